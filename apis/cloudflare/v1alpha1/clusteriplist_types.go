@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ClusterIPListParameters defines the desired state of a ClusterIPList
+type ClusterIPListParameters struct {
+	// ListRef references the List of kind ip whose items are kept in sync.
+	// Exactly one of listRef and trustListRef must be set.
+	// +kubebuilder:validation:Optional
+	ListRef *xpv1.Reference `json:"listRef,omitempty"`
+
+	// TrustListRef references the Zero Trust TrustList of type IP whose
+	// items are kept in sync.
+	// +kubebuilder:validation:Optional
+	TrustListRef *xpv1.Reference `json:"trustListRef,omitempty"`
+
+	// NodeSelector selects the Nodes whose addresses are added. No Nodes
+	// are selected if unset, all Nodes if empty.
+	// +kubebuilder:validation:Optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// NodeAddressTypes are the types of the Node addresses that are added.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={"ExternalIP"}
+	NodeAddressTypes []string `json:"nodeAddressTypes,omitempty"`
+
+	// ServiceSelector selects the Services of type LoadBalancer whose
+	// ingress IPs are added. No Services are selected if unset, all
+	// Services if empty.
+	// +kubebuilder:validation:Optional
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
+
+	// CIDRs are static addresses and CIDRs that are always added.
+	// +kubebuilder:validation:Optional
+	CIDRs []string `json:"cidrs,omitempty"`
+
+	// MinItems is the smallest number of items the list is updated with.
+	// A smaller set, e.g. computed from a wrong selector, is not applied
+	// and the resource reports it instead.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	MinItems int `json:"minItems,omitempty"`
+}
+
+// ClusterIPListObservation defines the observed state of a ClusterIPList
+type ClusterIPListObservation struct {
+	// Addresses are the addresses and CIDRs computed from the Nodes,
+	// Services and static CIDRs.
+	Addresses []string `json:"addresses,omitempty"`
+
+	// NodeCount is the number of selected Nodes.
+	NodeCount int `json:"nodeCount,omitempty"`
+
+	// ServiceCount is the number of selected Services with ingress IPs.
+	ServiceCount int `json:"serviceCount,omitempty"`
+
+	// ItemCount is the number of items of the list.
+	ItemCount int `json:"itemCount,omitempty"`
+
+	// Withheld reports that the computed addresses are fewer than
+	// minItems and were not applied.
+	Withheld bool `json:"withheld,omitempty"`
+
+	// LastSyncTime is when the items of the list were last updated.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// ClusterIPListSpec defines the desired state of ClusterIPList
+type ClusterIPListSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterIPListParameters `json:"forProvider"`
+}
+
+// ClusterIPListStatus defines the observed state of ClusterIPList
+type ClusterIPListStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterIPListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ITEMS",type="integer",JSONPath=".status.atProvider.itemCount"
+// +kubebuilder:printcolumn:name="WITHHELD",type="boolean",JSONPath=".status.atProvider.withheld"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// ClusterIPList is the Schema for the ClusterIPList API.
+// It keeps the items of a List or of a Zero Trust TrustList in sync with the
+// addresses of selected Nodes, the ingress IPs of selected LoadBalancer
+// Services and static CIDRs, by updating the items of the referenced
+// managed resource. It owns all the items of the list.
+type ClusterIPList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterIPListSpec   `json:"spec"`
+	Status            ClusterIPListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterIPListList contains a list of ClusterIPLists
+type ClusterIPListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterIPList `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ClusterIPList_Kind             = "ClusterIPList"
+	ClusterIPList_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ClusterIPList_Kind}.String()
+	ClusterIPList_KindAPIVersion   = ClusterIPList_Kind + "." + CRDGroupVersion.String()
+	ClusterIPList_GroupVersionKind = CRDGroupVersion.WithKind(ClusterIPList_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterIPList{}, &ClusterIPListList{})
+}
+
+func (mg *ClusterIPList) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *ClusterIPList) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *ClusterIPList) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *ClusterIPList) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *ClusterIPList) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *ClusterIPList) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *ClusterIPList) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *ClusterIPList) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *ClusterIPList) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *ClusterIPList) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
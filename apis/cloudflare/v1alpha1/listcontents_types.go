@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ListContentsParameters defines the desired state of a ListContents
+type ListContentsParameters struct {
+	// AccountID is the Cloudflare account ID.
+	// +kubebuilder:validation:Required
+	AccountID string `json:"accountId"`
+
+	// ListID is the identifier of the list whose items are replaced.
+	// +crossplane:generate:reference:type=List
+	// +kubebuilder:validation:Optional
+	ListID *string `json:"listId,omitempty"`
+
+	// Reference to a List in cloudflare to populate listId.
+	// +kubebuilder:validation:Optional
+	ListIDRef *xpv1.Reference `json:"listIdRef,omitempty"`
+
+	// Selector for a List in cloudflare to populate listId.
+	// +kubebuilder:validation:Optional
+	ListIDSelector *xpv1.Selector `json:"listIdSelector,omitempty"`
+
+	// Sources are the ConfigMaps and URL feeds the items are read from.
+	// Each line of a source holds an item, optionally followed by a comment
+	// starting with # or ;. Lines without an item are skipped.
+	// Items are IP addresses or CIDRs, hostnames, ASNs such as AS13335, or
+	// for redirect lists a source URL, a target URL and an optional status
+	// code separated by spaces, depending on the kind of the list.
+	// +kubebuilder:validation:MinItems=1
+	Sources []ListContentsSource `json:"sources"`
+}
+
+// ListContentsSource is a ConfigMap or URL feed of list items.
+type ListContentsSource struct {
+	// ConfigMapRef references a ConfigMap holding items.
+	// +kubebuilder:validation:Optional
+	ConfigMapRef *ListContentsConfigMapReference `json:"configMapRef,omitempty"`
+
+	// URL of a plain text feed of items, fetched on every poll.
+	// +kubebuilder:validation:Optional
+	URL *string `json:"url,omitempty"`
+
+	// Comment of the items of the source that have no comment of their own.
+	// +kubebuilder:validation:Optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// ListContentsConfigMapReference references the keys of a ConfigMap.
+type ListContentsConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key holding the items. Defaults to all the keys of the ConfigMap.
+	// +kubebuilder:validation:Optional
+	Key string `json:"key,omitempty"`
+}
+
+// ListContentsObservation defines the observed state of a ListContents
+type ListContentsObservation struct {
+	// Kind of the list, e.g. ip or hostname.
+	Kind string `json:"kind,omitempty"`
+
+	// ItemCount is the number of valid items read from the sources.
+	ItemCount int `json:"itemCount,omitempty"`
+
+	// ListItemCount is the number of items of the list.
+	ListItemCount int `json:"listItemCount,omitempty"`
+
+	// InvalidItemCount is the number of lines of the sources that are not
+	// valid items for the kind of the list. They are skipped.
+	InvalidItemCount int `json:"invalidItemCount,omitempty"`
+
+	// InvalidItems are the first invalid lines of the sources.
+	InvalidItems []string `json:"invalidItems,omitempty"`
+
+	// Digest is the digest of the items of the last bulk operation.
+	Digest string `json:"digest,omitempty"`
+
+	// OperationID is the identifier of the last bulk operation.
+	OperationID string `json:"operationId,omitempty"`
+
+	// OperationStatus is the status of the last bulk operation, i.e.
+	// pending, running, completed or failed.
+	OperationStatus string `json:"operationStatus,omitempty"`
+
+	// OperationError is the error of the last bulk operation, if it failed.
+	OperationError string `json:"operationError,omitempty"`
+
+	// ListModifiedOn is when the list was modified by the last bulk
+	// operation. A later modification is drift.
+	ListModifiedOn *metav1.Time `json:"listModifiedOn,omitempty"`
+
+	// LastSyncTime is when the last bulk operation completed.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// ListContentsSpec defines the desired state of ListContents
+type ListContentsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ListContentsParameters `json:"forProvider"`
+}
+
+// ListContentsStatus defines the observed state of ListContents
+type ListContentsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ListContentsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ITEMS",type="integer",JSONPath=".status.atProvider.listItemCount"
+// +kubebuilder:printcolumn:name="INVALID",type="integer",JSONPath=".status.atProvider.invalidItemCount"
+// +kubebuilder:printcolumn:name="OPERATION",type="string",JSONPath=".status.atProvider.operationStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// ListContents is the Schema for the ListContents API.
+// It replaces the items of a list in bulk with the items read from
+// ConfigMaps and URL feeds, and tracks the asynchronous bulk operation. The
+// items of the list must not be managed by the List itself.
+type ListContents struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ListContentsSpec   `json:"spec"`
+	Status            ListContentsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListContentsList contains a list of ListContents
+type ListContentsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListContents `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ListContents_Kind             = "ListContents"
+	ListContents_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ListContents_Kind}.String()
+	ListContents_KindAPIVersion   = ListContents_Kind + "." + CRDGroupVersion.String()
+	ListContents_GroupVersionKind = CRDGroupVersion.WithKind(ListContents_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ListContents{}, &ListContentsList{})
+}
+
+func (mg *ListContents) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *ListContents) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *ListContents) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *ListContents) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *ListContents) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *ListContents) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *ListContents) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *ListContents) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *ListContents) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *ListContents) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ManagedRulesetDeploymentParameters defines the desired state of a
+// ManagedRulesetDeployment
+type ManagedRulesetDeploymentParameters struct {
+	// ZoneID is the identifier of the zone the managed ruleset is deployed
+	// to.
+	// +crossplane:generate:reference:type=Zone
+	// +kubebuilder:validation:Optional
+	ZoneID *string `json:"zoneId,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDRef *xpv1.Reference `json:"zoneIdRef,omitempty"`
+
+	// Selector for a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDSelector *xpv1.Selector `json:"zoneIdSelector,omitempty"`
+
+	// Ruleset is the name or the identifier of the managed ruleset, e.g.
+	// "Cloudflare Managed Ruleset" or "Cloudflare OWASP Core Ruleset".
+	// +kubebuilder:validation:Required
+	Ruleset string `json:"ruleset"`
+
+	// Expression matches the requests the managed ruleset is executed for.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="true"
+	Expression string `json:"expression,omitempty"`
+
+	// Priority orders the execute rule among the RulesetRules of the
+	// http_request_firewall_managed entrypoint.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=0
+	Priority int32 `json:"priority,omitempty"`
+
+	// Description of the execute rule.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// Enabled controls whether the managed ruleset is executed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Overrides of the behaviour of the managed ruleset.
+	// +kubebuilder:validation:Optional
+	Overrides *ManagedRulesetOverrides `json:"overrides,omitempty"`
+}
+
+// ManagedRulesetOverrides overrides the behaviour of a managed ruleset, of
+// the rules of a category or of single rules. The most specific override
+// applies.
+type ManagedRulesetOverrides struct {
+	// Action of all the rules of the ruleset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=block;challenge;js_challenge;managed_challenge;log
+	Action *string `json:"action,omitempty"`
+
+	// Enabled enables or disables all the rules of the ruleset.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// SensitivityLevel of all the rules of the ruleset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=default;medium;low;eoff
+	SensitivityLevel *string `json:"sensitivityLevel,omitempty"`
+
+	// Categories overrides the rules tagged with a category.
+	// +kubebuilder:validation:Optional
+	Categories []ManagedRulesetCategoryOverride `json:"categories,omitempty"`
+
+	// Rules overrides single rules.
+	// +kubebuilder:validation:Optional
+	Rules []ManagedRulesetRuleOverride `json:"rules,omitempty"`
+}
+
+// ManagedRulesetCategoryOverride overrides the rules tagged with a category.
+type ManagedRulesetCategoryOverride struct {
+	// Tag of the rules, e.g. wordpress or paranoia-level-2.
+	// +kubebuilder:validation:Required
+	Tag string `json:"tag"`
+
+	// Action of the rules.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=block;challenge;js_challenge;managed_challenge;log
+	Action *string `json:"action,omitempty"`
+
+	// Enabled enables or disables the rules.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ManagedRulesetRuleOverride overrides a single rule, identified either by
+// its identifier or by its description.
+type ManagedRulesetRuleOverride struct {
+	// ID of the rule.
+	// +kubebuilder:validation:Optional
+	ID *string `json:"id,omitempty"`
+
+	// Description of the rule, e.g. "949110: Inbound Anomaly Score
+	// Exceeded". It must match a single rule of the ruleset.
+	// +kubebuilder:validation:Optional
+	Description *string `json:"description,omitempty"`
+
+	// Action of the rule.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=block;challenge;js_challenge;managed_challenge;log
+	Action *string `json:"action,omitempty"`
+
+	// Enabled enables or disables the rule.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ScoreThreshold of an anomaly score rule, e.g. 25 for a low
+	// sensitivity of the OWASP Core Ruleset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ScoreThreshold *int32 `json:"scoreThreshold,omitempty"`
+
+	// SensitivityLevel of the rule.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=default;medium;low;eoff
+	SensitivityLevel *string `json:"sensitivityLevel,omitempty"`
+}
+
+// ManagedRulesetDeploymentObservation defines the observed state of a
+// ManagedRulesetDeployment
+type ManagedRulesetDeploymentObservation struct {
+	// RulesetID is the identifier of the managed ruleset.
+	RulesetID string `json:"rulesetId,omitempty"`
+
+	// RulesetName is the name of the managed ruleset.
+	RulesetName string `json:"rulesetName,omitempty"`
+
+	// RulesetVersion is the latest version of the managed ruleset.
+	RulesetVersion string `json:"rulesetVersion,omitempty"`
+
+	// RuleID is the identifier of the execute rule in the entrypoint.
+	RuleID string `json:"ruleId,omitempty"`
+
+	// Position is the position of the execute rule in the entrypoint,
+	// starting at 1.
+	Position int `json:"position,omitempty"`
+}
+
+// ManagedRulesetDeploymentSpec defines the desired state of
+// ManagedRulesetDeployment
+type ManagedRulesetDeploymentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ManagedRulesetDeploymentParameters `json:"forProvider"`
+}
+
+// ManagedRulesetDeploymentStatus defines the observed state of
+// ManagedRulesetDeployment
+type ManagedRulesetDeploymentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ManagedRulesetDeploymentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="RULESET",type="string",JSONPath=".status.atProvider.rulesetName"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.rulesetVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// ManagedRulesetDeployment is the Schema for the ManagedRulesetDeployment
+// API. It deploys a managed WAF ruleset to a zone by rendering an execute
+// rule, whose overrides refer to categories and rules by tag and description
+// rather than by identifier. The execute rule is managed through a
+// RulesetRule of the same name in the http_request_firewall_managed phase.
+type ManagedRulesetDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ManagedRulesetDeploymentSpec   `json:"spec"`
+	Status            ManagedRulesetDeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedRulesetDeploymentList contains a list of ManagedRulesetDeployments
+type ManagedRulesetDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedRulesetDeployment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ManagedRulesetDeployment_Kind             = "ManagedRulesetDeployment"
+	ManagedRulesetDeployment_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ManagedRulesetDeployment_Kind}.String()
+	ManagedRulesetDeployment_KindAPIVersion   = ManagedRulesetDeployment_Kind + "." + CRDGroupVersion.String()
+	ManagedRulesetDeployment_GroupVersionKind = CRDGroupVersion.WithKind(ManagedRulesetDeployment_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ManagedRulesetDeployment{}, &ManagedRulesetDeploymentList{})
+}
+
+func (mg *ManagedRulesetDeployment) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *ManagedRulesetDeployment) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *ManagedRulesetDeployment) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *ManagedRulesetDeployment) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *ManagedRulesetDeployment) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *ManagedRulesetDeployment) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *ManagedRulesetDeployment) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *ManagedRulesetDeployment) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *ManagedRulesetDeployment) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *ManagedRulesetDeployment) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
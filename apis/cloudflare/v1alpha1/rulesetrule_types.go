@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// RulesetRuleParameters defines the desired state of a RulesetRule
+type RulesetRuleParameters struct {
+	// ZoneID is the identifier of the zone whose entrypoint ruleset holds
+	// the rule.
+	// +crossplane:generate:reference:type=Zone
+	// +kubebuilder:validation:Optional
+	ZoneID *string `json:"zoneId,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDRef *xpv1.Reference `json:"zoneIdRef,omitempty"`
+
+	// Selector for a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDSelector *xpv1.Selector `json:"zoneIdSelector,omitempty"`
+
+	// Phase of the entrypoint ruleset, e.g. http_request_firewall_custom.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=ddos_l7;http_config_settings;http_custom_errors;http_log_custom_fields;http_ratelimit;http_request_cache_settings;http_request_dynamic_redirect;http_request_firewall_custom;http_request_firewall_managed;http_request_late_transform;http_request_origin;http_request_redirect;http_request_sanitize;http_request_sbfm;http_request_transform;http_response_compression;http_response_firewall_managed;http_response_headers_transform
+	Phase string `json:"phase"`
+
+	// Priority orders the rules of the entrypoint. Rules are evaluated in
+	// ascending order of priority, then in the order of their names.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=0
+	Priority int32 `json:"priority,omitempty"`
+
+	// Action of the rule, e.g. block, skip or set_cache_settings.
+	// +kubebuilder:validation:Required
+	Action string `json:"action"`
+
+	// Expression is the Rules language expression matching the requests the
+	// rule applies to.
+	// +kubebuilder:validation:Required
+	Expression string `json:"expression"`
+
+	// Description of the rule.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// Enabled controls whether the rule is evaluated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ActionParameters are the parameters of the action, in the format of
+	// the Rulesets API, e.g. {"id": "...", "overrides": {...}}.
+	// +kubebuilder:validation:Optional
+	ActionParameters *apiextensionsv1.JSON `json:"actionParameters,omitempty"`
+
+	// Ratelimit configures a rate limiting rule, in the format of the
+	// Rulesets API.
+	// +kubebuilder:validation:Optional
+	Ratelimit *apiextensionsv1.JSON `json:"ratelimit,omitempty"`
+
+	// Logging configures the logging of a skip rule, in the format of the
+	// Rulesets API.
+	// +kubebuilder:validation:Optional
+	Logging *apiextensionsv1.JSON `json:"logging,omitempty"`
+}
+
+// RulesetRuleObservation defines the observed state of a RulesetRule
+type RulesetRuleObservation struct {
+	// RulesetID is the identifier of the entrypoint ruleset.
+	RulesetID string `json:"rulesetId,omitempty"`
+
+	// RuleID is the identifier of the rule.
+	RuleID string `json:"ruleId,omitempty"`
+
+	// Ref is the reference identifying the rule as owned by this resource.
+	Ref string `json:"ref,omitempty"`
+
+	// Position is the position of the rule in the entrypoint, starting at 1.
+	Position int `json:"position,omitempty"`
+}
+
+// RulesetRuleSpec defines the desired state of RulesetRule
+type RulesetRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RulesetRuleParameters `json:"forProvider"`
+}
+
+// RulesetRuleStatus defines the observed state of RulesetRule
+type RulesetRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RulesetRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".spec.forProvider.phase"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="POSITION",type="integer",JSONPath=".status.atProvider.position"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// RulesetRule is the Schema for the RulesetRule API.
+// It manages a single rule of the entrypoint ruleset of a phase of a zone.
+// The rules of all RulesetRules targeting the same zone and phase are merged
+// into the entrypoint in the order of their priority, so that different
+// teams can own their rules independently. A RulesetRule refuses to merge
+// into an entrypoint managed by a Ruleset of kind zone, which would remove
+// its rule.
+type RulesetRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RulesetRuleSpec   `json:"spec"`
+	Status            RulesetRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RulesetRuleList contains a list of RulesetRules
+type RulesetRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RulesetRule `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	RulesetRule_Kind             = "RulesetRule"
+	RulesetRule_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: RulesetRule_Kind}.String()
+	RulesetRule_KindAPIVersion   = RulesetRule_Kind + "." + CRDGroupVersion.String()
+	RulesetRule_GroupVersionKind = CRDGroupVersion.WithKind(RulesetRule_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RulesetRule{}, &RulesetRuleList{})
+}
+
+func (mg *RulesetRule) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *RulesetRule) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *RulesetRule) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *RulesetRule) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *RulesetRule) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *RulesetRule) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *RulesetRule) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *RulesetRule) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *RulesetRule) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *RulesetRule) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
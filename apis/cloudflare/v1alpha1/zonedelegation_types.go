@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ZoneDelegationParameters defines the desired state of a ZoneDelegation
+type ZoneDelegationParameters struct {
+	// ZoneID is the identifier of the delegated zone.
+	// +crossplane:generate:reference:type=Zone
+	// +kubebuilder:validation:Optional
+	ZoneID *string `json:"zoneId,omitempty"`
+
+	// Reference to a Zone to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDRef *xpv1.Reference `json:"zoneIdRef,omitempty"`
+
+	// Selector for a Zone to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDSelector *xpv1.Selector `json:"zoneIdSelector,omitempty"`
+
+	// ParentZoneID is the identifier of the parent zone the NS records of
+	// the delegated zone are created in.
+	// +crossplane:generate:reference:type=Zone
+	// +kubebuilder:validation:Optional
+	ParentZoneID *string `json:"parentZoneId,omitempty"`
+
+	// Reference to a Zone to populate parentZoneId.
+	// +kubebuilder:validation:Optional
+	ParentZoneIDRef *xpv1.Reference `json:"parentZoneIdRef,omitempty"`
+
+	// Selector for a Zone to populate parentZoneId.
+	// +kubebuilder:validation:Optional
+	ParentZoneIDSelector *xpv1.Selector `json:"parentZoneIdSelector,omitempty"`
+
+	// RecordTTL is the TTL of the NS records in seconds, 1 for automatic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	RecordTTL int `json:"recordTtl,omitempty"`
+
+	// ActivationCheck requests an activation check of the delegated zone
+	// once the NS records are published, and again every hour while the
+	// zone is pending, instead of waiting for Cloudflare to check it.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	ActivationCheck *bool `json:"activationCheck,omitempty"`
+
+	// Resolver is the address of the DNS server, e.g. 1.1.1.1:53, asked
+	// for the nameservers of the delegated zone to report propagation. The
+	// resolver of the provider is used if unset.
+	// +kubebuilder:validation:Optional
+	Resolver *string `json:"resolver,omitempty"`
+}
+
+// ZoneDelegationObservation defines the observed state of a ZoneDelegation
+type ZoneDelegationObservation struct {
+	// ZoneName is the name of the delegated zone.
+	ZoneName string `json:"zoneName,omitempty"`
+
+	// ZoneStatus is the status of the delegated zone, e.g. pending or
+	// active.
+	ZoneStatus string `json:"zoneStatus,omitempty"`
+
+	// NameServers are the nameservers Cloudflare assigned to the delegated
+	// zone.
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// ResolvedNameServers are the nameservers of the delegated zone as
+	// last resolved through DNS.
+	ResolvedNameServers []string `json:"resolvedNameServers,omitempty"`
+
+	// Propagated reports that the resolved nameservers are the assigned
+	// ones.
+	Propagated bool `json:"propagated,omitempty"`
+
+	// Records are the names of the dns.Record resources created for the
+	// NS records.
+	Records []string `json:"records,omitempty"`
+
+	// LastActivationCheckTime is when an activation check of the delegated
+	// zone was last requested.
+	LastActivationCheckTime *metav1.Time `json:"lastActivationCheckTime,omitempty"`
+}
+
+// ZoneDelegationSpec defines the desired state of ZoneDelegation
+type ZoneDelegationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ZoneDelegationParameters `json:"forProvider"`
+}
+
+// ZoneDelegationStatus defines the observed state of ZoneDelegation
+type ZoneDelegationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ZoneDelegationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".status.atProvider.zoneName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.zoneStatus"
+// +kubebuilder:printcolumn:name="PROPAGATED",type="boolean",JSONPath=".status.atProvider.propagated"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// ZoneDelegation is the Schema for the ZoneDelegation API.
+// It delegates a zone to Cloudflare by creating the NS records of its
+// assigned nameservers as dns.Record resources in its parent zone, and
+// reports whether the delegation has propagated. It is ready once the
+// delegated zone is active.
+type ZoneDelegation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ZoneDelegationSpec   `json:"spec"`
+	Status            ZoneDelegationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ZoneDelegationList contains a list of ZoneDelegations
+type ZoneDelegationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ZoneDelegation `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ZoneDelegation_Kind             = "ZoneDelegation"
+	ZoneDelegation_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ZoneDelegation_Kind}.String()
+	ZoneDelegation_KindAPIVersion   = ZoneDelegation_Kind + "." + CRDGroupVersion.String()
+	ZoneDelegation_GroupVersionKind = CRDGroupVersion.WithKind(ZoneDelegation_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ZoneDelegation{}, &ZoneDelegationList{})
+}
+
+func (mg *ZoneDelegation) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *ZoneDelegation) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *ZoneDelegation) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *ZoneDelegation) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *ZoneDelegation) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *ZoneDelegation) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *ZoneDelegation) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *ZoneDelegation) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *ZoneDelegation) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *ZoneDelegation) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
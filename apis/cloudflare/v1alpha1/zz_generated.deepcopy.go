@@ -9,6 +9,9 @@
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -1921,6 +1924,168 @@ func (in *CategoriesParameters) DeepCopy() *CategoriesParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIPList) DeepCopyInto(out *ClusterIPList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIPList.
+func (in *ClusterIPList) DeepCopy() *ClusterIPList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIPList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterIPList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIPListList) DeepCopyInto(out *ClusterIPListList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterIPList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIPListList.
+func (in *ClusterIPListList) DeepCopy() *ClusterIPListList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIPListList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterIPListList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIPListObservation) DeepCopyInto(out *ClusterIPListObservation) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIPListObservation.
+func (in *ClusterIPListObservation) DeepCopy() *ClusterIPListObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIPListObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIPListParameters) DeepCopyInto(out *ClusterIPListParameters) {
+	*out = *in
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustListRef != nil {
+		in, out := &in.TrustListRef, &out.TrustListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeAddressTypes != nil {
+		in, out := &in.NodeAddressTypes, &out.NodeAddressTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIPListParameters.
+func (in *ClusterIPListParameters) DeepCopy() *ClusterIPListParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIPListParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIPListSpec) DeepCopyInto(out *ClusterIPListSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIPListSpec.
+func (in *ClusterIPListSpec) DeepCopy() *ClusterIPListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIPListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIPListStatus) DeepCopyInto(out *ClusterIPListStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIPListStatus.
+func (in *ClusterIPListStatus) DeepCopy() *ClusterIPListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIPListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsumersInitParameters) DeepCopyInto(out *ConsumersInitParameters) {
 	*out = *in
@@ -5064,6 +5229,204 @@ func (in *List) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContents) DeepCopyInto(out *ListContents) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContents.
+func (in *ListContents) DeepCopy() *ListContents {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContents)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListContents) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContentsConfigMapReference) DeepCopyInto(out *ListContentsConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContentsConfigMapReference.
+func (in *ListContentsConfigMapReference) DeepCopy() *ListContentsConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContentsConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContentsList) DeepCopyInto(out *ListContentsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListContents, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContentsList.
+func (in *ListContentsList) DeepCopy() *ListContentsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContentsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListContentsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContentsObservation) DeepCopyInto(out *ListContentsObservation) {
+	*out = *in
+	if in.InvalidItems != nil {
+		in, out := &in.InvalidItems, &out.InvalidItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ListModifiedOn != nil {
+		in, out := &in.ListModifiedOn, &out.ListModifiedOn
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContentsObservation.
+func (in *ListContentsObservation) DeepCopy() *ListContentsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContentsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContentsParameters) DeepCopyInto(out *ListContentsParameters) {
+	*out = *in
+	if in.ListID != nil {
+		in, out := &in.ListID, &out.ListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ListIDRef != nil {
+		in, out := &in.ListIDRef, &out.ListIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListIDSelector != nil {
+		in, out := &in.ListIDSelector, &out.ListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]ListContentsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContentsParameters.
+func (in *ListContentsParameters) DeepCopy() *ListContentsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContentsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContentsSource) DeepCopyInto(out *ListContentsSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ListContentsConfigMapReference)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContentsSource.
+func (in *ListContentsSource) DeepCopy() *ListContentsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContentsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContentsSpec) DeepCopyInto(out *ListContentsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContentsSpec.
+func (in *ListContentsSpec) DeepCopy() *ListContentsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContentsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListContentsStatus) DeepCopyInto(out *ListContentsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListContentsStatus.
+func (in *ListContentsStatus) DeepCopy() *ListContentsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ListContentsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ListInitParameters) DeepCopyInto(out *ListInitParameters) {
 	*out = *in
@@ -5467,22 +5830,284 @@ func (in *ManagedByObservation) DeepCopy() *ManagedByObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedByObservation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedByParameters) DeepCopyInto(out *ManagedByParameters) {
-	*out = *in
+	out := new(ManagedByObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedByParameters) DeepCopyInto(out *ManagedByParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedByParameters.
+func (in *ManagedByParameters) DeepCopy() *ManagedByParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedByParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetCategoryOverride) DeepCopyInto(out *ManagedRulesetCategoryOverride) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetCategoryOverride.
+func (in *ManagedRulesetCategoryOverride) DeepCopy() *ManagedRulesetCategoryOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetCategoryOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeployment) DeepCopyInto(out *ManagedRulesetDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeployment.
+func (in *ManagedRulesetDeployment) DeepCopy() *ManagedRulesetDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedRulesetDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentList) DeepCopyInto(out *ManagedRulesetDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedRulesetDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentList.
+func (in *ManagedRulesetDeploymentList) DeepCopy() *ManagedRulesetDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedRulesetDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentObservation) DeepCopyInto(out *ManagedRulesetDeploymentObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentObservation.
+func (in *ManagedRulesetDeploymentObservation) DeepCopy() *ManagedRulesetDeploymentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentParameters) DeepCopyInto(out *ManagedRulesetDeploymentParameters) {
+	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ManagedRulesetOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentParameters.
+func (in *ManagedRulesetDeploymentParameters) DeepCopy() *ManagedRulesetDeploymentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentSpec) DeepCopyInto(out *ManagedRulesetDeploymentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentSpec.
+func (in *ManagedRulesetDeploymentSpec) DeepCopy() *ManagedRulesetDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentStatus) DeepCopyInto(out *ManagedRulesetDeploymentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentStatus.
+func (in *ManagedRulesetDeploymentStatus) DeepCopy() *ManagedRulesetDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetOverrides) DeepCopyInto(out *ManagedRulesetOverrides) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SensitivityLevel != nil {
+		in, out := &in.SensitivityLevel, &out.SensitivityLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]ManagedRulesetCategoryOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ManagedRulesetRuleOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetOverrides.
+func (in *ManagedRulesetOverrides) DeepCopy() *ManagedRulesetOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetRuleOverride) DeepCopyInto(out *ManagedRulesetRuleOverride) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScoreThreshold != nil {
+		in, out := &in.ScoreThreshold, &out.ScoreThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SensitivityLevel != nil {
+		in, out := &in.SensitivityLevel, &out.SensitivityLevel
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedByParameters.
-func (in *ManagedByParameters) DeepCopy() *ManagedByParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetRuleOverride.
+func (in *ManagedRulesetRuleOverride) DeepCopy() *ManagedRulesetRuleOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedByParameters)
+	out := new(ManagedRulesetRuleOverride)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -8546,6 +9171,164 @@ func (in *RulesetParameters) DeepCopy() *RulesetParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRule) DeepCopyInto(out *RulesetRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRule.
+func (in *RulesetRule) DeepCopy() *RulesetRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RulesetRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRuleList) DeepCopyInto(out *RulesetRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RulesetRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRuleList.
+func (in *RulesetRuleList) DeepCopy() *RulesetRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RulesetRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRuleObservation) DeepCopyInto(out *RulesetRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRuleObservation.
+func (in *RulesetRuleObservation) DeepCopy() *RulesetRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRuleParameters) DeepCopyInto(out *RulesetRuleParameters) {
+	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ActionParameters != nil {
+		in, out := &in.ActionParameters, &out.ActionParameters
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ratelimit != nil {
+		in, out := &in.Ratelimit, &out.Ratelimit
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRuleParameters.
+func (in *RulesetRuleParameters) DeepCopy() *RulesetRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRuleSpec) DeepCopyInto(out *RulesetRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRuleSpec.
+func (in *RulesetRuleSpec) DeepCopy() *RulesetRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRuleStatus) DeepCopyInto(out *RulesetRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRuleStatus.
+func (in *RulesetRuleStatus) DeepCopy() *RulesetRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesetSpec) DeepCopyInto(out *RulesetSpec) {
 	*out = *in
@@ -11621,6 +12404,188 @@ func (in *ZoneAccountParameters) DeepCopy() *ZoneAccountParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneDelegation) DeepCopyInto(out *ZoneDelegation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneDelegation.
+func (in *ZoneDelegation) DeepCopy() *ZoneDelegation {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneDelegation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneDelegation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneDelegationList) DeepCopyInto(out *ZoneDelegationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ZoneDelegation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneDelegationList.
+func (in *ZoneDelegationList) DeepCopy() *ZoneDelegationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneDelegationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneDelegationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneDelegationObservation) DeepCopyInto(out *ZoneDelegationObservation) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedNameServers != nil {
+		in, out := &in.ResolvedNameServers, &out.ResolvedNameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastActivationCheckTime != nil {
+		in, out := &in.LastActivationCheckTime, &out.LastActivationCheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneDelegationObservation.
+func (in *ZoneDelegationObservation) DeepCopy() *ZoneDelegationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneDelegationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneDelegationParameters) DeepCopyInto(out *ZoneDelegationParameters) {
+	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentZoneID != nil {
+		in, out := &in.ParentZoneID, &out.ParentZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentZoneIDRef != nil {
+		in, out := &in.ParentZoneIDRef, &out.ParentZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentZoneIDSelector != nil {
+		in, out := &in.ParentZoneIDSelector, &out.ParentZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActivationCheck != nil {
+		in, out := &in.ActivationCheck, &out.ActivationCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Resolver != nil {
+		in, out := &in.Resolver, &out.Resolver
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneDelegationParameters.
+func (in *ZoneDelegationParameters) DeepCopy() *ZoneDelegationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneDelegationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneDelegationSpec) DeepCopyInto(out *ZoneDelegationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneDelegationSpec.
+func (in *ZoneDelegationSpec) DeepCopy() *ZoneDelegationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneDelegationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneDelegationStatus) DeepCopyInto(out *ZoneDelegationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneDelegationStatus.
+func (in *ZoneDelegationStatus) DeepCopy() *ZoneDelegationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneDelegationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZoneInitParameters) DeepCopyInto(out *ZoneInitParameters) {
 	*out = *in
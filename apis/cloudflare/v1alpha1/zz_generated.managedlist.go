@@ -17,6 +17,15 @@ func (l *AccountList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ClusterIPListList.
+func (l *ClusterIPListList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this FilterList.
 func (l *FilterList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -44,6 +53,15 @@ func (l *ImageList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ListContentsList.
+func (l *ListContentsList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ListList.
 func (l *ListList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -53,6 +71,15 @@ func (l *ListList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ManagedRulesetDeploymentList.
+func (l *ManagedRulesetDeploymentList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this OrganizationList.
 func (l *OrganizationList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -80,6 +107,15 @@ func (l *RulesetList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this RulesetRuleList.
+func (l *RulesetRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this SnippetList.
 func (l *SnippetList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -134,6 +170,15 @@ func (l *WorkflowList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ZoneDelegationList.
+func (l *ZoneDelegationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ZoneList.
 func (l *ZoneList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
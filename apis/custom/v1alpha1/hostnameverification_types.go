@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// HostnameVerificationParameters defines the desired state of a
+// HostnameVerification
+type HostnameVerificationParameters struct {
+	// HostnameRef references the Hostname whose verification records are
+	// published.
+	// +kubebuilder:validation:Required
+	HostnameRef xpv1.Reference `json:"hostnameRef"`
+
+	// RecordZoneID is the identifier of the zone the customer hostname
+	// lives in. If set, the verification records within this zone are
+	// created as dns.Record resources.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Zone
+	// +kubebuilder:validation:Optional
+	RecordZoneID *string `json:"recordZoneId,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate recordZoneId.
+	// +kubebuilder:validation:Optional
+	RecordZoneIDRef *xpv1.Reference `json:"recordZoneIdRef,omitempty"`
+
+	// Selector for a Zone in cloudflare to populate recordZoneId.
+	// +kubebuilder:validation:Optional
+	RecordZoneIDSelector *xpv1.Selector `json:"recordZoneIdSelector,omitempty"`
+
+	// RecordTTL is the TTL of the created records in seconds, 1 for
+	// automatic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	RecordTTL int `json:"recordTtl,omitempty"`
+}
+
+// OwnershipVerificationRecord is the record proving the ownership of a
+// custom hostname.
+type OwnershipVerificationRecord struct {
+	// Type of the record, e.g. txt.
+	Type string `json:"type,omitempty"`
+
+	// Name of the record.
+	Name string `json:"name,omitempty"`
+
+	// Value of the record.
+	Value string `json:"value,omitempty"`
+}
+
+// ValidationRecord is a domain control validation record of the
+// certificate of a custom hostname.
+type ValidationRecord struct {
+	// TXTName is the name of the TXT record.
+	TXTName string `json:"txtName,omitempty"`
+
+	// TXTValue is the value of the TXT record.
+	TXTValue string `json:"txtValue,omitempty"`
+
+	// CNAMEName is the name of the CNAME record.
+	CNAMEName string `json:"cnameName,omitempty"`
+
+	// CNAMETarget is the target of the CNAME record.
+	CNAMETarget string `json:"cnameTarget,omitempty"`
+
+	// HTTPURL is the URL the HTTP validation body is served at.
+	HTTPURL string `json:"httpUrl,omitempty"`
+
+	// HTTPBody is the body of the HTTP validation.
+	HTTPBody string `json:"httpBody,omitempty"`
+
+	// Emails are the addresses the validation emails are sent to.
+	Emails []string `json:"emails,omitempty"`
+}
+
+// HostnameVerificationObservation defines the observed state of a
+// HostnameVerification
+type HostnameVerificationObservation struct {
+	// Hostname is the custom hostname.
+	Hostname string `json:"hostname,omitempty"`
+
+	// Status of the custom hostname, e.g. pending or active.
+	Status string `json:"status,omitempty"`
+
+	// SSLStatus is the status of the certificate of the custom hostname,
+	// e.g. pending_validation or active.
+	SSLStatus string `json:"sslStatus,omitempty"`
+
+	// VerificationErrors are the errors of the last verification.
+	VerificationErrors []string `json:"verificationErrors,omitempty"`
+
+	// OwnershipVerification is the record proving the ownership of the
+	// hostname.
+	OwnershipVerification *OwnershipVerificationRecord `json:"ownershipVerification,omitempty"`
+
+	// ValidationRecords are the domain control validation records of the
+	// certificate.
+	ValidationRecords []ValidationRecord `json:"validationRecords,omitempty"`
+
+	// Records are the names of the dns.Record resources created for the
+	// verification records.
+	Records []string `json:"records,omitempty"`
+}
+
+// HostnameVerificationSpec defines the desired state of HostnameVerification
+type HostnameVerificationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HostnameVerificationParameters `json:"forProvider"`
+}
+
+// HostnameVerificationStatus defines the observed state of HostnameVerification
+type HostnameVerificationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HostnameVerificationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="HOSTNAME",type="string",JSONPath=".status.atProvider.hostname"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="SSL",type="string",JSONPath=".status.atProvider.sslStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// HostnameVerification is the Schema for the HostnameVerification API.
+// It publishes the ownership and certificate validation records of a custom
+// hostname as connection details and status, and optionally creates them
+// as dns.Record resources. It is ready once the hostname and its
+// certificate are active.
+type HostnameVerification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              HostnameVerificationSpec   `json:"spec"`
+	Status            HostnameVerificationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HostnameVerificationList contains a list of HostnameVerifications
+type HostnameVerificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostnameVerification `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	HostnameVerification_Kind             = "HostnameVerification"
+	HostnameVerification_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: HostnameVerification_Kind}.String()
+	HostnameVerification_KindAPIVersion   = HostnameVerification_Kind + "." + CRDGroupVersion.String()
+	HostnameVerification_GroupVersionKind = CRDGroupVersion.WithKind(HostnameVerification_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&HostnameVerification{}, &HostnameVerificationList{})
+}
+
+func (mg *HostnameVerification) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *HostnameVerification) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *HostnameVerification) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *HostnameVerification) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *HostnameVerification) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *HostnameVerification) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *HostnameVerification) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *HostnameVerification) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *HostnameVerification) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *HostnameVerification) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// SSLSecretParameters defines the desired state of an SSLSecret
+type SSLSecretParameters struct {
+	// ZoneID is the identifier of the zone the certificate is uploaded to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Zone
+	// +kubebuilder:validation:Optional
+	ZoneID *string `json:"zoneId,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDRef *xpv1.Reference `json:"zoneIdRef,omitempty"`
+
+	// Selector for a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDSelector *xpv1.Selector `json:"zoneIdSelector,omitempty"`
+
+	// SecretRef is the kubernetes.io/tls Secret holding the certificate
+	// chain and private key as tls.crt and tls.key, such as a Secret
+	// issued by cert-manager. The certificate is uploaded again whenever
+	// the Secret holds a renewed one.
+	// +kubebuilder:validation:Required
+	SecretRef xpv1.SecretReference `json:"secretRef"`
+
+	// BundleMethod is how the certificate chain is bundled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ubiquitous;optimal;force
+	// +kubebuilder:default=ubiquitous
+	BundleMethod string `json:"bundleMethod,omitempty"`
+
+	// GeoRestrictions restricts the regions the private key is stored in.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=us;eu;highest_security
+	GeoRestrictions *string `json:"geoRestrictions,omitempty"`
+
+	// Type of the certificate, sni_custom for clients supporting SNI or
+	// legacy_custom for all clients.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=sni_custom;legacy_custom
+	// +kubebuilder:default=sni_custom
+	Type string `json:"type,omitempty"`
+}
+
+// SSLSecretObservation defines the observed state of an SSLSecret
+type SSLSecretObservation struct {
+	// CertificateID is the ID of the custom certificate.
+	CertificateID string `json:"certificateId,omitempty"`
+
+	// SerialNumber is the hexadecimal serial number of the uploaded
+	// certificate.
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// Hosts are the hostnames the uploaded certificate is valid for.
+	Hosts []string `json:"hosts,omitempty"`
+
+	// Issuer of the uploaded certificate.
+	Issuer string `json:"issuer,omitempty"`
+
+	// ExpiresOn is when the uploaded certificate expires.
+	ExpiresOn *metav1.Time `json:"expiresOn,omitempty"`
+
+	// Status of the custom certificate, e.g. active.
+	Status string `json:"status,omitempty"`
+
+	// LastUploadTime is when the certificate was last uploaded.
+	LastUploadTime *metav1.Time `json:"lastUploadTime,omitempty"`
+}
+
+// SSLSecretSpec defines the desired state of SSLSecret
+type SSLSecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SSLSecretParameters `json:"forProvider"`
+}
+
+// SSLSecretStatus defines the observed state of SSLSecret
+type SSLSecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SSLSecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SECRET",type="string",JSONPath=".spec.forProvider.secretRef.name"
+// +kubebuilder:printcolumn:name="SERIAL",type="string",JSONPath=".status.atProvider.serialNumber",priority=1
+// +kubebuilder:printcolumn:name="EXPIRES",type="date",JSONPath=".status.atProvider.expiresOn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// SSLSecret is the Schema for the SSLSecret API.
+// It uploads the certificate and key of a kubernetes.io/tls Secret as a
+// custom certificate of a zone and uploads it again when the Secret is
+// renewed.
+type SSLSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SSLSecretSpec   `json:"spec"`
+	Status            SSLSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SSLSecretList contains a list of SSLSecrets
+type SSLSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SSLSecret `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SSLSecret_Kind             = "SSLSecret"
+	SSLSecret_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SSLSecret_Kind}.String()
+	SSLSecret_KindAPIVersion   = SSLSecret_Kind + "." + CRDGroupVersion.String()
+	SSLSecret_GroupVersionKind = CRDGroupVersion.WithKind(SSLSecret_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SSLSecret{}, &SSLSecretList{})
+}
+
+func (mg *SSLSecret) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *SSLSecret) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *SSLSecret) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *SSLSecret) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *SSLSecret) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *SSLSecret) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *SSLSecret) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *SSLSecret) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *SSLSecret) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *SSLSecret) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
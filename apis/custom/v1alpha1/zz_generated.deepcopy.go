@@ -633,6 +633,167 @@ func (in *HostnameStatus) DeepCopy() *HostnameStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameVerification) DeepCopyInto(out *HostnameVerification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameVerification.
+func (in *HostnameVerification) DeepCopy() *HostnameVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostnameVerification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameVerificationList) DeepCopyInto(out *HostnameVerificationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HostnameVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameVerificationList.
+func (in *HostnameVerificationList) DeepCopy() *HostnameVerificationList {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameVerificationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostnameVerificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameVerificationObservation) DeepCopyInto(out *HostnameVerificationObservation) {
+	*out = *in
+	if in.VerificationErrors != nil {
+		in, out := &in.VerificationErrors, &out.VerificationErrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OwnershipVerification != nil {
+		in, out := &in.OwnershipVerification, &out.OwnershipVerification
+		*out = new(OwnershipVerificationRecord)
+		**out = **in
+	}
+	if in.ValidationRecords != nil {
+		in, out := &in.ValidationRecords, &out.ValidationRecords
+		*out = make([]ValidationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameVerificationObservation.
+func (in *HostnameVerificationObservation) DeepCopy() *HostnameVerificationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameVerificationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameVerificationParameters) DeepCopyInto(out *HostnameVerificationParameters) {
+	*out = *in
+	in.HostnameRef.DeepCopyInto(&out.HostnameRef)
+	if in.RecordZoneID != nil {
+		in, out := &in.RecordZoneID, &out.RecordZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RecordZoneIDRef != nil {
+		in, out := &in.RecordZoneIDRef, &out.RecordZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecordZoneIDSelector != nil {
+		in, out := &in.RecordZoneIDSelector, &out.RecordZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameVerificationParameters.
+func (in *HostnameVerificationParameters) DeepCopy() *HostnameVerificationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameVerificationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameVerificationSpec) DeepCopyInto(out *HostnameVerificationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameVerificationSpec.
+func (in *HostnameVerificationSpec) DeepCopy() *HostnameVerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameVerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameVerificationStatus) DeepCopyInto(out *HostnameVerificationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameVerificationStatus.
+func (in *HostnameVerificationStatus) DeepCopy() *HostnameVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeylessServerInitParameters) DeepCopyInto(out *KeylessServerInitParameters) {
 	*out = *in
@@ -849,6 +1010,21 @@ func (in *OwnershipVerificationParameters) DeepCopy() *OwnershipVerificationPara
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnershipVerificationRecord) DeepCopyInto(out *OwnershipVerificationRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnershipVerificationRecord.
+func (in *OwnershipVerificationRecord) DeepCopy() *OwnershipVerificationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnershipVerificationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Pages) DeepCopyInto(out *Pages) {
 	*out = *in
@@ -1547,6 +1723,163 @@ func (in *SSLParameters_2) DeepCopy() *SSLParameters_2 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLSecret) DeepCopyInto(out *SSLSecret) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLSecret.
+func (in *SSLSecret) DeepCopy() *SSLSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSLSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLSecretList) DeepCopyInto(out *SSLSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSLSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLSecretList.
+func (in *SSLSecretList) DeepCopy() *SSLSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSLSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLSecretObservation) DeepCopyInto(out *SSLSecretObservation) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresOn != nil {
+		in, out := &in.ExpiresOn, &out.ExpiresOn
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUploadTime != nil {
+		in, out := &in.LastUploadTime, &out.LastUploadTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLSecretObservation.
+func (in *SSLSecretObservation) DeepCopy() *SSLSecretObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLSecretObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLSecretParameters) DeepCopyInto(out *SSLSecretParameters) {
+	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+	if in.GeoRestrictions != nil {
+		in, out := &in.GeoRestrictions, &out.GeoRestrictions
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLSecretParameters.
+func (in *SSLSecretParameters) DeepCopy() *SSLSecretParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLSecretParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLSecretSpec) DeepCopyInto(out *SSLSecretSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLSecretSpec.
+func (in *SSLSecretSpec) DeepCopy() *SSLSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLSecretStatus) DeepCopyInto(out *SSLSecretStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLSecretStatus.
+func (in *SSLSecretStatus) DeepCopy() *SSLSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSLSpec) DeepCopyInto(out *SSLSpec) {
 	*out = *in
@@ -1774,3 +2107,23 @@ func (in *TunnelParameters) DeepCopy() *TunnelParameters {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationRecord) DeepCopyInto(out *ValidationRecord) {
+	*out = *in
+	if in.Emails != nil {
+		in, out := &in.Emails, &out.Emails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationRecord.
+func (in *ValidationRecord) DeepCopy() *ValidationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -26,6 +26,15 @@ func (l *HostnameList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this HostnameVerificationList.
+func (l *HostnameVerificationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this PagesList.
 func (l *PagesList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -43,3 +52,12 @@ func (l *SSLList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this SSLSecretList.
+func (l *SSLSecretList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
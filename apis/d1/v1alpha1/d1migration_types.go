@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// D1MigrationParameters defines the desired state of a D1Migration
+type D1MigrationParameters struct {
+	// AccountID is the Cloudflare account ID.
+	// +kubebuilder:validation:Required
+	AccountID string `json:"accountId"`
+
+	// DatabaseID is the identifier of the D1 database to migrate.
+	// +crossplane:generate:reference:type=Database
+	// +kubebuilder:validation:Optional
+	DatabaseID *string `json:"databaseId,omitempty"`
+
+	// Reference to a Database in d1 to populate databaseId.
+	// +kubebuilder:validation:Optional
+	DatabaseIDRef *xpv1.Reference `json:"databaseIdRef,omitempty"`
+
+	// Selector for a Database in d1 to populate databaseId.
+	// +kubebuilder:validation:Optional
+	DatabaseIDSelector *xpv1.Selector `json:"databaseIdSelector,omitempty"`
+
+	// ConfigMapRef references the ConfigMap holding the migrations. Every
+	// key ending in .sql is a migration, applied in the lexical order of the
+	// keys, e.g. 0001_create_users.sql before 0002_add_email.sql.
+	// +kubebuilder:validation:Required
+	ConfigMapRef ConfigMapReference `json:"configMapRef"`
+
+	// MigrationsTable is the table recording the applied migrations.
+	// Defaults to d1_migrations, the table used by wrangler.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="d1_migrations"
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	MigrationsTable string `json:"migrationsTable,omitempty"`
+}
+
+// ConfigMapReference references a ConfigMap by name and namespace.
+type ConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+}
+
+// D1MigrationObservation defines the observed state of a D1Migration
+type D1MigrationObservation struct {
+	// Head is the name of the last applied migration.
+	Head string `json:"head,omitempty"`
+
+	// Applied is the number of applied migrations.
+	Applied int `json:"applied,omitempty"`
+
+	// Pending are the migrations that are not applied yet.
+	Pending []string `json:"pending,omitempty"`
+
+	// FailedMigration is the migration that failed to apply.
+	FailedMigration string `json:"failedMigration,omitempty"`
+
+	// Error is the SQL error of the failed migration.
+	Error string `json:"error,omitempty"`
+}
+
+// D1MigrationSpec defines the desired state of D1Migration
+type D1MigrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       D1MigrationParameters `json:"forProvider"`
+}
+
+// D1MigrationStatus defines the observed state of D1Migration
+type D1MigrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          D1MigrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="HEAD",type="string",JSONPath=".status.atProvider.head"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// D1Migration is the Schema for the D1Migration API.
+// It applies the pending SQL migrations of a ConfigMap to a D1 database
+// through the D1 query API and records them in a migrations table. D1 does
+// not apply a migration in a transaction, so a migration that fails
+// part-way is left partly applied; prefer statements such as CREATE TABLE IF
+// NOT EXISTS that can be applied again once the migration is fixed.
+type D1Migration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              D1MigrationSpec   `json:"spec"`
+	Status            D1MigrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// D1MigrationList contains a list of D1Migrations
+type D1MigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []D1Migration `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	D1Migration_Kind             = "D1Migration"
+	D1Migration_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: D1Migration_Kind}.String()
+	D1Migration_KindAPIVersion   = D1Migration_Kind + "." + CRDGroupVersion.String()
+	D1Migration_GroupVersionKind = CRDGroupVersion.WithKind(D1Migration_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&D1Migration{}, &D1MigrationList{})
+}
+
+func (mg *D1Migration) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *D1Migration) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *D1Migration) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *D1Migration) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *D1Migration) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *D1Migration) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *D1Migration) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *D1Migration) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *D1Migration) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *D1Migration) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
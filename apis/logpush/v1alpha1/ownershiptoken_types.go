@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// OwnershipTokenParameters defines the desired state of an OwnershipToken
+type OwnershipTokenParameters struct {
+	// AccountID is the account of the Logpush job. Mutually exclusive with
+	// zoneId.
+	// +kubebuilder:validation:Optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// ZoneID is the zone of the Logpush job. Mutually exclusive with
+	// accountId.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Zone
+	// +kubebuilder:validation:Optional
+	ZoneID *string `json:"zoneId,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDRef *xpv1.Reference `json:"zoneIdRef,omitempty"`
+
+	// Selector for a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDSelector *xpv1.Selector `json:"zoneIdSelector,omitempty"`
+
+	// DestinationConf is the destination of the Logpush job, e.g.
+	// r2://bucket/logs?account-id=...&access-key-id=...&secret-access-key=...
+	// or s3://bucket/logs?region=us-east-1. Only R2 and S3 compatible
+	// destinations are supported.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(r2|s3)://`
+	DestinationConf string `json:"destinationConf"`
+
+	// CredentialsSecretRef references a Secret with the access_key_id,
+	// secret_access_key and optional endpoint keys used to read the
+	// challenge file from the bucket, such as the connection secret of an
+	// R2 Credentials resource. Defaults to the access keys of the
+	// destination, or for R2 to credentials derived from the api_token of
+	// the ProviderConfig.
+	// +kubebuilder:validation:Optional
+	CredentialsSecretRef *xpv1.SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// Endpoint overrides the URL of the S3 API the challenge file is read
+	// from, e.g. a local S3 stand-in.
+	// +kubebuilder:validation:Optional
+	Endpoint *string `json:"endpoint,omitempty"`
+}
+
+// OwnershipTokenObservation defines the observed state of an
+// OwnershipToken
+type OwnershipTokenObservation struct {
+	// OwnershipChallenge is the name of the OwnershipChallenge requesting
+	// the challenge.
+	OwnershipChallenge string `json:"ownershipChallenge,omitempty"`
+
+	// Filename is the key of the challenge file in the bucket.
+	Filename string `json:"filename,omitempty"`
+
+	// Valid reports whether Cloudflare accepts the token for the
+	// destination.
+	Valid bool `json:"valid,omitempty"`
+}
+
+// OwnershipTokenSpec defines the desired state of OwnershipToken
+type OwnershipTokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OwnershipTokenParameters `json:"forProvider"`
+}
+
+// OwnershipTokenStatus defines the observed state of OwnershipToken
+type OwnershipTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OwnershipTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="VALID",type="boolean",JSONPath=".status.atProvider.valid"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// OwnershipToken is the Schema for the OwnershipToken API.
+// It requests an ownership challenge for a Logpush destination through an
+// OwnershipChallenge resource, reads the challenge file Cloudflare writes
+// to the destination bucket, and publishes the token as the
+// ownership_challenge connection detail, to be referenced by the
+// ownershipChallengeSecretRef of a Job. It is ready once Cloudflare
+// accepts the token.
+type OwnershipToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self.forProvider.destinationConf == oldSelf.forProvider.destinationConf",message="destinationConf is immutable"
+	Spec   OwnershipTokenSpec   `json:"spec"`
+	Status OwnershipTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OwnershipTokenList contains a list of OwnershipTokens
+type OwnershipTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OwnershipToken `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	OwnershipToken_Kind             = "OwnershipToken"
+	OwnershipToken_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: OwnershipToken_Kind}.String()
+	OwnershipToken_KindAPIVersion   = OwnershipToken_Kind + "." + CRDGroupVersion.String()
+	OwnershipToken_GroupVersionKind = CRDGroupVersion.WithKind(OwnershipToken_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OwnershipToken{}, &OwnershipTokenList{})
+}
+
+func (mg *OwnershipToken) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *OwnershipToken) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *OwnershipToken) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *OwnershipToken) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *OwnershipToken) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *OwnershipToken) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *OwnershipToken) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *OwnershipToken) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *OwnershipToken) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *OwnershipToken) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
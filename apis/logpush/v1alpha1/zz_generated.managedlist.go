@@ -25,3 +25,12 @@ func (l *OwnershipChallengeList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this OwnershipTokenList.
+func (l *OwnershipTokenList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// CACertificateSecretParameters defines the desired state of a
+// CACertificateSecret
+type CACertificateSecretParameters struct {
+	// Hostnames are the hostnames and wildcards the certificate is issued
+	// for, e.g. example.com and *.example.com.
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+
+	// KeyType is the type of the private key generated for the certificate,
+	// ecdsa for a P-256 key or rsa for a 2048-bit key.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ecdsa;rsa
+	// +kubebuilder:default=ecdsa
+	KeyType string `json:"keyType,omitempty"`
+
+	// ValidityDays is the number of days the certificate is valid for.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=7;30;90;365;730;1095;5475
+	// +kubebuilder:default=5475
+	ValidityDays int `json:"validityDays,omitempty"`
+
+	// RenewBefore is how long before expiry the certificate is replaced by
+	// a new one, with a new key.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="720h"
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// SecretRef is the kubernetes.io/tls Secret the private key, the
+	// certificate and the Origin CA root certificate are written to, as
+	// tls.key, tls.crt and ca.crt.
+	// +kubebuilder:validation:Required
+	SecretRef xpv1.SecretReference `json:"secretRef"`
+}
+
+// CACertificateSecretObservation defines the observed state of a
+// CACertificateSecret
+type CACertificateSecretObservation struct {
+	// CertificateID is the ID of the current certificate.
+	CertificateID string `json:"certificateId,omitempty"`
+
+	// SerialNumber is the hexadecimal serial number of the current
+	// certificate.
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// Hostnames are the hostnames the current certificate is issued for.
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// ExpiresOn is when the current certificate expires.
+	ExpiresOn *metav1.Time `json:"expiresOn,omitempty"`
+
+	// RenewsOn is when the current certificate is renewed.
+	RenewsOn *metav1.Time `json:"renewsOn,omitempty"`
+
+	// LastIssueTime is when the current certificate was issued.
+	LastIssueTime *metav1.Time `json:"lastIssueTime,omitempty"`
+}
+
+// CACertificateSecretSpec defines the desired state of CACertificateSecret
+type CACertificateSecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CACertificateSecretParameters `json:"forProvider"`
+}
+
+// CACertificateSecretStatus defines the observed state of CACertificateSecret
+type CACertificateSecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CACertificateSecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SECRET",type="string",JSONPath=".spec.forProvider.secretRef.name"
+// +kubebuilder:printcolumn:name="EXPIRES",type="date",JSONPath=".status.atProvider.expiresOn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// CACertificateSecret is the Schema for the CACertificateSecret API.
+// It generates a private key and CSR, has the Origin CA issue a certificate
+// for them, writes both to a kubernetes.io/tls Secret and replaces the
+// certificate before it expires.
+type CACertificateSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CACertificateSecretSpec   `json:"spec"`
+	Status            CACertificateSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CACertificateSecretList contains a list of CACertificateSecrets
+type CACertificateSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CACertificateSecret `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	CACertificateSecret_Kind             = "CACertificateSecret"
+	CACertificateSecret_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: CACertificateSecret_Kind}.String()
+	CACertificateSecret_KindAPIVersion   = CACertificateSecret_Kind + "." + CRDGroupVersion.String()
+	CACertificateSecret_GroupVersionKind = CRDGroupVersion.WithKind(CACertificateSecret_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CACertificateSecret{}, &CACertificateSecretList{})
+}
+
+func (mg *CACertificateSecret) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *CACertificateSecret) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *CACertificateSecret) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *CACertificateSecret) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *CACertificateSecret) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *CACertificateSecret) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *CACertificateSecret) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *CACertificateSecret) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *CACertificateSecret) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *CACertificateSecret) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
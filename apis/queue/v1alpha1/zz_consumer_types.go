@@ -24,12 +24,31 @@ type ConsumerInitParameters struct {
 
 	// (String) A Resource identifier.
 	// A Resource identifier.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Queue
 	QueueID *string `json:"queueId,omitempty" tf:"queue_id,omitempty"`
 
+	// Reference to a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDRef *v1.Reference `json:"queueIdRef,omitempty" tf:"-"`
+
+	// Selector for a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDSelector *v1.Selector `json:"queueIdSelector,omitempty" tf:"-"`
+
 	// (String) Name of a Worker
 	// Name of a Worker
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	ScriptName *string `json:"scriptName,omitempty" tf:"script_name,omitempty"`
 
+	// Reference to a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameRef *v1.Reference `json:"scriptNameRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameSelector *v1.Selector `json:"scriptNameSelector,omitempty" tf:"-"`
+
 	// (Attributes) (see below for nested schema)
 	Settings *SettingsInitParameters `json:"settings,omitempty" tf:"settings,omitempty"`
 
@@ -89,14 +108,33 @@ type ConsumerParameters struct {
 
 	// (String) A Resource identifier.
 	// A Resource identifier.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Queue
 	// +kubebuilder:validation:Optional
 	QueueID *string `json:"queueId,omitempty" tf:"queue_id,omitempty"`
 
+	// Reference to a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDRef *v1.Reference `json:"queueIdRef,omitempty" tf:"-"`
+
+	// Selector for a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDSelector *v1.Selector `json:"queueIdSelector,omitempty" tf:"-"`
+
 	// (String) Name of a Worker
 	// Name of a Worker
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	// +kubebuilder:validation:Optional
 	ScriptName *string `json:"scriptName,omitempty" tf:"script_name,omitempty"`
 
+	// Reference to a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameRef *v1.Reference `json:"scriptNameRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameSelector *v1.Selector `json:"scriptNameSelector,omitempty" tf:"-"`
+
 	// (Attributes) (see below for nested schema)
 	// +kubebuilder:validation:Optional
 	Settings *SettingsParameters `json:"settings,omitempty" tf:"settings,omitempty"`
@@ -231,7 +269,6 @@ type Consumer struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.accountId) || (has(self.initProvider) && has(self.initProvider.accountId))",message="spec.forProvider.accountId is a required parameter"
-	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.queueId) || (has(self.initProvider) && has(self.initProvider.queueId))",message="spec.forProvider.queueId is a required parameter"
 	Spec   ConsumerSpec   `json:"spec"`
 	Status ConsumerStatus `json:"status,omitempty"`
 }
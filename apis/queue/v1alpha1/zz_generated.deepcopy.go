@@ -9,6 +9,7 @@
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -57,11 +58,31 @@ func (in *ConsumerInitParameters) DeepCopyInto(out *ConsumerInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.QueueIDRef != nil {
+		in, out := &in.QueueIDRef, &out.QueueIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueIDSelector != nil {
+		in, out := &in.QueueIDSelector, &out.QueueIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ScriptName != nil {
 		in, out := &in.ScriptName, &out.ScriptName
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScriptNameRef != nil {
+		in, out := &in.ScriptNameRef, &out.ScriptNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScriptNameSelector != nil {
+		in, out := &in.ScriptNameSelector, &out.ScriptNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Settings != nil {
 		in, out := &in.Settings, &out.Settings
 		*out = new(SettingsInitParameters)
@@ -199,11 +220,31 @@ func (in *ConsumerParameters) DeepCopyInto(out *ConsumerParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.QueueIDRef != nil {
+		in, out := &in.QueueIDRef, &out.QueueIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueIDSelector != nil {
+		in, out := &in.QueueIDSelector, &out.QueueIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ScriptName != nil {
 		in, out := &in.ScriptName, &out.ScriptName
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScriptNameRef != nil {
+		in, out := &in.ScriptNameRef, &out.ScriptNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScriptNameSelector != nil {
+		in, out := &in.ScriptNameSelector, &out.ScriptNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Settings != nil {
 		in, out := &in.Settings, &out.Settings
 		*out = new(SettingsParameters)
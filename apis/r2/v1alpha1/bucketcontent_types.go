@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// BucketContentParameters defines the desired state of a BucketContent
+type BucketContentParameters struct {
+	// AccountID is the Cloudflare account ID.
+	// +kubebuilder:validation:Required
+	AccountID string `json:"accountId"`
+
+	// Bucket is the name of the R2 bucket the objects are uploaded to.
+	// +crossplane:generate:reference:type=Bucket
+	// +kubebuilder:validation:Optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// Reference to a Bucket in r2 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// Selector for a Bucket in r2 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Jurisdiction of the bucket, e.g. eu or fedramp.
+	// +kubebuilder:validation:Optional
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+
+	// CredentialsSecretRef references a Secret with the access_key_id,
+	// secret_access_key and optional endpoint keys used to access the
+	// bucket, such as the connection secret of an R2 Credentials resource.
+	// Defaults to credentials derived from the api_token of the
+	// ProviderConfig.
+	// +kubebuilder:validation:Optional
+	CredentialsSecretRef *ObjectReference `json:"credentialsSecretRef,omitempty"`
+
+	// Sources are the ConfigMaps and Secrets whose keys are uploaded as
+	// objects. A key of a later source overrides the same key of an
+	// earlier one.
+	// +kubebuilder:validation:MinItems=1
+	Sources []BucketContentSource `json:"sources"`
+
+	// PrunePrefix enables pruning of the objects under the given prefix,
+	// e.g. assets/. Objects under the prefix that are not uploaded from
+	// the sources are deleted, including objects written by other clients.
+	// +kubebuilder:validation:Optional
+	PrunePrefix *string `json:"prunePrefix,omitempty"`
+}
+
+// BucketContentSource is a ConfigMap or Secret uploaded to an R2 bucket.
+type BucketContentSource struct {
+	// ConfigMapRef references a ConfigMap whose data are uploaded.
+	// +kubebuilder:validation:Optional
+	ConfigMapRef *ObjectReference `json:"configMapRef,omitempty"`
+
+	// SecretRef references a Secret whose data are uploaded.
+	// +kubebuilder:validation:Optional
+	SecretRef *ObjectReference `json:"secretRef,omitempty"`
+
+	// Prefix is prepended to the keys of the source to form the object
+	// keys, e.g. assets/.
+	// +kubebuilder:validation:Optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// ContentType of the objects of the source. Defaults to the type
+	// implied by the extension of each key.
+	// +kubebuilder:validation:Optional
+	ContentType string `json:"contentType,omitempty"`
+
+	// CacheControl of the objects of the source, e.g. public, max-age=3600.
+	// +kubebuilder:validation:Optional
+	CacheControl string `json:"cacheControl,omitempty"`
+
+	// Metadata is the custom metadata of the objects of the source.
+	// +kubebuilder:validation:Optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ObjectReference references a ConfigMap or Secret by name and namespace.
+type ObjectReference struct {
+	// Name of the object.
+	Name string `json:"name"`
+
+	// Namespace of the object.
+	Namespace string `json:"namespace"`
+}
+
+// BucketContentObservation defines the observed state of a BucketContent
+type BucketContentObservation struct {
+	// Objects maps the keys of the objects uploaded by this resource to
+	// their ETag.
+	Objects map[string]string `json:"objects,omitempty"`
+
+	// ObjectCount is the number of objects uploaded by this resource.
+	ObjectCount int `json:"objectCount,omitempty"`
+
+	// LastSyncTime is when objects were last uploaded or deleted.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// BucketContentSpec defines the desired state of BucketContent
+type BucketContentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BucketContentParameters `json:"forProvider"`
+}
+
+// BucketContentStatus defines the observed state of BucketContent
+type BucketContentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketContentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="OBJECTS",type="integer",JSONPath=".status.atProvider.objectCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// BucketContent is the Schema for the BucketContent API.
+// It uploads the keys of ConfigMaps and Secrets as objects of an R2 bucket
+// through the S3 API and re-uploads objects whose ETag drifted.
+type BucketContent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              BucketContentSpec   `json:"spec"`
+	Status            BucketContentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketContentList contains a list of BucketContents
+type BucketContentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketContent `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	BucketContent_Kind             = "BucketContent"
+	BucketContent_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: BucketContent_Kind}.String()
+	BucketContent_KindAPIVersion   = BucketContent_Kind + "." + CRDGroupVersion.String()
+	BucketContent_GroupVersionKind = CRDGroupVersion.WithKind(BucketContent_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BucketContent{}, &BucketContentList{})
+}
+
+func (mg *BucketContent) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *BucketContent) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *BucketContent) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *BucketContent) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *BucketContent) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *BucketContent) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *BucketContent) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *BucketContent) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *BucketContent) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *BucketContent) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -19,6 +19,10 @@ type BucketInitParameters struct {
 	// Account ID.
 	AccountID *string `json:"accountId,omitempty" tf:"account_id,omitempty"`
 
+	// (Boolean) Delete all objects and abort all incomplete multipart uploads of the bucket through the S3 API before deleting it. The progress is reported in the ForceDestroy condition.
+	// Delete all objects and abort all incomplete multipart uploads of the bucket through the S3 API before deleting it. The progress is reported in the ForceDestroy condition.
+	ForceDestroy *bool `json:"forceDestroy,omitempty" tf:"force_destroy,omitempty"`
+
 	// (String) Jurisdiction where objects in this bucket are guaranteed to be stored.
 	// Available values: "default", "eu", "fedramp".
 	// Jurisdiction where objects in this bucket are guaranteed to be stored.
@@ -34,6 +38,10 @@ type BucketInitParameters struct {
 	// Name of the bucket.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
 
+	// (Attributes) Secret with the access_key_id, secret_access_key and optional endpoint keys used to empty the bucket, such as the connection secret of an R2 Credentials resource. The endpoint may point at any S3 compatible server. Defaults to credentials derived from the api_token of the ProviderConfig.
+	// Secret with the access_key_id, secret_access_key and optional endpoint keys used to empty the bucket, such as the connection secret of an R2 Credentials resource. The endpoint may point at any S3 compatible server. Defaults to credentials derived from the api_token of the ProviderConfig.
+	S3CredentialsSecretRef *S3CredentialsSecretRefInitParameters `json:"s3CredentialsSecretRef,omitempty" tf:"s3_credentials_secret_ref,omitempty"`
+
 	// (String) Storage class for newly uploaded objects, unless specified otherwise.
 	// Available values: "Standard", "InfrequentAccess".
 	// Storage class for newly uploaded objects, unless specified otherwise.
@@ -51,6 +59,10 @@ type BucketObservation struct {
 	// Creation timestamp.
 	CreationDate *string `json:"creationDate,omitempty" tf:"creation_date,omitempty"`
 
+	// (Boolean) Delete all objects and abort all incomplete multipart uploads of the bucket through the S3 API before deleting it. The progress is reported in the ForceDestroy condition.
+	// Delete all objects and abort all incomplete multipart uploads of the bucket through the S3 API before deleting it. The progress is reported in the ForceDestroy condition.
+	ForceDestroy *bool `json:"forceDestroy,omitempty" tf:"force_destroy,omitempty"`
+
 	// (String) Name of the bucket.
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
@@ -69,6 +81,10 @@ type BucketObservation struct {
 	// Name of the bucket.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
 
+	// (Attributes) Secret with the access_key_id, secret_access_key and optional endpoint keys used to empty the bucket, such as the connection secret of an R2 Credentials resource. The endpoint may point at any S3 compatible server. Defaults to credentials derived from the api_token of the ProviderConfig.
+	// Secret with the access_key_id, secret_access_key and optional endpoint keys used to empty the bucket, such as the connection secret of an R2 Credentials resource. The endpoint may point at any S3 compatible server. Defaults to credentials derived from the api_token of the ProviderConfig.
+	S3CredentialsSecretRef *S3CredentialsSecretRefObservation `json:"s3CredentialsSecretRef,omitempty" tf:"s3_credentials_secret_ref,omitempty"`
+
 	// (String) Storage class for newly uploaded objects, unless specified otherwise.
 	// Available values: "Standard", "InfrequentAccess".
 	// Storage class for newly uploaded objects, unless specified otherwise.
@@ -83,6 +99,11 @@ type BucketParameters struct {
 	// +kubebuilder:validation:Optional
 	AccountID *string `json:"accountId,omitempty" tf:"account_id,omitempty"`
 
+	// (Boolean) Delete all objects and abort all incomplete multipart uploads of the bucket through the S3 API before deleting it. The progress is reported in the ForceDestroy condition.
+	// Delete all objects and abort all incomplete multipart uploads of the bucket through the S3 API before deleting it. The progress is reported in the ForceDestroy condition.
+	// +kubebuilder:validation:Optional
+	ForceDestroy *bool `json:"forceDestroy,omitempty" tf:"force_destroy,omitempty"`
+
 	// (String) Jurisdiction where objects in this bucket are guaranteed to be stored.
 	// Available values: "default", "eu", "fedramp".
 	// Jurisdiction where objects in this bucket are guaranteed to be stored.
@@ -101,6 +122,11 @@ type BucketParameters struct {
 	// +kubebuilder:validation:Optional
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
 
+	// (Attributes) Secret with the access_key_id, secret_access_key and optional endpoint keys used to empty the bucket, such as the connection secret of an R2 Credentials resource. The endpoint may point at any S3 compatible server. Defaults to credentials derived from the api_token of the ProviderConfig.
+	// Secret with the access_key_id, secret_access_key and optional endpoint keys used to empty the bucket, such as the connection secret of an R2 Credentials resource. The endpoint may point at any S3 compatible server. Defaults to credentials derived from the api_token of the ProviderConfig.
+	// +kubebuilder:validation:Optional
+	S3CredentialsSecretRef *S3CredentialsSecretRefParameters `json:"s3CredentialsSecretRef,omitempty" tf:"s3_credentials_secret_ref,omitempty"`
+
 	// (String) Storage class for newly uploaded objects, unless specified otherwise.
 	// Available values: "Standard", "InfrequentAccess".
 	// Storage class for newly uploaded objects, unless specified otherwise.
@@ -109,6 +135,41 @@ type BucketParameters struct {
 	StorageClass *string `json:"storageClass,omitempty" tf:"storage_class,omitempty"`
 }
 
+type S3CredentialsSecretRefInitParameters struct {
+
+	// (String) Name of the Secret.
+	// Name of the Secret.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Namespace of the Secret.
+	// Namespace of the Secret.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type S3CredentialsSecretRefObservation struct {
+
+	// (String) Name of the Secret.
+	// Name of the Secret.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Namespace of the Secret.
+	// Namespace of the Secret.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type S3CredentialsSecretRefParameters struct {
+
+	// (String) Name of the Secret.
+	// Name of the Secret.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (String) Namespace of the Secret.
+	// Namespace of the Secret.
+	// +kubebuilder:validation:Optional
+	Namespace *string `json:"namespace" tf:"namespace,omitempty"`
+}
+
 // BucketSpec defines the desired state of Bucket
 type BucketSpec struct {
 	v1.ResourceSpec `json:",inline"`
@@ -29,8 +29,17 @@ type BucketEventNotificationInitParameters struct {
 
 	// (String) Queue ID.
 	// Queue ID.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Queue
 	QueueID *string `json:"queueId,omitempty" tf:"queue_id,omitempty"`
 
+	// Reference to a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDRef *v1.Reference `json:"queueIdRef,omitempty" tf:"-"`
+
+	// Selector for a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDSelector *v1.Selector `json:"queueIdSelector,omitempty" tf:"-"`
+
 	// (Attributes List) Array of rules to drive notifications. (see below for nested schema)
 	Rules []BucketEventNotificationRulesInitParameters `json:"rules,omitempty" tf:"rules,omitempty"`
 }
@@ -82,9 +91,18 @@ type BucketEventNotificationParameters struct {
 
 	// (String) Queue ID.
 	// Queue ID.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Queue
 	// +kubebuilder:validation:Optional
 	QueueID *string `json:"queueId,omitempty" tf:"queue_id,omitempty"`
 
+	// Reference to a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDRef *v1.Reference `json:"queueIdRef,omitempty" tf:"-"`
+
+	// Selector for a Queue in cloudflare to populate queueId.
+	// +kubebuilder:validation:Optional
+	QueueIDSelector *v1.Selector `json:"queueIdSelector,omitempty" tf:"-"`
+
 	// (Attributes List) Array of rules to drive notifications. (see below for nested schema)
 	// +kubebuilder:validation:Optional
 	Rules []BucketEventNotificationRulesParameters `json:"rules,omitempty" tf:"rules,omitempty"`
@@ -189,7 +207,6 @@ type BucketEventNotification struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.accountId) || (has(self.initProvider) && has(self.initProvider.accountId))",message="spec.forProvider.accountId is a required parameter"
 	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.bucketName) || (has(self.initProvider) && has(self.initProvider.bucketName))",message="spec.forProvider.bucketName is a required parameter"
-	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.queueId) || (has(self.initProvider) && has(self.initProvider.queueId))",message="spec.forProvider.queueId is a required parameter"
 	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.rules) || (has(self.initProvider) && has(self.initProvider.rules))",message="spec.forProvider.rules is a required parameter"
 	Spec   BucketEventNotificationSpec   `json:"spec"`
 	Status BucketEventNotificationStatus `json:"status,omitempty"`
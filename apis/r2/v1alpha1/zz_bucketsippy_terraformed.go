@@ -21,7 +21,7 @@ func (mg *BucketSippy) GetTerraformResourceType() string {
 
 // GetConnectionDetailsMapping for this BucketSippy
 func (tr *BucketSippy) GetConnectionDetailsMapping() map[string]string {
-	return map[string]string{"destination.secret_access_key": "destination.secretAccessKeySecretRef", "source.private_key": "source.privateKeySecretRef", "source.secret_access_key": "source.secretAccessKeySecretRef"}
+	return map[string]string{"destination.access_key_id": "destination.accessKeyIdSecretRef", "destination.secret_access_key": "destination.secretAccessKeySecretRef", "source.access_key_id": "source.accessKeyIdSecretRef", "source.client_email": "source.clientEmailSecretRef", "source.private_key": "source.privateKeySecretRef", "source.secret_access_key": "source.secretAccessKeySecretRef"}
 }
 
 // GetObservation of this BucketSippy
@@ -98,7 +98,7 @@ type DestinationInitParameters struct {
 	//
 	// Sippy will use this token when writing objects to R2, so it is
 	// best to scope this token to the bucket you're enabling Sippy for.
-	AccessKeyID *string `json:"accessKeyId,omitempty" tf:"access_key_id,omitempty"`
+	AccessKeyIDSecretRef *v1.SecretKeySelector `json:"accessKeyIdSecretRef,omitempty" tf:"-"`
 
 	// (String) Available values: "r2".
 	// Available values: "r2".
@@ -118,17 +118,6 @@ type DestinationInitParameters struct {
 
 type DestinationObservation struct {
 
-	// (String) ID of a Cloudflare API token.
-	// This is the value labelled "Access Key ID" when creating an API.
-	// token from the R2 dashboard.
-	// ID of a Cloudflare API token.
-	// This is the value labelled "Access Key ID" when creating an API.
-	// token from the [R2 dashboard](https://dash.cloudflare.com/?to=/:account/r2/api-tokens).
-	//
-	// Sippy will use this token when writing objects to R2, so it is
-	// best to scope this token to the bucket you're enabling Sippy for.
-	AccessKeyID *string `json:"accessKeyId,omitempty" tf:"access_key_id,omitempty"`
-
 	// (String) Available values: "r2".
 	// Available values: "r2".
 	CloudProvider *string `json:"cloudProvider,omitempty" tf:"cloud_provider,omitempty"`
@@ -146,7 +135,7 @@ type DestinationParameters struct {
 	// Sippy will use this token when writing objects to R2, so it is
 	// best to scope this token to the bucket you're enabling Sippy for.
 	// +kubebuilder:validation:Optional
-	AccessKeyID *string `json:"accessKeyId,omitempty" tf:"access_key_id,omitempty"`
+	AccessKeyIDSecretRef *v1.SecretKeySelector `json:"accessKeyIdSecretRef,omitempty" tf:"-"`
 
 	// (String) Available values: "r2".
 	// Available values: "r2".
@@ -172,7 +161,7 @@ type SourceInitParameters struct {
 	// This is the value labelled "Access Key ID" when creating an API.
 	// token from the R2 dashboard.
 	// Access Key ID of an IAM credential (ideally scoped to a single S3 bucket).
-	AccessKeyID *string `json:"accessKeyId,omitempty" tf:"access_key_id,omitempty"`
+	AccessKeyIDSecretRef *v1.SecretKeySelector `json:"accessKeyIdSecretRef,omitempty" tf:"-"`
 
 	// (String) Name of the AWS S3 bucket.
 	// Name of the AWS S3 bucket.
@@ -184,7 +173,7 @@ type SourceInitParameters struct {
 
 	// (String) Client email of an IAM credential (ideally scoped to a single GCS bucket).
 	// Client email of an IAM credential (ideally scoped to a single GCS bucket).
-	ClientEmail *string `json:"clientEmail,omitempty" tf:"client_email,omitempty"`
+	ClientEmailSecretRef *v1.SecretKeySelector `json:"clientEmailSecretRef,omitempty" tf:"-"`
 
 	// (String) Available values: "r2".
 	// Available values: "aws", "gcs", "s3".
@@ -207,12 +196,6 @@ type SourceInitParameters struct {
 
 type SourceObservation struct {
 
-	// (String) ID of a Cloudflare API token.
-	// This is the value labelled "Access Key ID" when creating an API.
-	// token from the R2 dashboard.
-	// Access Key ID of an IAM credential (ideally scoped to a single S3 bucket).
-	AccessKeyID *string `json:"accessKeyId,omitempty" tf:"access_key_id,omitempty"`
-
 	// (String) Name of the AWS S3 bucket.
 	// Name of the AWS S3 bucket.
 	Bucket *string `json:"bucket,omitempty" tf:"bucket,omitempty"`
@@ -221,10 +204,6 @@ type SourceObservation struct {
 	// URL to the S3-compatible API of the bucket.
 	BucketURL *string `json:"bucketUrl,omitempty" tf:"bucket_url,omitempty"`
 
-	// (String) Client email of an IAM credential (ideally scoped to a single GCS bucket).
-	// Client email of an IAM credential (ideally scoped to a single GCS bucket).
-	ClientEmail *string `json:"clientEmail,omitempty" tf:"client_email,omitempty"`
-
 	// (String) Available values: "r2".
 	// Available values: "aws", "gcs", "s3".
 	CloudProvider *string `json:"cloudProvider,omitempty" tf:"cloud_provider,omitempty"`
@@ -241,7 +220,7 @@ type SourceParameters struct {
 	// token from the R2 dashboard.
 	// Access Key ID of an IAM credential (ideally scoped to a single S3 bucket).
 	// +kubebuilder:validation:Optional
-	AccessKeyID *string `json:"accessKeyId,omitempty" tf:"access_key_id,omitempty"`
+	AccessKeyIDSecretRef *v1.SecretKeySelector `json:"accessKeyIdSecretRef,omitempty" tf:"-"`
 
 	// (String) Name of the AWS S3 bucket.
 	// Name of the AWS S3 bucket.
@@ -256,7 +235,7 @@ type SourceParameters struct {
 	// (String) Client email of an IAM credential (ideally scoped to a single GCS bucket).
 	// Client email of an IAM credential (ideally scoped to a single GCS bucket).
 	// +kubebuilder:validation:Optional
-	ClientEmail *string `json:"clientEmail,omitempty" tf:"client_email,omitempty"`
+	ClientEmailSecretRef *v1.SecretKeySelector `json:"clientEmailSecretRef,omitempty" tf:"-"`
 
 	// (String) Available values: "r2".
 	// Available values: "aws", "gcs", "s3".
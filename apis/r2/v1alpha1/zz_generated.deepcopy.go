@@ -244,6 +244,204 @@ func (in *Bucket) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketContent) DeepCopyInto(out *BucketContent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketContent.
+func (in *BucketContent) DeepCopy() *BucketContent {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketContent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketContentList) DeepCopyInto(out *BucketContentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketContent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketContentList.
+func (in *BucketContentList) DeepCopy() *BucketContentList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketContentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketContentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketContentObservation) DeepCopyInto(out *BucketContentObservation) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketContentObservation.
+func (in *BucketContentObservation) DeepCopy() *BucketContentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketContentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketContentParameters) DeepCopyInto(out *BucketContentParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]BucketContentSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrunePrefix != nil {
+		in, out := &in.PrunePrefix, &out.PrunePrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketContentParameters.
+func (in *BucketContentParameters) DeepCopy() *BucketContentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketContentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketContentSource) DeepCopyInto(out *BucketContentSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketContentSource.
+func (in *BucketContentSource) DeepCopy() *BucketContentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketContentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketContentSpec) DeepCopyInto(out *BucketContentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketContentSpec.
+func (in *BucketContentSpec) DeepCopy() *BucketContentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketContentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketContentStatus) DeepCopyInto(out *BucketContentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketContentStatus.
+func (in *BucketContentStatus) DeepCopy() *BucketContentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketContentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketCors) DeepCopyInto(out *BucketCors) {
 	*out = *in
@@ -504,6 +702,16 @@ func (in *BucketEventNotificationInitParameters) DeepCopyInto(out *BucketEventNo
 		*out = new(string)
 		**out = **in
 	}
+	if in.QueueIDRef != nil {
+		in, out := &in.QueueIDRef, &out.QueueIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueIDSelector != nil {
+		in, out := &in.QueueIDSelector, &out.QueueIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]BucketEventNotificationRulesInitParameters, len(*in))
@@ -630,6 +838,16 @@ func (in *BucketEventNotificationParameters) DeepCopyInto(out *BucketEventNotifi
 		*out = new(string)
 		**out = **in
 	}
+	if in.QueueIDRef != nil {
+		in, out := &in.QueueIDRef, &out.QueueIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueIDSelector != nil {
+		in, out := &in.QueueIDSelector, &out.QueueIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]BucketEventNotificationRulesParameters, len(*in))
@@ -815,6 +1033,11 @@ func (in *BucketInitParameters) DeepCopyInto(out *BucketInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ForceDestroy != nil {
+		in, out := &in.ForceDestroy, &out.ForceDestroy
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Jurisdiction != nil {
 		in, out := &in.Jurisdiction, &out.Jurisdiction
 		*out = new(string)
@@ -830,6 +1053,11 @@ func (in *BucketInitParameters) DeepCopyInto(out *BucketInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.S3CredentialsSecretRef != nil {
+		in, out := &in.S3CredentialsSecretRef, &out.S3CredentialsSecretRef
+		*out = new(S3CredentialsSecretRefInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StorageClass != nil {
 		in, out := &in.StorageClass, &out.StorageClass
 		*out = new(string)
@@ -1558,6 +1786,11 @@ func (in *BucketObservation) DeepCopyInto(out *BucketObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ForceDestroy != nil {
+		in, out := &in.ForceDestroy, &out.ForceDestroy
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -1578,6 +1811,11 @@ func (in *BucketObservation) DeepCopyInto(out *BucketObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.S3CredentialsSecretRef != nil {
+		in, out := &in.S3CredentialsSecretRef, &out.S3CredentialsSecretRef
+		*out = new(S3CredentialsSecretRefObservation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StorageClass != nil {
 		in, out := &in.StorageClass, &out.StorageClass
 		*out = new(string)
@@ -1603,6 +1841,11 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ForceDestroy != nil {
+		in, out := &in.ForceDestroy, &out.ForceDestroy
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Jurisdiction != nil {
 		in, out := &in.Jurisdiction, &out.Jurisdiction
 		*out = new(string)
@@ -1618,6 +1861,11 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.S3CredentialsSecretRef != nil {
+		in, out := &in.S3CredentialsSecretRef, &out.S3CredentialsSecretRef
+		*out = new(S3CredentialsSecretRefParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StorageClass != nil {
 		in, out := &in.StorageClass, &out.StorageClass
 		*out = new(string)
@@ -2607,9 +2855,9 @@ func (in *DeleteObjectsTransitionParameters) DeepCopy() *DeleteObjectsTransition
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DestinationInitParameters) DeepCopyInto(out *DestinationInitParameters) {
 	*out = *in
-	if in.AccessKeyID != nil {
-		in, out := &in.AccessKeyID, &out.AccessKeyID
-		*out = new(string)
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 	if in.CloudProvider != nil {
@@ -2637,11 +2885,6 @@ func (in *DestinationInitParameters) DeepCopy() *DestinationInitParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DestinationObservation) DeepCopyInto(out *DestinationObservation) {
 	*out = *in
-	if in.AccessKeyID != nil {
-		in, out := &in.AccessKeyID, &out.AccessKeyID
-		*out = new(string)
-		**out = **in
-	}
 	if in.CloudProvider != nil {
 		in, out := &in.CloudProvider, &out.CloudProvider
 		*out = new(string)
@@ -2662,9 +2905,9 @@ func (in *DestinationObservation) DeepCopy() *DestinationObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DestinationParameters) DeepCopyInto(out *DestinationParameters) {
 	*out = *in
-	if in.AccessKeyID != nil {
-		in, out := &in.AccessKeyID, &out.AccessKeyID
-		*out = new(string)
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 	if in.CloudProvider != nil {
@@ -2903,6 +3146,21 @@ func (in *ManagedDomainStatus) DeepCopy() *ManagedDomainStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
+func (in *ObjectReference) DeepCopy() *ObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesConditionInitParameters) DeepCopyInto(out *RulesConditionInitParameters) {
 	*out = *in
@@ -3117,13 +3375,88 @@ func (in *RulesParameters) DeepCopy() *RulesParameters {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SourceInitParameters) DeepCopyInto(out *SourceInitParameters) {
+func (in *S3CredentialsSecretRefInitParameters) DeepCopyInto(out *S3CredentialsSecretRefInitParameters) {
 	*out = *in
-	if in.AccessKeyID != nil {
-		in, out := &in.AccessKeyID, &out.AccessKeyID
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3CredentialsSecretRefInitParameters.
+func (in *S3CredentialsSecretRefInitParameters) DeepCopy() *S3CredentialsSecretRefInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(S3CredentialsSecretRefInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3CredentialsSecretRefObservation) DeepCopyInto(out *S3CredentialsSecretRefObservation) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3CredentialsSecretRefObservation.
+func (in *S3CredentialsSecretRefObservation) DeepCopy() *S3CredentialsSecretRefObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(S3CredentialsSecretRefObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3CredentialsSecretRefParameters) DeepCopyInto(out *S3CredentialsSecretRefParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3CredentialsSecretRefParameters.
+func (in *S3CredentialsSecretRefParameters) DeepCopy() *S3CredentialsSecretRefParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(S3CredentialsSecretRefParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceInitParameters) DeepCopyInto(out *SourceInitParameters) {
+	*out = *in
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 	if in.Bucket != nil {
 		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
@@ -3134,9 +3467,9 @@ func (in *SourceInitParameters) DeepCopyInto(out *SourceInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClientEmail != nil {
-		in, out := &in.ClientEmail, &out.ClientEmail
-		*out = new(string)
+	if in.ClientEmailSecretRef != nil {
+		in, out := &in.ClientEmailSecretRef, &out.ClientEmailSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 	if in.CloudProvider != nil {
@@ -3174,11 +3507,6 @@ func (in *SourceInitParameters) DeepCopy() *SourceInitParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceObservation) DeepCopyInto(out *SourceObservation) {
 	*out = *in
-	if in.AccessKeyID != nil {
-		in, out := &in.AccessKeyID, &out.AccessKeyID
-		*out = new(string)
-		**out = **in
-	}
 	if in.Bucket != nil {
 		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
@@ -3189,11 +3517,6 @@ func (in *SourceObservation) DeepCopyInto(out *SourceObservation) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClientEmail != nil {
-		in, out := &in.ClientEmail, &out.ClientEmail
-		*out = new(string)
-		**out = **in
-	}
 	if in.CloudProvider != nil {
 		in, out := &in.CloudProvider, &out.CloudProvider
 		*out = new(string)
@@ -3219,9 +3542,9 @@ func (in *SourceObservation) DeepCopy() *SourceObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceParameters) DeepCopyInto(out *SourceParameters) {
 	*out = *in
-	if in.AccessKeyID != nil {
-		in, out := &in.AccessKeyID, &out.AccessKeyID
-		*out = new(string)
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 	if in.Bucket != nil {
@@ -3234,9 +3557,9 @@ func (in *SourceParameters) DeepCopyInto(out *SourceParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClientEmail != nil {
-		in, out := &in.ClientEmail, &out.ClientEmail
-		*out = new(string)
+	if in.ClientEmailSecretRef != nil {
+		in, out := &in.ClientEmailSecretRef, &out.ClientEmailSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 	if in.CloudProvider != nil {
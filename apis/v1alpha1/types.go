@@ -0,0 +1,97 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// SecretStoreType represents a type of secret store.
+type SecretStoreType string
+
+const (
+	// SecretStoreKubernetes indicates that secret store type is
+	// Kubernetes. In other words, connection secrets will be stored as K8s
+	// Secrets.
+	SecretStoreKubernetes SecretStoreType = "Kubernetes"
+
+	// SecretStorePlugin indicates that secret store type is Plugin and
+	// connection secrets will be stored through an External Secret Store
+	// plugin, such as a Vault plugin.
+	SecretStorePlugin SecretStoreType = "Plugin"
+)
+
+// A StoreConfigSpec defines the desired state of a StoreConfig.
+type StoreConfigSpec struct {
+	// Type configures which secret store to be used. Only the configuration
+	// block for this store will be used and others will be ignored if
+	// provided. Default is Kubernetes.
+	// +optional
+	// +kubebuilder:validation:Enum=Kubernetes;Plugin
+	// +kubebuilder:default=Kubernetes
+	Type *SecretStoreType `json:"type,omitempty"`
+
+	// DefaultScope used for scoping secrets for which the namespace of the
+	// connection secret is not set, e.g. the namespace of the provider.
+	DefaultScope string `json:"defaultScope"`
+
+	// Plugin configures External Secret Store as a plugin.
+	// +optional
+	Plugin *PluginStoreConfig `json:"plugin,omitempty"`
+}
+
+// PluginStoreConfig represents configuration of an External Secret Store
+// plugin.
+type PluginStoreConfig struct {
+	// Endpoint is the gRPC endpoint of the plugin, e.g.
+	// ess-plugin-vault.crossplane-system:4040.
+	Endpoint string `json:"endpoint"`
+
+	// ConfigRef contains the reference to the configuration of the plugin,
+	// which is passed to it with every request.
+	ConfigRef PluginConfigReference `json:"configRef"`
+}
+
+// PluginConfigReference refers to the configuration of an External Secret
+// Store plugin.
+type PluginConfigReference struct {
+	// APIVersion of the referenced config.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the referenced config.
+	Kind string `json:"kind"`
+
+	// Name of the referenced config.
+	Name string `json:"name"`
+}
+
+// A StoreConfigStatus represents the status of a StoreConfig.
+type StoreConfigStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StoreConfig configures how the Cloudflare controllers store connection
+// details.
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="DEFAULT-SCOPE",type="string",JSONPath=".spec.defaultScope"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,store,cloudflare}
+type StoreConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StoreConfigSpec   `json:"spec"`
+	Status StoreConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StoreConfigList contains a list of StoreConfig
+type StoreConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StoreConfig `json:"items"`
+}
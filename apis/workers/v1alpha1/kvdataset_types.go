@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// KVDataSetParameters defines the desired state of a KVDataSet
+type KVDataSetParameters struct {
+	// AccountID is the Cloudflare account ID.
+	// +kubebuilder:validation:Required
+	AccountID string `json:"accountId"`
+
+	// NamespaceID is the identifier of the Workers KV namespace the keys are
+	// written to.
+	// +crossplane:generate:reference:type=KvNamespace
+	// +kubebuilder:validation:Optional
+	NamespaceID *string `json:"namespaceId,omitempty"`
+
+	// Reference to a KvNamespace in workers to populate namespaceId.
+	// +kubebuilder:validation:Optional
+	NamespaceIDRef *xpv1.Reference `json:"namespaceIdRef,omitempty"`
+
+	// Selector for a KvNamespace in workers to populate namespaceId.
+	// +kubebuilder:validation:Optional
+	NamespaceIDSelector *xpv1.Selector `json:"namespaceIdSelector,omitempty"`
+
+	// Sources are the ConfigMaps and Secrets whose keys are mirrored into
+	// the namespace. A key of a later source overrides the same key of an
+	// earlier one.
+	// +kubebuilder:validation:MinItems=1
+	Sources []KVDataSource `json:"sources"`
+
+	// Prune deletes the keys written by this data set that no longer exist
+	// in its sources.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	Prune *bool `json:"prune,omitempty"`
+}
+
+// KVDataSource is a ConfigMap or Secret mirrored into a KV namespace.
+type KVDataSource struct {
+	// ConfigMapRef references a ConfigMap whose data are mirrored.
+	// +kubebuilder:validation:Optional
+	ConfigMapRef *KVDataSourceReference `json:"configMapRef,omitempty"`
+
+	// SecretRef references a Secret whose data are mirrored.
+	// +kubebuilder:validation:Optional
+	SecretRef *KVDataSourceReference `json:"secretRef,omitempty"`
+
+	// Prefix is prepended to the keys of the source.
+	// +kubebuilder:validation:Optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// ExpirationTTL is the number of seconds the keys of the source live
+	// for, at least 60. Expired keys are written again on the next
+	// reconcile, so the TTL takes effect when the data set is no longer
+	// reconciled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=60
+	ExpirationTTL *int64 `json:"expirationTtl,omitempty"`
+
+	// Metadata is attached to every key of the source. The
+	// crossplane-kvdataset metadata key is reserved: it marks the keys
+	// written by the data set with its name and a hash of the key, so that
+	// changed and removed keys are found by listing the namespace.
+	// +kubebuilder:validation:Optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// KVDataSourceReference references a ConfigMap or Secret by name and
+// namespace.
+type KVDataSourceReference struct {
+	// Name of the object.
+	Name string `json:"name"`
+
+	// Namespace of the object.
+	Namespace string `json:"namespace"`
+}
+
+// KVDataSetObservation defines the observed state of a KVDataSet
+type KVDataSetObservation struct {
+	// Digest is a hash of the keys last written by this data set and of
+	// their values, TTLs and metadata.
+	Digest string `json:"digest,omitempty"`
+
+	// KeyCount is the number of keys written by this data set.
+	KeyCount int `json:"keyCount,omitempty"`
+
+	// LastSyncTime is when keys were last written or deleted.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// KVDataSetSpec defines the desired state of KVDataSet
+type KVDataSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KVDataSetParameters `json:"forProvider"`
+}
+
+// KVDataSetStatus defines the observed state of KVDataSet
+type KVDataSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KVDataSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="KEYS",type="integer",JSONPath=".status.atProvider.keyCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// KVDataSet is the Schema for the KVDataSet API.
+// It mirrors the keys of ConfigMaps and Secrets into a Workers KV namespace
+// with the bulk write and delete endpoints.
+type KVDataSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KVDataSetSpec   `json:"spec"`
+	Status            KVDataSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KVDataSetList contains a list of KVDataSets
+type KVDataSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KVDataSet `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	KVDataSet_Kind             = "KVDataSet"
+	KVDataSet_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: KVDataSet_Kind}.String()
+	KVDataSet_KindAPIVersion   = KVDataSet_Kind + "." + CRDGroupVersion.String()
+	KVDataSet_GroupVersionKind = CRDGroupVersion.WithKind(KVDataSet_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KVDataSet{}, &KVDataSetList{})
+}
+
+func (mg *KVDataSet) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *KVDataSet) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *KVDataSet) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *KVDataSet) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *KVDataSet) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *KVDataSet) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *KVDataSet) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *KVDataSet) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *KVDataSet) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *KVDataSet) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// Phases of a WorkerRollout.
+const (
+	RolloutPhaseProgressing = "Progressing"
+	RolloutPhaseSucceeded   = "Succeeded"
+	RolloutPhaseRolledBack  = "RolledBack"
+	RolloutPhaseFailed      = "Failed"
+)
+
+// WorkerRolloutParameters defines the desired state of a WorkerRollout
+type WorkerRolloutParameters struct {
+	// DeploymentRef references the Deployment whose traffic split is driven
+	// by this rollout. The versions of the Deployment are managed by the
+	// rollout and should not be set by other means.
+	// +kubebuilder:validation:Required
+	DeploymentRef xpv1.Reference `json:"deploymentRef"`
+
+	// TargetVersionID is the identifier of the Worker version to roll out.
+	// Changing it starts a new rollout from the first step.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/worker/v1alpha1.Version
+	// +kubebuilder:validation:Optional
+	TargetVersionID *string `json:"targetVersionId,omitempty"`
+
+	// Reference to a Version in worker to populate targetVersionId.
+	// +kubebuilder:validation:Optional
+	TargetVersionIDRef *xpv1.Reference `json:"targetVersionIdRef,omitempty"`
+
+	// Selector for a Version in worker to populate targetVersionId.
+	// +kubebuilder:validation:Optional
+	TargetVersionIDSelector *xpv1.Selector `json:"targetVersionIdSelector,omitempty"`
+
+	// Steps is the plan of the rollout. Each step routes the given
+	// percentage of the traffic to the target version and the remainder to
+	// the stable version, then pauses before the next step. Defaults to 10%,
+	// 50% and 100% with pauses of five minutes.
+	// +kubebuilder:validation:Optional
+	Steps []RolloutStep `json:"steps,omitempty"`
+
+	// Analysis configures the health signals checked while the target
+	// version receives traffic. The Deployment is rolled back to the stable
+	// version as soon as a signal degrades.
+	// +kubebuilder:validation:Optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+}
+
+// RolloutStep is a step of a WorkerRollout.
+type RolloutStep struct {
+	// Percentage of the traffic routed to the target version.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage"`
+
+	// Pause is how long the step is held before the next one. The last step
+	// is held for the pause before the rollout succeeds.
+	// +kubebuilder:validation:Optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// RolloutAnalysis configures the health signals of a WorkerRollout.
+type RolloutAnalysis struct {
+	// ErrorRate checks the error rate of the target version reported by the
+	// Workers analytics.
+	// +kubebuilder:validation:Optional
+	ErrorRate *ErrorRateAnalysis `json:"errorRate,omitempty"`
+
+	// HTTPProbe checks an HTTP endpoint served by the target version.
+	// +kubebuilder:validation:Optional
+	HTTPProbe *HTTPProbe `json:"httpProbe,omitempty"`
+
+	// Interval is how often the health signals are checked. Reconciles in
+	// between leave the last results as they are.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1m"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// ErrorRateAnalysis checks the ratio of errored to total invocations of the
+// target version.
+type ErrorRateAnalysis struct {
+	// MaxErrorRate is the highest tolerated ratio of errored invocations,
+	// between 0 and 1.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	MaxErrorRate float64 `json:"maxErrorRate"`
+
+	// Window is how far back invocations are counted, bounded by the start
+	// of the current step.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5m"
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// MinRequests is the number of invocations needed before the error rate
+	// is evaluated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=100
+	MinRequests *int64 `json:"minRequests,omitempty"`
+}
+
+// HTTPProbe checks an HTTP endpoint served by the target version.
+type HTTPProbe struct {
+	// URL of the endpoint.
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// Method of the request.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="GET"
+	Method string `json:"method,omitempty"`
+
+	// Headers added to the request.
+	// +kubebuilder:validation:Optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// SuccessStatusCodes are the response status codes considered healthy.
+	// Any 2xx or 3xx status code is healthy if empty.
+	// +kubebuilder:validation:Optional
+	SuccessStatusCodes []int `json:"successStatusCodes,omitempty"`
+
+	// Timeout of the request.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="10s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailureThreshold is the number of consecutive failed probes that
+	// triggers a rollback.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=3
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+
+	// PinTargetVersion sends the Cloudflare-Workers-Version-Overrides header
+	// so that the probe is always served by the target version.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	PinTargetVersion *bool `json:"pinTargetVersion,omitempty"`
+}
+
+// WorkerRolloutObservation defines the observed state of a WorkerRollout
+type WorkerRolloutObservation struct {
+	// Phase of the rollout: Progressing, Succeeded, RolledBack, or Failed
+	// when a health signal degraded and there was no stable version to roll
+	// back to.
+	Phase string `json:"phase,omitempty"`
+
+	// TargetVersionID is the version being rolled out.
+	TargetVersionID string `json:"targetVersionId,omitempty"`
+
+	// StableVersionID is the version that served most of the traffic when
+	// the rollout started, and that the Deployment is rolled back to.
+	StableVersionID string `json:"stableVersionId,omitempty"`
+
+	// CurrentStep is the index of the current step.
+	CurrentStep *int32 `json:"currentStep,omitempty"`
+
+	// Percentage of the traffic routed to the target version.
+	Percentage int32 `json:"percentage,omitempty"`
+
+	// StepStartedAt is when the current step was applied to the Deployment.
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+
+	// Requests is the number of invocations of the target version counted
+	// by the last error rate analysis.
+	Requests int64 `json:"requests,omitempty"`
+
+	// Errors is the number of errored invocations of the target version
+	// counted by the last error rate analysis.
+	Errors int64 `json:"errors,omitempty"`
+
+	// ErrorRate is the error rate of the target version measured by the
+	// last error rate analysis.
+	ErrorRate *float64 `json:"errorRate,omitempty"`
+
+	// ProbeFailures is the number of consecutive failed HTTP probes.
+	ProbeFailures int32 `json:"probeFailures,omitempty"`
+
+	// LastAnalysisTime is when the health signals were last checked.
+	LastAnalysisTime *metav1.Time `json:"lastAnalysisTime,omitempty"`
+
+	// Message describes why the rollout was rolled back.
+	Message string `json:"message,omitempty"`
+}
+
+// WorkerRolloutSpec defines the desired state of WorkerRollout
+type WorkerRolloutSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkerRolloutParameters `json:"forProvider"`
+}
+
+// WorkerRolloutStatus defines the observed state of WorkerRollout
+type WorkerRolloutStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkerRolloutObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.atProvider.phase"
+// +kubebuilder:printcolumn:name="PERCENTAGE",type="integer",JSONPath=".status.atProvider.percentage"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// WorkerRollout is the Schema for the WorkerRollout API.
+// It progressively shifts the traffic of a Workers Deployment to a new
+// version and rolls back to the stable version if a health signal degrades.
+type WorkerRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              WorkerRolloutSpec   `json:"spec"`
+	Status            WorkerRolloutStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkerRolloutList contains a list of WorkerRollouts
+type WorkerRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkerRollout `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	WorkerRollout_Kind             = "WorkerRollout"
+	WorkerRollout_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: WorkerRollout_Kind}.String()
+	WorkerRollout_KindAPIVersion   = WorkerRollout_Kind + "." + CRDGroupVersion.String()
+	WorkerRollout_GroupVersionKind = CRDGroupVersion.WithKind(WorkerRollout_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WorkerRollout{}, &WorkerRolloutList{})
+}
+
+func (mg *WorkerRollout) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *WorkerRollout) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *WorkerRollout) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *WorkerRollout) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *WorkerRollout) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *WorkerRollout) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *WorkerRollout) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *WorkerRollout) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *WorkerRollout) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *WorkerRollout) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
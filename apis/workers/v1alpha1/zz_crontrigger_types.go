@@ -24,7 +24,17 @@ type CronTriggerInitParameters struct {
 
 	// (String) Name of the script, used in URLs and route configuration.
 	// Name of the script, used in URLs and route configuration.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	ScriptName *string `json:"scriptName,omitempty" tf:"script_name,omitempty"`
+
+	// Reference to a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameRef *v1.Reference `json:"scriptNameRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameSelector *v1.Selector `json:"scriptNameSelector,omitempty" tf:"-"`
 }
 
 type CronTriggerObservation struct {
@@ -57,8 +67,18 @@ type CronTriggerParameters struct {
 
 	// (String) Name of the script, used in URLs and route configuration.
 	// Name of the script, used in URLs and route configuration.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	// +kubebuilder:validation:Optional
 	ScriptName *string `json:"scriptName,omitempty" tf:"script_name,omitempty"`
+
+	// Reference to a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameRef *v1.Reference `json:"scriptNameRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate scriptName.
+	// +kubebuilder:validation:Optional
+	ScriptNameSelector *v1.Selector `json:"scriptNameSelector,omitempty" tf:"-"`
 }
 
 type SchedulesInitParameters struct {
@@ -124,7 +144,6 @@ type CronTrigger struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.accountId) || (has(self.initProvider) && has(self.initProvider.accountId))",message="spec.forProvider.accountId is a required parameter"
 	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.schedules) || (has(self.initProvider) && has(self.initProvider.schedules))",message="spec.forProvider.schedules is a required parameter"
-	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.scriptName) || (has(self.initProvider) && has(self.initProvider.scriptName))",message="spec.forProvider.scriptName is a required parameter"
 	Spec   CronTriggerSpec   `json:"spec"`
 	Status CronTriggerStatus `json:"status,omitempty"`
 }
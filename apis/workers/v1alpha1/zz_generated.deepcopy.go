@@ -11,6 +11,7 @@ package v1alpha1
 import (
 	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -204,6 +205,16 @@ func (in *BindingsInitParameters) DeepCopyInto(out *BindingsInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CertificateID != nil {
 		in, out := &in.CertificateID, &out.CertificateID
 		*out = new(string)
@@ -214,6 +225,16 @@ func (in *BindingsInitParameters) DeepCopyInto(out *BindingsInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.D1DatabaseRef != nil {
+		in, out := &in.D1DatabaseRef, &out.D1DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.D1DatabaseSelector != nil {
+		in, out := &in.D1DatabaseSelector, &out.D1DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Dataset != nil {
 		in, out := &in.Dataset, &out.Dataset
 		*out = new(string)
@@ -234,6 +255,21 @@ func (in *BindingsInitParameters) DeepCopyInto(out *BindingsInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.HyperdriveConfigRef != nil {
+		in, out := &in.HyperdriveConfigRef, &out.HyperdriveConfigRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HyperdriveConfigSelector != nil {
+		in, out := &in.HyperdriveConfigSelector, &out.HyperdriveConfigSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HyperdriveID != nil {
+		in, out := &in.HyperdriveID, &out.HyperdriveID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -279,6 +315,16 @@ func (in *BindingsInitParameters) DeepCopyInto(out *BindingsInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OldName != nil {
 		in, out := &in.OldName, &out.OldName
 		*out = new(string)
@@ -304,6 +350,16 @@ func (in *BindingsInitParameters) DeepCopyInto(out *BindingsInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.QueueNameRef != nil {
+		in, out := &in.QueueNameRef, &out.QueueNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueNameSelector != nil {
+		in, out := &in.QueueNameSelector, &out.QueueNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ScriptName != nil {
 		in, out := &in.ScriptName, &out.ScriptName
 		*out = new(string)
@@ -319,6 +375,16 @@ func (in *BindingsInitParameters) DeepCopyInto(out *BindingsInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StoreID != nil {
 		in, out := &in.StoreID, &out.StoreID
 		*out = new(string)
@@ -432,6 +498,11 @@ func (in *BindingsObservation) DeepCopyInto(out *BindingsObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.HyperdriveID != nil {
+		in, out := &in.HyperdriveID, &out.HyperdriveID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -585,6 +656,16 @@ func (in *BindingsParameters) DeepCopyInto(out *BindingsParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CertificateID != nil {
 		in, out := &in.CertificateID, &out.CertificateID
 		*out = new(string)
@@ -595,6 +676,16 @@ func (in *BindingsParameters) DeepCopyInto(out *BindingsParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.D1DatabaseRef != nil {
+		in, out := &in.D1DatabaseRef, &out.D1DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.D1DatabaseSelector != nil {
+		in, out := &in.D1DatabaseSelector, &out.D1DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Dataset != nil {
 		in, out := &in.Dataset, &out.Dataset
 		*out = new(string)
@@ -615,6 +706,21 @@ func (in *BindingsParameters) DeepCopyInto(out *BindingsParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.HyperdriveConfigRef != nil {
+		in, out := &in.HyperdriveConfigRef, &out.HyperdriveConfigRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HyperdriveConfigSelector != nil {
+		in, out := &in.HyperdriveConfigSelector, &out.HyperdriveConfigSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HyperdriveID != nil {
+		in, out := &in.HyperdriveID, &out.HyperdriveID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -660,6 +766,16 @@ func (in *BindingsParameters) DeepCopyInto(out *BindingsParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OldName != nil {
 		in, out := &in.OldName, &out.OldName
 		*out = new(string)
@@ -685,6 +801,16 @@ func (in *BindingsParameters) DeepCopyInto(out *BindingsParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.QueueNameRef != nil {
+		in, out := &in.QueueNameRef, &out.QueueNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueNameSelector != nil {
+		in, out := &in.QueueNameSelector, &out.QueueNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ScriptName != nil {
 		in, out := &in.ScriptName, &out.ScriptName
 		*out = new(string)
@@ -700,6 +826,16 @@ func (in *BindingsParameters) DeepCopyInto(out *BindingsParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StoreID != nil {
 		in, out := &in.StoreID, &out.StoreID
 		*out = new(string)
@@ -793,6 +929,96 @@ func (in *ConfigInitParameters) DeepCopy() *ConfigInitParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRefInitParameters) DeepCopyInto(out *ConfigMapKeyRefInitParameters) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRefInitParameters.
+func (in *ConfigMapKeyRefInitParameters) DeepCopy() *ConfigMapKeyRefInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyRefInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRefObservation) DeepCopyInto(out *ConfigMapKeyRefObservation) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRefObservation.
+func (in *ConfigMapKeyRefObservation) DeepCopy() *ConfigMapKeyRefObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyRefObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRefParameters) DeepCopyInto(out *ConfigMapKeyRefParameters) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRefParameters.
+func (in *ConfigMapKeyRefParameters) DeepCopy() *ConfigMapKeyRefParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyRefParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigObservation) DeepCopyInto(out *ConfigObservation) {
 	*out = *in
@@ -883,6 +1109,96 @@ func (in *ConfigParameters) DeepCopy() *ConfigParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentFromInitParameters) DeepCopyInto(out *ContentFromInitParameters) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRefInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Oci != nil {
+		in, out := &in.Oci, &out.Oci
+		*out = new(OciInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeyRefInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentFromInitParameters.
+func (in *ContentFromInitParameters) DeepCopy() *ContentFromInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentFromInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentFromObservation) DeepCopyInto(out *ContentFromObservation) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRefObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Oci != nil {
+		in, out := &in.Oci, &out.Oci
+		*out = new(OciObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeyRefObservation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentFromObservation.
+func (in *ContentFromObservation) DeepCopy() *ContentFromObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentFromObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentFromParameters) DeepCopyInto(out *ContentFromParameters) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRefParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Oci != nil {
+		in, out := &in.Oci, &out.Oci
+		*out = new(OciParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeyRefParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentFromParameters.
+func (in *ContentFromParameters) DeepCopy() *ContentFromParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentFromParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronTrigger) DeepCopyInto(out *CronTrigger) {
 	*out = *in
@@ -930,6 +1246,16 @@ func (in *CronTriggerInitParameters) DeepCopyInto(out *CronTriggerInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScriptNameRef != nil {
+		in, out := &in.ScriptNameRef, &out.ScriptNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScriptNameSelector != nil {
+		in, out := &in.ScriptNameSelector, &out.ScriptNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronTriggerInitParameters.
@@ -1031,6 +1357,16 @@ func (in *CronTriggerParameters) DeepCopyInto(out *CronTriggerParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScriptNameRef != nil {
+		in, out := &in.ScriptNameRef, &out.ScriptNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScriptNameSelector != nil {
+		in, out := &in.ScriptNameSelector, &out.ScriptNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronTriggerParameters.
@@ -1128,11 +1464,31 @@ func (in *CustomDomainInitParameters) DeepCopyInto(out *CustomDomainInitParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ZoneID != nil {
 		in, out := &in.ZoneID, &out.ZoneID
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainInitParameters.
@@ -1250,11 +1606,31 @@ func (in *CustomDomainParameters) DeepCopyInto(out *CustomDomainParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ZoneID != nil {
 		in, out := &in.ZoneID, &out.ZoneID
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainParameters.
@@ -1542,6 +1918,31 @@ func (in *DeploymentStatus) DeepCopy() *DeploymentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorRateAnalysis) DeepCopyInto(out *ErrorRateAnalysis) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinRequests != nil {
+		in, out := &in.MinRequests, &out.MinRequests
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorRateAnalysis.
+func (in *ErrorRateAnalysis) DeepCopy() *ErrorRateAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorRateAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ForPlatformsDispatchNamespace) DeepCopyInto(out *ForPlatformsDispatchNamespace) {
 	*out = *in
@@ -1757,7 +2158,49 @@ func (in *ForPlatformsDispatchNamespaceStatus) DeepCopy() *ForPlatformsDispatchN
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Kv) DeepCopyInto(out *Kv) {
+func (in *HTTPProbe) DeepCopyInto(out *HTTPProbe) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SuccessStatusCodes != nil {
+		in, out := &in.SuccessStatusCodes, &out.SuccessStatusCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PinTargetVersion != nil {
+		in, out := &in.PinTargetVersion, &out.PinTargetVersion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbe.
+func (in *HTTPProbe) DeepCopy() *HTTPProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVDataSet) DeepCopyInto(out *KVDataSet) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1765,18 +2208,18 @@ func (in *Kv) DeepCopyInto(out *Kv) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kv.
-func (in *Kv) DeepCopy() *Kv {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSet.
+func (in *KVDataSet) DeepCopy() *KVDataSet {
 	if in == nil {
 		return nil
 	}
-	out := new(Kv)
+	out := new(KVDataSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Kv) DeepCopyObject() runtime.Object {
+func (in *KVDataSet) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1784,16 +2227,222 @@ func (in *Kv) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KvInitParameters) DeepCopyInto(out *KvInitParameters) {
+func (in *KVDataSetList) DeepCopyInto(out *KVDataSetList) {
 	*out = *in
-	if in.AccountID != nil {
-		in, out := &in.AccountID, &out.AccountID
-		*out = new(string)
-		**out = **in
-	}
-	if in.KeyName != nil {
-		in, out := &in.KeyName, &out.KeyName
-		*out = new(string)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KVDataSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSetList.
+func (in *KVDataSetList) DeepCopy() *KVDataSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(KVDataSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KVDataSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVDataSetObservation) DeepCopyInto(out *KVDataSetObservation) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSetObservation.
+func (in *KVDataSetObservation) DeepCopy() *KVDataSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KVDataSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVDataSetParameters) DeepCopyInto(out *KVDataSetParameters) {
+	*out = *in
+	if in.NamespaceID != nil {
+		in, out := &in.NamespaceID, &out.NamespaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]KVDataSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSetParameters.
+func (in *KVDataSetParameters) DeepCopy() *KVDataSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KVDataSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVDataSetSpec) DeepCopyInto(out *KVDataSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSetSpec.
+func (in *KVDataSetSpec) DeepCopy() *KVDataSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KVDataSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVDataSetStatus) DeepCopyInto(out *KVDataSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSetStatus.
+func (in *KVDataSetStatus) DeepCopy() *KVDataSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KVDataSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVDataSource) DeepCopyInto(out *KVDataSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(KVDataSourceReference)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(KVDataSourceReference)
+		**out = **in
+	}
+	if in.ExpirationTTL != nil {
+		in, out := &in.ExpirationTTL, &out.ExpirationTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSource.
+func (in *KVDataSource) DeepCopy() *KVDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KVDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVDataSourceReference) DeepCopyInto(out *KVDataSourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVDataSourceReference.
+func (in *KVDataSourceReference) DeepCopy() *KVDataSourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KVDataSourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kv) DeepCopyInto(out *Kv) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kv.
+func (in *Kv) DeepCopy() *Kv {
+	if in == nil {
+		return nil
+	}
+	out := new(Kv)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Kv) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KvInitParameters) DeepCopyInto(out *KvInitParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
 		**out = **in
 	}
 	if in.Metadata != nil {
@@ -2830,6 +3479,111 @@ func (in *ObservabilityParameters) DeepCopy() *ObservabilityParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciInitParameters) DeepCopyInto(out *OciInitParameters) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PlainHTTP != nil {
+		in, out := &in.PlainHTTP, &out.PlainHTTP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(PullSecretRefInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reference != nil {
+		in, out := &in.Reference, &out.Reference
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciInitParameters.
+func (in *OciInitParameters) DeepCopy() *OciInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OciInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciObservation) DeepCopyInto(out *OciObservation) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PlainHTTP != nil {
+		in, out := &in.PlainHTTP, &out.PlainHTTP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(PullSecretRefObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reference != nil {
+		in, out := &in.Reference, &out.Reference
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciObservation.
+func (in *OciObservation) DeepCopy() *OciObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OciObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciParameters) DeepCopyInto(out *OciParameters) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PlainHTTP != nil {
+		in, out := &in.PlainHTTP, &out.PlainHTTP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(PullSecretRefParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reference != nil {
+		in, out := &in.Reference, &out.Reference
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciParameters.
+func (in *OciParameters) DeepCopy() *OciParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OciParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutboundInitParameters) DeepCopyInto(out *OutboundInitParameters) {
 	*out = *in
@@ -2983,27 +3737,102 @@ func (in *PlacementObservation) DeepCopy() *PlacementObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PlacementObservation)
+	out := new(PlacementObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementParameters) DeepCopyInto(out *PlacementParameters) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementParameters.
+func (in *PlacementParameters) DeepCopy() *PlacementParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullSecretRefInitParameters) DeepCopyInto(out *PullSecretRefInitParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullSecretRefInitParameters.
+func (in *PullSecretRefInitParameters) DeepCopy() *PullSecretRefInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PullSecretRefInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullSecretRefObservation) DeepCopyInto(out *PullSecretRefObservation) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullSecretRefObservation.
+func (in *PullSecretRefObservation) DeepCopy() *PullSecretRefObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PullSecretRefObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlacementParameters) DeepCopyInto(out *PlacementParameters) {
+func (in *PullSecretRefParameters) DeepCopyInto(out *PullSecretRefParameters) {
 	*out = *in
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementParameters.
-func (in *PlacementParameters) DeepCopy() *PlacementParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullSecretRefParameters.
+func (in *PullSecretRefParameters) DeepCopy() *PullSecretRefParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PlacementParameters)
+	out := new(PullSecretRefParameters)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3083,6 +3912,56 @@ func (in *RenamedClassesParameters) DeepCopy() *RenamedClassesParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAnalysis) DeepCopyInto(out *RolloutAnalysis) {
+	*out = *in
+	if in.ErrorRate != nil {
+		in, out := &in.ErrorRate, &out.ErrorRate
+		*out = new(ErrorRateAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPProbe != nil {
+		in, out := &in.HTTPProbe, &out.HTTPProbe
+		*out = new(HTTPProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAnalysis.
+func (in *RolloutAnalysis) DeepCopy() *RolloutAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStep) DeepCopyInto(out *RolloutStep) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStep.
+func (in *RolloutStep) DeepCopy() *RolloutStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
@@ -3123,11 +4002,31 @@ func (in *RouteInitParameters) DeepCopyInto(out *RouteInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScriptRef != nil {
+		in, out := &in.ScriptRef, &out.ScriptRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScriptSelector != nil {
+		in, out := &in.ScriptSelector, &out.ScriptSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ZoneID != nil {
 		in, out := &in.ZoneID, &out.ZoneID
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteInitParameters.
@@ -3220,11 +4119,31 @@ func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScriptRef != nil {
+		in, out := &in.ScriptRef, &out.ScriptRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScriptSelector != nil {
+		in, out := &in.ScriptSelector, &out.ScriptSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ZoneID != nil {
 		in, out := &in.ZoneID, &out.ZoneID
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneIDRef != nil {
+		in, out := &in.ZoneIDRef, &out.ZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneIDSelector != nil {
+		in, out := &in.ZoneIDSelector, &out.ZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteParameters.
@@ -3420,6 +4339,11 @@ func (in *ScriptInitParameters) DeepCopyInto(out *ScriptInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(ContentFromInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContentSha256 != nil {
 		in, out := &in.ContentSha256, &out.ContentSha256
 		*out = new(string)
@@ -3588,6 +4512,11 @@ func (in *ScriptObservation) DeepCopyInto(out *ScriptObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(ContentFromObservation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContentSha256 != nil {
 		in, out := &in.ContentSha256, &out.ContentSha256
 		*out = new(string)
@@ -3787,6 +4716,11 @@ func (in *ScriptParameters) DeepCopyInto(out *ScriptParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(ContentFromParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContentSha256 != nil {
 		in, out := &in.ContentSha256, &out.ContentSha256
 		*out = new(string)
@@ -4111,6 +5045,96 @@ func (in *ScriptSubdomainStatus) DeepCopy() *ScriptSubdomainStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRefInitParameters) DeepCopyInto(out *SecretKeyRefInitParameters) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRefInitParameters.
+func (in *SecretKeyRefInitParameters) DeepCopy() *SecretKeyRefInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRefInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRefObservation) DeepCopyInto(out *SecretKeyRefObservation) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRefObservation.
+func (in *SecretKeyRefObservation) DeepCopy() *SecretKeyRefObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRefObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRefParameters) DeepCopyInto(out *SecretKeyRefParameters) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRefParameters.
+func (in *SecretKeyRefParameters) DeepCopy() *SecretKeyRefParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRefParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepsInitParameters) DeepCopyInto(out *StepsInitParameters) {
 	*out = *in
@@ -4701,3 +5725,172 @@ func (in *WorkerParameters) DeepCopy() *WorkerParameters {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRollout) DeepCopyInto(out *WorkerRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRollout.
+func (in *WorkerRollout) DeepCopy() *WorkerRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkerRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRolloutList) DeepCopyInto(out *WorkerRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkerRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRolloutList.
+func (in *WorkerRolloutList) DeepCopy() *WorkerRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkerRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRolloutObservation) DeepCopyInto(out *WorkerRolloutObservation) {
+	*out = *in
+	if in.CurrentStep != nil {
+		in, out := &in.CurrentStep, &out.CurrentStep
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StepStartedAt != nil {
+		in, out := &in.StepStartedAt, &out.StepStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ErrorRate != nil {
+		in, out := &in.ErrorRate, &out.ErrorRate
+		*out = new(float64)
+		**out = **in
+	}
+	if in.LastAnalysisTime != nil {
+		in, out := &in.LastAnalysisTime, &out.LastAnalysisTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRolloutObservation.
+func (in *WorkerRolloutObservation) DeepCopy() *WorkerRolloutObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRolloutObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRolloutParameters) DeepCopyInto(out *WorkerRolloutParameters) {
+	*out = *in
+	in.DeploymentRef.DeepCopyInto(&out.DeploymentRef)
+	if in.TargetVersionID != nil {
+		in, out := &in.TargetVersionID, &out.TargetVersionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetVersionIDRef != nil {
+		in, out := &in.TargetVersionIDRef, &out.TargetVersionIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetVersionIDSelector != nil {
+		in, out := &in.TargetVersionIDSelector, &out.TargetVersionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]RolloutStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRolloutParameters.
+func (in *WorkerRolloutParameters) DeepCopy() *WorkerRolloutParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRolloutParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRolloutSpec) DeepCopyInto(out *WorkerRolloutSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRolloutSpec.
+func (in *WorkerRolloutSpec) DeepCopy() *WorkerRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRolloutStatus) DeepCopyInto(out *WorkerRolloutStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRolloutStatus.
+func (in *WorkerRolloutStatus) DeepCopy() *WorkerRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -44,6 +44,15 @@ func (l *ForPlatformsDispatchNamespaceList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this KVDataSetList.
+func (l *KVDataSetList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this KvList.
 func (l *KvList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -88,3 +97,12 @@ func (l *ScriptSubdomainList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this WorkerRolloutList.
+func (l *WorkerRolloutList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
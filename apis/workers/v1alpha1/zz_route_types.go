@@ -21,11 +21,30 @@ type RouteInitParameters struct {
 
 	// (String) Name of the script to run if the route matches.
 	// Name of the script to run if the route matches.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	Script *string `json:"script,omitempty" tf:"script,omitempty"`
 
+	// Reference to a Script in workers to populate script.
+	// +kubebuilder:validation:Optional
+	ScriptRef *v1.Reference `json:"scriptRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate script.
+	// +kubebuilder:validation:Optional
+	ScriptSelector *v1.Selector `json:"scriptSelector,omitempty" tf:"-"`
+
 	// (String) Identifier.
 	// Identifier.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Zone
 	ZoneID *string `json:"zoneId,omitempty" tf:"zone_id,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDRef *v1.Reference `json:"zoneIdRef,omitempty" tf:"-"`
+
+	// Selector for a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDSelector *v1.Selector `json:"zoneIdSelector,omitempty" tf:"-"`
 }
 
 type RouteObservation struct {
@@ -55,13 +74,32 @@ type RouteParameters struct {
 
 	// (String) Name of the script to run if the route matches.
 	// Name of the script to run if the route matches.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	// +kubebuilder:validation:Optional
 	Script *string `json:"script,omitempty" tf:"script,omitempty"`
 
+	// Reference to a Script in workers to populate script.
+	// +kubebuilder:validation:Optional
+	ScriptRef *v1.Reference `json:"scriptRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate script.
+	// +kubebuilder:validation:Optional
+	ScriptSelector *v1.Selector `json:"scriptSelector,omitempty" tf:"-"`
+
 	// (String) Identifier.
 	// Identifier.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Zone
 	// +kubebuilder:validation:Optional
 	ZoneID *string `json:"zoneId,omitempty" tf:"zone_id,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDRef *v1.Reference `json:"zoneIdRef,omitempty" tf:"-"`
+
+	// Selector for a Zone in cloudflare to populate zoneId.
+	// +kubebuilder:validation:Optional
+	ZoneIDSelector *v1.Selector `json:"zoneIdSelector,omitempty" tf:"-"`
 }
 
 // RouteSpec defines the desired state of Route
@@ -101,7 +139,6 @@ type Route struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.pattern) || (has(self.initProvider) && has(self.initProvider.pattern))",message="spec.forProvider.pattern is a required parameter"
-	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.zoneId) || (has(self.initProvider) && has(self.initProvider.zoneId))",message="spec.forProvider.zoneId is a required parameter"
 	Spec   RouteSpec   `json:"spec"`
 	Status RouteStatus `json:"status,omitempty"`
 }
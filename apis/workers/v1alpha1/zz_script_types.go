@@ -75,8 +75,18 @@ type BindingsInitParameters struct {
 
 	// (String) R2 bucket to bind to.
 	// R2 bucket to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/r2/v1alpha1.Bucket
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("name",false)
 	BucketName *string `json:"bucketName,omitempty" tf:"bucket_name,omitempty"`
 
+	// Reference to a Bucket in r2 to populate bucketName.
+	// +kubebuilder:validation:Optional
+	BucketNameRef *v1.Reference `json:"bucketNameRef,omitempty" tf:"-"`
+
+	// Selector for a Bucket in r2 to populate bucketName.
+	// +kubebuilder:validation:Optional
+	BucketNameSelector *v1.Selector `json:"bucketNameSelector,omitempty" tf:"-"`
+
 	// (String) Identifier of the certificate to bind to.
 	// Identifier of the certificate to bind to.
 	CertificateID *string `json:"certificateId,omitempty" tf:"certificate_id,omitempty"`
@@ -85,6 +95,14 @@ type BindingsInitParameters struct {
 	// The exported class name of the Durable Object.
 	ClassName *string `json:"className,omitempty" tf:"class_name,omitempty"`
 
+	// Reference to a Database in d1 to populate id.
+	// +kubebuilder:validation:Optional
+	D1DatabaseRef *v1.Reference `json:"d1DatabaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in d1 to populate id.
+	// +kubebuilder:validation:Optional
+	D1DatabaseSelector *v1.Selector `json:"d1DatabaseSelector,omitempty" tf:"-"`
+
 	// (String) The name of the dataset to bind to.
 	// The name of the dataset to bind to.
 	Dataset *string `json:"dataset,omitempty" tf:"dataset,omitempty"`
@@ -103,8 +121,26 @@ type BindingsInitParameters struct {
 	// Available values: "raw", "pkcs8", "spki", "jwk".
 	Format *string `json:"format,omitempty" tf:"format,omitempty"`
 
+	// Reference to a Config in hyperdrive to populate hyperdriveId.
+	// +kubebuilder:validation:Optional
+	HyperdriveConfigRef *v1.Reference `json:"hyperdriveConfigRef,omitempty" tf:"-"`
+
+	// Selector for a Config in hyperdrive to populate hyperdriveId.
+	// +kubebuilder:validation:Optional
+	HyperdriveConfigSelector *v1.Selector `json:"hyperdriveConfigSelector,omitempty" tf:"-"`
+
+	// (String) Identifier of the Hyperdrive config to bind in a hyperdrive binding. Used as the binding id when id is not set.
+	// Identifier of the Hyperdrive config to bind in a hyperdrive binding. Used as the binding id when id is not set.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/hyperdrive/v1alpha1.Config
+	// +crossplane:generate:reference:refFieldName=HyperdriveConfigRef
+	// +crossplane:generate:reference:selectorFieldName=HyperdriveConfigSelector
+	HyperdriveID *string `json:"hyperdriveId,omitempty" tf:"hyperdrive_id,omitempty"`
+
 	// (String) Name of the script, used in URLs and route configuration.
 	// Identifier of the D1 database to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/d1/v1alpha1.Database
+	// +crossplane:generate:reference:refFieldName=D1DatabaseRef
+	// +crossplane:generate:reference:selectorFieldName=D1DatabaseSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
 	// (String) Name of the Vectorize index to bind to.
@@ -139,8 +175,17 @@ type BindingsInitParameters struct {
 
 	// (String) Namespace identifier tag.
 	// Namespace identifier tag.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.KvNamespace
 	NamespaceID *string `json:"namespaceId,omitempty" tf:"namespace_id,omitempty"`
 
+	// Reference to a KvNamespace in workers to populate namespaceId.
+	// +kubebuilder:validation:Optional
+	NamespaceIDRef *v1.Reference `json:"namespaceIdRef,omitempty" tf:"-"`
+
+	// Selector for a KvNamespace in workers to populate namespaceId.
+	// +kubebuilder:validation:Optional
+	NamespaceIDSelector *v1.Selector `json:"namespaceIdSelector,omitempty" tf:"-"`
+
 	// (String) The old name of the inherited binding. If set, the binding will be renamed from old_name to name in the new version. If not set, the binding will keep the same name between versions.
 	// The old name of the inherited binding. If set, the binding will be renamed from `old_name` to `name` in the new version. If not set, the binding will keep the same name between versions.
 	OldName *string `json:"oldName,omitempty" tf:"old_name,omitempty"`
@@ -158,8 +203,18 @@ type BindingsInitParameters struct {
 
 	// (String) Name of the Queue to bind to.
 	// Name of the Queue to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Queue
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("queue_name",false)
 	QueueName *string `json:"queueName,omitempty" tf:"queue_name,omitempty"`
 
+	// Reference to a Queue in cloudflare to populate queueName.
+	// +kubebuilder:validation:Optional
+	QueueNameRef *v1.Reference `json:"queueNameRef,omitempty" tf:"-"`
+
+	// Selector for a Queue in cloudflare to populate queueName.
+	// +kubebuilder:validation:Optional
+	QueueNameSelector *v1.Selector `json:"queueNameSelector,omitempty" tf:"-"`
+
 	// (String) Name of the script, used in URLs and route configuration.
 	// The script where the Durable Object is defined, if it is external to this Worker.
 	ScriptName *string `json:"scriptName,omitempty" tf:"script_name,omitempty"`
@@ -170,8 +225,18 @@ type BindingsInitParameters struct {
 
 	// (String) Name of Worker to bind to.
 	// Name of Worker to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	Service *string `json:"service,omitempty" tf:"service,omitempty"`
 
+	// Reference to a Script in workers to populate service.
+	// +kubebuilder:validation:Optional
+	ServiceRef *v1.Reference `json:"serviceRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate service.
+	// +kubebuilder:validation:Optional
+	ServiceSelector *v1.Selector `json:"serviceSelector,omitempty" tf:"-"`
+
 	// (String) ID of the store containing the secret.
 	// ID of the store containing the secret.
 	StoreID *string `json:"storeId,omitempty" tf:"store_id,omitempty"`
@@ -244,6 +309,10 @@ type BindingsObservation struct {
 	// Available values: "raw", "pkcs8", "spki", "jwk".
 	Format *string `json:"format,omitempty" tf:"format,omitempty"`
 
+	// (String) Identifier of the Hyperdrive config to bind in a hyperdrive binding. Used as the binding id when id is not set.
+	// Identifier of the Hyperdrive config to bind in a hyperdrive binding. Used as the binding id when id is not set.
+	HyperdriveID *string `json:"hyperdriveId,omitempty" tf:"hyperdrive_id,omitempty"`
+
 	// (String) Name of the script, used in URLs and route configuration.
 	// Identifier of the D1 database to bind to.
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
@@ -348,9 +417,19 @@ type BindingsParameters struct {
 
 	// (String) R2 bucket to bind to.
 	// R2 bucket to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/r2/v1alpha1.Bucket
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("name",false)
 	// +kubebuilder:validation:Optional
 	BucketName *string `json:"bucketName,omitempty" tf:"bucket_name,omitempty"`
 
+	// Reference to a Bucket in r2 to populate bucketName.
+	// +kubebuilder:validation:Optional
+	BucketNameRef *v1.Reference `json:"bucketNameRef,omitempty" tf:"-"`
+
+	// Selector for a Bucket in r2 to populate bucketName.
+	// +kubebuilder:validation:Optional
+	BucketNameSelector *v1.Selector `json:"bucketNameSelector,omitempty" tf:"-"`
+
 	// (String) Identifier of the certificate to bind to.
 	// Identifier of the certificate to bind to.
 	// +kubebuilder:validation:Optional
@@ -361,6 +440,14 @@ type BindingsParameters struct {
 	// +kubebuilder:validation:Optional
 	ClassName *string `json:"className,omitempty" tf:"class_name,omitempty"`
 
+	// Reference to a Database in d1 to populate id.
+	// +kubebuilder:validation:Optional
+	D1DatabaseRef *v1.Reference `json:"d1DatabaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in d1 to populate id.
+	// +kubebuilder:validation:Optional
+	D1DatabaseSelector *v1.Selector `json:"d1DatabaseSelector,omitempty" tf:"-"`
+
 	// (String) The name of the dataset to bind to.
 	// The name of the dataset to bind to.
 	// +kubebuilder:validation:Optional
@@ -383,8 +470,27 @@ type BindingsParameters struct {
 	// +kubebuilder:validation:Optional
 	Format *string `json:"format,omitempty" tf:"format,omitempty"`
 
+	// Reference to a Config in hyperdrive to populate hyperdriveId.
+	// +kubebuilder:validation:Optional
+	HyperdriveConfigRef *v1.Reference `json:"hyperdriveConfigRef,omitempty" tf:"-"`
+
+	// Selector for a Config in hyperdrive to populate hyperdriveId.
+	// +kubebuilder:validation:Optional
+	HyperdriveConfigSelector *v1.Selector `json:"hyperdriveConfigSelector,omitempty" tf:"-"`
+
+	// (String) Identifier of the Hyperdrive config to bind in a hyperdrive binding. Used as the binding id when id is not set.
+	// Identifier of the Hyperdrive config to bind in a hyperdrive binding. Used as the binding id when id is not set.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/hyperdrive/v1alpha1.Config
+	// +crossplane:generate:reference:refFieldName=HyperdriveConfigRef
+	// +crossplane:generate:reference:selectorFieldName=HyperdriveConfigSelector
+	// +kubebuilder:validation:Optional
+	HyperdriveID *string `json:"hyperdriveId,omitempty" tf:"hyperdrive_id,omitempty"`
+
 	// (String) Name of the script, used in URLs and route configuration.
 	// Identifier of the D1 database to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/d1/v1alpha1.Database
+	// +crossplane:generate:reference:refFieldName=D1DatabaseRef
+	// +crossplane:generate:reference:selectorFieldName=D1DatabaseSelector
 	// +kubebuilder:validation:Optional
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
@@ -427,9 +533,18 @@ type BindingsParameters struct {
 
 	// (String) Namespace identifier tag.
 	// Namespace identifier tag.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.KvNamespace
 	// +kubebuilder:validation:Optional
 	NamespaceID *string `json:"namespaceId,omitempty" tf:"namespace_id,omitempty"`
 
+	// Reference to a KvNamespace in workers to populate namespaceId.
+	// +kubebuilder:validation:Optional
+	NamespaceIDRef *v1.Reference `json:"namespaceIdRef,omitempty" tf:"-"`
+
+	// Selector for a KvNamespace in workers to populate namespaceId.
+	// +kubebuilder:validation:Optional
+	NamespaceIDSelector *v1.Selector `json:"namespaceIdSelector,omitempty" tf:"-"`
+
 	// (String) The old name of the inherited binding. If set, the binding will be renamed from old_name to name in the new version. If not set, the binding will keep the same name between versions.
 	// The old name of the inherited binding. If set, the binding will be renamed from `old_name` to `name` in the new version. If not set, the binding will keep the same name between versions.
 	// +kubebuilder:validation:Optional
@@ -451,9 +566,19 @@ type BindingsParameters struct {
 
 	// (String) Name of the Queue to bind to.
 	// Name of the Queue to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Queue
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("queue_name",false)
 	// +kubebuilder:validation:Optional
 	QueueName *string `json:"queueName,omitempty" tf:"queue_name,omitempty"`
 
+	// Reference to a Queue in cloudflare to populate queueName.
+	// +kubebuilder:validation:Optional
+	QueueNameRef *v1.Reference `json:"queueNameRef,omitempty" tf:"-"`
+
+	// Selector for a Queue in cloudflare to populate queueName.
+	// +kubebuilder:validation:Optional
+	QueueNameSelector *v1.Selector `json:"queueNameSelector,omitempty" tf:"-"`
+
 	// (String) Name of the script, used in URLs and route configuration.
 	// The script where the Durable Object is defined, if it is external to this Worker.
 	// +kubebuilder:validation:Optional
@@ -466,9 +591,19 @@ type BindingsParameters struct {
 
 	// (String) Name of Worker to bind to.
 	// Name of Worker to bind to.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1.Script
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)
 	// +kubebuilder:validation:Optional
 	Service *string `json:"service,omitempty" tf:"service,omitempty"`
 
+	// Reference to a Script in workers to populate service.
+	// +kubebuilder:validation:Optional
+	ServiceRef *v1.Reference `json:"serviceRef,omitempty" tf:"-"`
+
+	// Selector for a Script in workers to populate service.
+	// +kubebuilder:validation:Optional
+	ServiceSelector *v1.Selector `json:"serviceSelector,omitempty" tf:"-"`
+
 	// (String) ID of the store containing the secret.
 	// ID of the store containing the secret.
 	// +kubebuilder:validation:Optional
@@ -532,6 +667,51 @@ type ConfigInitParameters struct {
 	ServeDirectly *bool `json:"serveDirectly,omitempty" tf:"serve_directly,omitempty"`
 }
 
+type ConfigMapKeyRefInitParameters struct {
+
+	// Key of the ConfigMap holding the Worker content. When omitted, every key is uploaded as a module of a multi-module Worker, named by the key, and main_module names the main module.
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the ConfigMap.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the ConfigMap.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type ConfigMapKeyRefObservation struct {
+
+	// Key of the ConfigMap holding the Worker content. When omitted, every key is uploaded as a module of a multi-module Worker, named by the key, and main_module names the main module.
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the ConfigMap.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the ConfigMap.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type ConfigMapKeyRefParameters struct {
+
+	// Key of the ConfigMap holding the Worker content. When omitted, every key is uploaded as a module of a multi-module Worker, named by the key, and main_module names the main module.
+	// +kubebuilder:validation:Optional
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the ConfigMap.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the ConfigMap.
+	// +kubebuilder:validation:Optional
+	Namespace *string `json:"namespace" tf:"namespace,omitempty"`
+}
+
 type ConfigObservation struct {
 
 	// trailing-slash", "force-trailing-slash", "drop-trailing-slash", "none".
@@ -596,6 +776,45 @@ type ConfigParameters struct {
 	ServeDirectly *bool `json:"serveDirectly,omitempty" tf:"serve_directly,omitempty"`
 }
 
+type ContentFromInitParameters struct {
+
+	// Selects a key of a ConfigMap holding the Worker content.
+	ConfigMapKeyRef *ConfigMapKeyRefInitParameters `json:"configMapKeyRef,omitempty" tf:"config_map_key_ref,omitempty"`
+
+	// OCI artifact holding the Worker content, such as one pushed with oras.
+	Oci *OciInitParameters `json:"oci,omitempty" tf:"oci,omitempty"`
+
+	// Selects a key of a Secret holding the Worker content.
+	SecretKeyRef *SecretKeyRefInitParameters `json:"secretKeyRef,omitempty" tf:"secret_key_ref,omitempty"`
+}
+
+type ContentFromObservation struct {
+
+	// Selects a key of a ConfigMap holding the Worker content.
+	ConfigMapKeyRef *ConfigMapKeyRefObservation `json:"configMapKeyRef,omitempty" tf:"config_map_key_ref,omitempty"`
+
+	// OCI artifact holding the Worker content, such as one pushed with oras.
+	Oci *OciObservation `json:"oci,omitempty" tf:"oci,omitempty"`
+
+	// Selects a key of a Secret holding the Worker content.
+	SecretKeyRef *SecretKeyRefObservation `json:"secretKeyRef,omitempty" tf:"secret_key_ref,omitempty"`
+}
+
+type ContentFromParameters struct {
+
+	// Selects a key of a ConfigMap holding the Worker content.
+	// +kubebuilder:validation:Optional
+	ConfigMapKeyRef *ConfigMapKeyRefParameters `json:"configMapKeyRef,omitempty" tf:"config_map_key_ref,omitempty"`
+
+	// OCI artifact holding the Worker content, such as one pushed with oras.
+	// +kubebuilder:validation:Optional
+	Oci *OciParameters `json:"oci,omitempty" tf:"oci,omitempty"`
+
+	// Selects a key of a Secret holding the Worker content.
+	// +kubebuilder:validation:Optional
+	SecretKeyRef *SecretKeyRefParameters `json:"secretKeyRef,omitempty" tf:"secret_key_ref,omitempty"`
+}
+
 type LimitsInitParameters struct {
 
 	// (Number) The amount of CPU time this Worker can use in milliseconds.
@@ -897,6 +1116,55 @@ type ObservabilityParameters struct {
 	Logs *LogsParameters `json:"logs,omitempty" tf:"logs,omitempty"`
 }
 
+type OciInitParameters struct {
+
+	// Title of the artifact layer holding the Worker content. When omitted, the only layer of an artifact is uploaded as the Worker content, and every layer of an artifact with several layers as a module of a multi-module Worker, named by the layer title, with main_module naming the main module.
+	Path *string `json:"path,omitempty" tf:"path,omitempty"`
+
+	// Access the registry over plain HTTP.
+	PlainHTTP *bool `json:"plainHttp,omitempty" tf:"plain_http,omitempty"`
+
+	// Secret of type kubernetes.io/dockerconfigjson with the registry credentials.
+	PullSecretRef *PullSecretRefInitParameters `json:"pullSecretRef,omitempty" tf:"pull_secret_ref,omitempty"`
+
+	// Reference of the artifact, e.g. ghcr.io/example/worker:v1 or ghcr.io/example/worker@sha256:...
+	Reference *string `json:"reference,omitempty" tf:"reference,omitempty"`
+}
+
+type OciObservation struct {
+
+	// Title of the artifact layer holding the Worker content. When omitted, the only layer of an artifact is uploaded as the Worker content, and every layer of an artifact with several layers as a module of a multi-module Worker, named by the layer title, with main_module naming the main module.
+	Path *string `json:"path,omitempty" tf:"path,omitempty"`
+
+	// Access the registry over plain HTTP.
+	PlainHTTP *bool `json:"plainHttp,omitempty" tf:"plain_http,omitempty"`
+
+	// Secret of type kubernetes.io/dockerconfigjson with the registry credentials.
+	PullSecretRef *PullSecretRefObservation `json:"pullSecretRef,omitempty" tf:"pull_secret_ref,omitempty"`
+
+	// Reference of the artifact, e.g. ghcr.io/example/worker:v1 or ghcr.io/example/worker@sha256:...
+	Reference *string `json:"reference,omitempty" tf:"reference,omitempty"`
+}
+
+type OciParameters struct {
+
+	// Title of the artifact layer holding the Worker content. When omitted, the only layer of an artifact is uploaded as the Worker content, and every layer of an artifact with several layers as a module of a multi-module Worker, named by the layer title, with main_module naming the main module.
+	// +kubebuilder:validation:Optional
+	Path *string `json:"path,omitempty" tf:"path,omitempty"`
+
+	// Access the registry over plain HTTP.
+	// +kubebuilder:validation:Optional
+	PlainHTTP *bool `json:"plainHttp,omitempty" tf:"plain_http,omitempty"`
+
+	// Secret of type kubernetes.io/dockerconfigjson with the registry credentials.
+	// +kubebuilder:validation:Optional
+	PullSecretRef *PullSecretRefParameters `json:"pullSecretRef,omitempty" tf:"pull_secret_ref,omitempty"`
+
+	// Reference of the artifact, e.g. ghcr.io/example/worker:v1 or ghcr.io/example/worker@sha256:...
+	// +kubebuilder:validation:Optional
+	Reference *string `json:"reference" tf:"reference,omitempty"`
+}
+
 type OutboundInitParameters struct {
 
 	// (List of String) Pass information from the Dispatch Worker to the Outbound Worker through the parameters.
@@ -979,6 +1247,41 @@ type PlacementParameters struct {
 	Mode *string `json:"mode,omitempty" tf:"mode,omitempty"`
 }
 
+type PullSecretRefInitParameters struct {
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the Secret.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the Secret.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type PullSecretRefObservation struct {
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the Secret.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the Secret.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type PullSecretRefParameters struct {
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the Secret.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the Secret.
+	// +kubebuilder:validation:Optional
+	Namespace *string `json:"namespace" tf:"namespace,omitempty"`
+}
+
 type RenamedClassesInitParameters struct {
 
 	// (String)
@@ -1041,6 +1344,10 @@ type ScriptInitParameters struct {
 	// Path to a file containing the Module or Service Worker contents of the Worker. Conflicts with `content`. Must be paired with `content_sha256`.
 	ContentFile *string `json:"contentFile,omitempty" tf:"content_file,omitempty"`
 
+	// (String)
+	// Source of the Worker content, either a single file or the modules of a multi-module Worker. The content_sha256 argument is computed from the resolved content and the Worker is re-deployed when it changes. Modules are typed by their extension: .js and .mjs are ES modules, .cjs CommonJS, .py Python, .wasm WebAssembly, .txt and .html text and others data modules. Can only be set in forProvider. Conflicts with content and content_file.
+	ContentFrom *ContentFromInitParameters `json:"contentFrom,omitempty" tf:"content_from,omitempty"`
+
 	// 256 hash of the Worker contents. Used to trigger updates when source code changes. Must be provided when content_file is specified.
 	// SHA-256 hash of the Worker contents. Used to trigger updates when source code changes. Must be provided when `content_file` is specified.
 	ContentSha256 *string `json:"contentSha256,omitempty" tf:"content_sha256,omitempty"`
@@ -1125,6 +1432,10 @@ type ScriptObservation struct {
 	// Path to a file containing the Module or Service Worker contents of the Worker. Conflicts with `content`. Must be paired with `content_sha256`.
 	ContentFile *string `json:"contentFile,omitempty" tf:"content_file,omitempty"`
 
+	// (String)
+	// Source of the Worker content, either a single file or the modules of a multi-module Worker. The content_sha256 argument is computed from the resolved content and the Worker is re-deployed when it changes. Modules are typed by their extension: .js and .mjs are ES modules, .cjs CommonJS, .py Python, .wasm WebAssembly, .txt and .html text and others data modules. Can only be set in forProvider. Conflicts with content and content_file.
+	ContentFrom *ContentFromObservation `json:"contentFrom,omitempty" tf:"content_from,omitempty"`
+
 	// 256 hash of the Worker contents. Used to trigger updates when source code changes. Must be provided when content_file is specified.
 	// SHA-256 hash of the Worker contents. Used to trigger updates when source code changes. Must be provided when `content_file` is specified.
 	ContentSha256 *string `json:"contentSha256,omitempty" tf:"content_sha256,omitempty"`
@@ -1258,6 +1569,11 @@ type ScriptParameters struct {
 	// +kubebuilder:validation:Optional
 	ContentFile *string `json:"contentFile,omitempty" tf:"content_file,omitempty"`
 
+	// (String)
+	// Source of the Worker content, either a single file or the modules of a multi-module Worker. The content_sha256 argument is computed from the resolved content and the Worker is re-deployed when it changes. Modules are typed by their extension: .js and .mjs are ES modules, .cjs CommonJS, .py Python, .wasm WebAssembly, .txt and .html text and others data modules. Can only be set in forProvider. Conflicts with content and content_file.
+	// +kubebuilder:validation:Optional
+	ContentFrom *ContentFromParameters `json:"contentFrom,omitempty" tf:"content_from,omitempty"`
+
 	// 256 hash of the Worker contents. Used to trigger updates when source code changes. Must be provided when content_file is specified.
 	// SHA-256 hash of the Worker contents. Used to trigger updates when source code changes. Must be provided when `content_file` is specified.
 	// +kubebuilder:validation:Optional
@@ -1322,6 +1638,51 @@ type ScriptParameters struct {
 	UsageModel *string `json:"usageModel,omitempty" tf:"usage_model,omitempty"`
 }
 
+type SecretKeyRefInitParameters struct {
+
+	// Key of the Secret holding the Worker content. When omitted, every key is uploaded as a module of a multi-module Worker, named by the key, and main_module names the main module.
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the Secret.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the Secret.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type SecretKeyRefObservation struct {
+
+	// Key of the Secret holding the Worker content. When omitted, every key is uploaded as a module of a multi-module Worker, named by the key, and main_module names the main module.
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the Secret.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the Secret.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type SecretKeyRefParameters struct {
+
+	// Key of the Secret holding the Worker content. When omitted, every key is uploaded as a module of a multi-module Worker, named by the key, and main_module names the main module.
+	// +kubebuilder:validation:Optional
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) A JavaScript variable name for the binding.
+	// Name of the Secret.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (String) The name of the dispatch namespace.
+	// Namespace of the Secret.
+	// +kubebuilder:validation:Optional
+	Namespace *string `json:"namespace" tf:"namespace,omitempty"`
+}
+
 type StepsInitParameters struct {
 
 	// (List of String) A list of classes to delete Durable Object namespaces from.
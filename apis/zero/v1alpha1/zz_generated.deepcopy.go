@@ -460,6 +460,16 @@ func (in *ApprovalGroupsInitParameters) DeepCopyInto(out *ApprovalGroupsInitPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.EmailListUUIDRef != nil {
+		in, out := &in.EmailListUUIDRef, &out.EmailListUUIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmailListUUIDSelector != nil {
+		in, out := &in.EmailListUUIDSelector, &out.EmailListUUIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalGroupsInitParameters.
@@ -532,6 +542,16 @@ func (in *ApprovalGroupsParameters) DeepCopyInto(out *ApprovalGroupsParameters)
 		*out = new(string)
 		**out = **in
 	}
+	if in.EmailListUUIDRef != nil {
+		in, out := &in.EmailListUUIDRef, &out.EmailListUUIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmailListUUIDSelector != nil {
+		in, out := &in.EmailListUUIDSelector, &out.EmailListUUIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalGroupsParameters.
@@ -622,6 +642,16 @@ func (in *AuthContextInitParameters) DeepCopyInto(out *AuthContextInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthContextInitParameters.
@@ -682,6 +712,16 @@ func (in *AuthContextParameters) DeepCopyInto(out *AuthContextParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthContextParameters.
@@ -950,6 +990,16 @@ func (in *AzureAdInitParameters) DeepCopyInto(out *AzureAdInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAdInitParameters.
@@ -1000,6 +1050,16 @@ func (in *AzureAdParameters) DeepCopyInto(out *AzureAdParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAdParameters.
@@ -4956,6 +5016,16 @@ func (in *EmailListInitParameters) DeepCopyInto(out *EmailListInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailListInitParameters.
@@ -4996,6 +5066,16 @@ func (in *EmailListParameters) DeepCopyInto(out *EmailListParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailListParameters.
@@ -5582,6 +5662,16 @@ func (in *ExcludeAuthContextInitParameters) DeepCopyInto(out *ExcludeAuthContext
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeAuthContextInitParameters.
@@ -5642,6 +5732,16 @@ func (in *ExcludeAuthContextParameters) DeepCopyInto(out *ExcludeAuthContextPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeAuthContextParameters.
@@ -5727,6 +5827,16 @@ func (in *ExcludeAzureAdInitParameters) DeepCopyInto(out *ExcludeAzureAdInitPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeAzureAdInitParameters.
@@ -5777,6 +5887,16 @@ func (in *ExcludeAzureAdParameters) DeepCopyInto(out *ExcludeAzureAdParameters)
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeAzureAdParameters.
@@ -6042,6 +6162,16 @@ func (in *ExcludeEmailListInitParameters) DeepCopyInto(out *ExcludeEmailListInit
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeEmailListInitParameters.
@@ -6082,6 +6212,16 @@ func (in *ExcludeEmailListParameters) DeepCopyInto(out *ExcludeEmailListParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeEmailListParameters.
@@ -6322,6 +6462,16 @@ func (in *ExcludeGithubOrganizationInitParameters) DeepCopyInto(out *ExcludeGith
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -6382,6 +6532,16 @@ func (in *ExcludeGithubOrganizationParameters) DeepCopyInto(out *ExcludeGithubOr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -6407,6 +6567,16 @@ func (in *ExcludeGithubOrganizationParameters) DeepCopy() *ExcludeGithubOrganiza
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExcludeGroupInitParameters) DeepCopyInto(out *ExcludeGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -6447,6 +6617,16 @@ func (in *ExcludeGroupObservation) DeepCopy() *ExcludeGroupObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExcludeGroupParameters) DeepCopyInto(out *ExcludeGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -6477,6 +6657,16 @@ func (in *ExcludeGsuiteInitParameters) DeepCopyInto(out *ExcludeGsuiteInitParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeGsuiteInitParameters.
@@ -6527,6 +6717,16 @@ func (in *ExcludeGsuiteParameters) DeepCopyInto(out *ExcludeGsuiteParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeGsuiteParameters.
@@ -6567,6 +6767,16 @@ func (in *ExcludeIPListInitParameters) DeepCopyInto(out *ExcludeIPListInitParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeIPListInitParameters.
@@ -6607,6 +6817,16 @@ func (in *ExcludeIPListParameters) DeepCopyInto(out *ExcludeIPListParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeIPListParameters.
@@ -6862,6 +7082,16 @@ func (in *ExcludeLoginMethodInitParameters) DeepCopyInto(out *ExcludeLoginMethod
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeLoginMethodInitParameters.
@@ -6902,6 +7132,16 @@ func (in *ExcludeLoginMethodParameters) DeepCopyInto(out *ExcludeLoginMethodPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeLoginMethodParameters.
@@ -7067,6 +7307,16 @@ func (in *ExcludeOidcInitParameters) DeepCopyInto(out *ExcludeOidcInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeOidcInitParameters.
@@ -7127,6 +7377,16 @@ func (in *ExcludeOidcParameters) DeepCopyInto(out *ExcludeOidcParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeOidcParameters.
@@ -7147,6 +7407,16 @@ func (in *ExcludeOktaInitParameters) DeepCopyInto(out *ExcludeOktaInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -7197,6 +7467,16 @@ func (in *ExcludeOktaParameters) DeepCopyInto(out *ExcludeOktaParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -7367,6 +7647,16 @@ func (in *ExcludeSAMLInitParameters) DeepCopyInto(out *ExcludeSAMLInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeSAMLInitParameters.
@@ -7427,6 +7717,16 @@ func (in *ExcludeSAMLParameters) DeepCopyInto(out *ExcludeSAMLParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeSAMLParameters.
@@ -7442,6 +7742,16 @@ func (in *ExcludeSAMLParameters) DeepCopy() *ExcludeSAMLParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExcludeServiceTokenInitParameters) DeepCopyInto(out *ExcludeServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -7482,6 +7792,16 @@ func (in *ExcludeServiceTokenObservation) DeepCopy() *ExcludeServiceTokenObserva
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExcludeServiceTokenParameters) DeepCopyInto(out *ExcludeServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -8088,6 +8408,16 @@ func (in *GithubOrganizationInitParameters) DeepCopyInto(out *GithubOrganization
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -8148,6 +8478,16 @@ func (in *GithubOrganizationParameters) DeepCopyInto(out *GithubOrganizationPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -8173,6 +8513,16 @@ func (in *GithubOrganizationParameters) DeepCopy() *GithubOrganizationParameters
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupInitParameters) DeepCopyInto(out *GroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -8213,6 +8563,16 @@ func (in *GroupObservation) DeepCopy() *GroupObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -8243,6 +8603,16 @@ func (in *GsuiteInitParameters) DeepCopyInto(out *GsuiteInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GsuiteInitParameters.
@@ -8293,6 +8663,16 @@ func (in *GsuiteParameters) DeepCopyInto(out *GsuiteParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GsuiteParameters.
@@ -8618,6 +8998,16 @@ func (in *IPListInitParameters) DeepCopyInto(out *IPListInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPListInitParameters.
@@ -8658,6 +9048,16 @@ func (in *IPListParameters) DeepCopyInto(out *IPListParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPListParameters.
@@ -8983,6 +9383,16 @@ func (in *IncludeAuthContextInitParameters) DeepCopyInto(out *IncludeAuthContext
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeAuthContextInitParameters.
@@ -9043,6 +9453,16 @@ func (in *IncludeAuthContextParameters) DeepCopyInto(out *IncludeAuthContextPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeAuthContextParameters.
@@ -9128,6 +9548,16 @@ func (in *IncludeAzureAdInitParameters) DeepCopyInto(out *IncludeAzureAdInitPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeAzureAdInitParameters.
@@ -9178,6 +9608,16 @@ func (in *IncludeAzureAdParameters) DeepCopyInto(out *IncludeAzureAdParameters)
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeAzureAdParameters.
@@ -9443,6 +9883,16 @@ func (in *IncludeEmailListInitParameters) DeepCopyInto(out *IncludeEmailListInit
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeEmailListInitParameters.
@@ -9483,6 +9933,16 @@ func (in *IncludeEmailListParameters) DeepCopyInto(out *IncludeEmailListParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeEmailListParameters.
@@ -9723,6 +10183,16 @@ func (in *IncludeGithubOrganizationInitParameters) DeepCopyInto(out *IncludeGith
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -9783,6 +10253,16 @@ func (in *IncludeGithubOrganizationParameters) DeepCopyInto(out *IncludeGithubOr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -9808,6 +10288,16 @@ func (in *IncludeGithubOrganizationParameters) DeepCopy() *IncludeGithubOrganiza
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IncludeGroupInitParameters) DeepCopyInto(out *IncludeGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -9848,6 +10338,16 @@ func (in *IncludeGroupObservation) DeepCopy() *IncludeGroupObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IncludeGroupParameters) DeepCopyInto(out *IncludeGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -9878,6 +10378,16 @@ func (in *IncludeGsuiteInitParameters) DeepCopyInto(out *IncludeGsuiteInitParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeGsuiteInitParameters.
@@ -9928,6 +10438,16 @@ func (in *IncludeGsuiteParameters) DeepCopyInto(out *IncludeGsuiteParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeGsuiteParameters.
@@ -9968,6 +10488,16 @@ func (in *IncludeIPListInitParameters) DeepCopyInto(out *IncludeIPListInitParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeIPListInitParameters.
@@ -10008,6 +10538,16 @@ func (in *IncludeIPListParameters) DeepCopyInto(out *IncludeIPListParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeIPListParameters.
@@ -10263,6 +10803,16 @@ func (in *IncludeLoginMethodInitParameters) DeepCopyInto(out *IncludeLoginMethod
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeLoginMethodInitParameters.
@@ -10303,6 +10853,16 @@ func (in *IncludeLoginMethodParameters) DeepCopyInto(out *IncludeLoginMethodPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeLoginMethodParameters.
@@ -10468,6 +11028,16 @@ func (in *IncludeOidcInitParameters) DeepCopyInto(out *IncludeOidcInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeOidcInitParameters.
@@ -10528,6 +11098,16 @@ func (in *IncludeOidcParameters) DeepCopyInto(out *IncludeOidcParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeOidcParameters.
@@ -10548,6 +11128,16 @@ func (in *IncludeOktaInitParameters) DeepCopyInto(out *IncludeOktaInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -10598,6 +11188,16 @@ func (in *IncludeOktaParameters) DeepCopyInto(out *IncludeOktaParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -10768,6 +11368,16 @@ func (in *IncludeSAMLInitParameters) DeepCopyInto(out *IncludeSAMLInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeSAMLInitParameters.
@@ -10828,6 +11438,16 @@ func (in *IncludeSAMLParameters) DeepCopyInto(out *IncludeSAMLParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludeSAMLParameters.
@@ -10843,6 +11463,16 @@ func (in *IncludeSAMLParameters) DeepCopy() *IncludeSAMLParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IncludeServiceTokenInitParameters) DeepCopyInto(out *IncludeServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -10883,6 +11513,16 @@ func (in *IncludeServiceTokenObservation) DeepCopy() *IncludeServiceTokenObserva
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IncludeServiceTokenParameters) DeepCopyInto(out *IncludeServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -12438,6 +13078,16 @@ func (in *LoginMethodInitParameters) DeepCopyInto(out *LoginMethodInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginMethodInitParameters.
@@ -12478,6 +13128,16 @@ func (in *LoginMethodParameters) DeepCopyInto(out *LoginMethodParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginMethodParameters.
@@ -12943,6 +13603,16 @@ func (in *OidcInitParameters) DeepCopyInto(out *OidcInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OidcInitParameters.
@@ -13003,6 +13673,16 @@ func (in *OidcParameters) DeepCopyInto(out *OidcParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OidcParameters.
@@ -13023,6 +13703,16 @@ func (in *OktaInitParameters) DeepCopyInto(out *OktaInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -13073,6 +13763,16 @@ func (in *OktaParameters) DeepCopyInto(out *OktaParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -13730,6 +14430,16 @@ func (in *PoliciesInitParameters) DeepCopyInto(out *PoliciesInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyRef != nil {
+		in, out := &in.PolicyRef, &out.PolicyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicySelector != nil {
+		in, out := &in.PolicySelector, &out.PolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Precedence != nil {
 		in, out := &in.Precedence, &out.Precedence
 		*out = new(float64)
@@ -13852,6 +14562,16 @@ func (in *PoliciesParameters) DeepCopyInto(out *PoliciesParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyRef != nil {
+		in, out := &in.PolicyRef, &out.PolicyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicySelector != nil {
+		in, out := &in.PolicySelector, &out.PolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Precedence != nil {
 		in, out := &in.Precedence, &out.Precedence
 		*out = new(float64)
@@ -14282,6 +15002,16 @@ func (in *RequireAuthContextInitParameters) DeepCopyInto(out *RequireAuthContext
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireAuthContextInitParameters.
@@ -14342,6 +15072,16 @@ func (in *RequireAuthContextParameters) DeepCopyInto(out *RequireAuthContextPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireAuthContextParameters.
@@ -14427,6 +15167,16 @@ func (in *RequireAzureAdInitParameters) DeepCopyInto(out *RequireAzureAdInitPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireAzureAdInitParameters.
@@ -14477,6 +15227,16 @@ func (in *RequireAzureAdParameters) DeepCopyInto(out *RequireAzureAdParameters)
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireAzureAdParameters.
@@ -14742,6 +15502,16 @@ func (in *RequireEmailListInitParameters) DeepCopyInto(out *RequireEmailListInit
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireEmailListInitParameters.
@@ -14782,6 +15552,16 @@ func (in *RequireEmailListParameters) DeepCopyInto(out *RequireEmailListParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireEmailListParameters.
@@ -15022,6 +15802,16 @@ func (in *RequireGithubOrganizationInitParameters) DeepCopyInto(out *RequireGith
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -15082,6 +15872,16 @@ func (in *RequireGithubOrganizationParameters) DeepCopyInto(out *RequireGithubOr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -15107,6 +15907,16 @@ func (in *RequireGithubOrganizationParameters) DeepCopy() *RequireGithubOrganiza
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequireGroupInitParameters) DeepCopyInto(out *RequireGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -15147,6 +15957,16 @@ func (in *RequireGroupObservation) DeepCopy() *RequireGroupObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequireGroupParameters) DeepCopyInto(out *RequireGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -15177,6 +15997,16 @@ func (in *RequireGsuiteInitParameters) DeepCopyInto(out *RequireGsuiteInitParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireGsuiteInitParameters.
@@ -15227,6 +16057,16 @@ func (in *RequireGsuiteParameters) DeepCopyInto(out *RequireGsuiteParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireGsuiteParameters.
@@ -15267,6 +16107,16 @@ func (in *RequireIPListInitParameters) DeepCopyInto(out *RequireIPListInitParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireIPListInitParameters.
@@ -15307,6 +16157,16 @@ func (in *RequireIPListParameters) DeepCopyInto(out *RequireIPListParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireIPListParameters.
@@ -15562,6 +16422,16 @@ func (in *RequireLoginMethodInitParameters) DeepCopyInto(out *RequireLoginMethod
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireLoginMethodInitParameters.
@@ -15602,6 +16472,16 @@ func (in *RequireLoginMethodParameters) DeepCopyInto(out *RequireLoginMethodPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireLoginMethodParameters.
@@ -15767,6 +16647,16 @@ func (in *RequireOidcInitParameters) DeepCopyInto(out *RequireOidcInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireOidcInitParameters.
@@ -15827,6 +16717,16 @@ func (in *RequireOidcParameters) DeepCopyInto(out *RequireOidcParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireOidcParameters.
@@ -15847,6 +16747,16 @@ func (in *RequireOktaInitParameters) DeepCopyInto(out *RequireOktaInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -15897,6 +16807,16 @@ func (in *RequireOktaParameters) DeepCopyInto(out *RequireOktaParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -16067,6 +16987,16 @@ func (in *RequireSAMLInitParameters) DeepCopyInto(out *RequireSAMLInitParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireSAMLInitParameters.
@@ -16127,6 +17057,16 @@ func (in *RequireSAMLParameters) DeepCopyInto(out *RequireSAMLParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequireSAMLParameters.
@@ -16142,6 +17082,16 @@ func (in *RequireSAMLParameters) DeepCopy() *RequireSAMLParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequireServiceTokenInitParameters) DeepCopyInto(out *RequireServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -16182,6 +17132,16 @@ func (in *RequireServiceTokenObservation) DeepCopy() *RequireServiceTokenObserva
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequireServiceTokenParameters) DeepCopyInto(out *RequireServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -16796,6 +17756,16 @@ func (in *SAMLInitParameters) DeepCopyInto(out *SAMLInitParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLInitParameters.
@@ -16856,6 +17826,16 @@ func (in *SAMLParameters) DeepCopyInto(out *SAMLParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLParameters.
@@ -17973,6 +18953,16 @@ func (in *ServiceModeV2Parameters) DeepCopy() *ServiceModeV2Parameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceTokenInitParameters) DeepCopyInto(out *ServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -18013,6 +19003,16 @@ func (in *ServiceTokenObservation) DeepCopy() *ServiceTokenObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceTokenParameters) DeepCopyInto(out *ServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -19711,6 +20711,18 @@ func (in *TrustAccessApplicationInitParameters) DeepCopyInto(out *TrustAccessApp
 			}
 		}
 	}
+	if in.AllowedIdpsRefs != nil {
+		in, out := &in.AllowedIdpsRefs, &out.AllowedIdpsRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedIdpsSelector != nil {
+		in, out := &in.AllowedIdpsSelector, &out.AllowedIdpsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AppLauncherLogoURL != nil {
 		in, out := &in.AppLauncherLogoURL, &out.AppLauncherLogoURL
 		*out = new(string)
@@ -20227,6 +21239,18 @@ func (in *TrustAccessApplicationParameters) DeepCopyInto(out *TrustAccessApplica
 			}
 		}
 	}
+	if in.AllowedIdpsRefs != nil {
+		in, out := &in.AllowedIdpsRefs, &out.AllowedIdpsRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedIdpsSelector != nil {
+		in, out := &in.AllowedIdpsSelector, &out.AllowedIdpsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AppLauncherLogoURL != nil {
 		in, out := &in.AppLauncherLogoURL, &out.AppLauncherLogoURL
 		*out = new(string)
@@ -21174,6 +22198,16 @@ func (in *TrustAccessGroupIncludeAuthContextInitParameters) DeepCopyInto(out *Tr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeAuthContextInitParameters.
@@ -21234,6 +22268,16 @@ func (in *TrustAccessGroupIncludeAuthContextParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeAuthContextParameters.
@@ -21319,6 +22363,16 @@ func (in *TrustAccessGroupIncludeAzureAdInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeAzureAdInitParameters.
@@ -21369,6 +22423,16 @@ func (in *TrustAccessGroupIncludeAzureAdParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeAzureAdParameters.
@@ -21634,6 +22698,16 @@ func (in *TrustAccessGroupIncludeEmailListInitParameters) DeepCopyInto(out *Trus
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeEmailListInitParameters.
@@ -21674,6 +22748,16 @@ func (in *TrustAccessGroupIncludeEmailListParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeEmailListParameters.
@@ -21914,6 +22998,16 @@ func (in *TrustAccessGroupIncludeGithubOrganizationInitParameters) DeepCopyInto(
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -21974,6 +23068,16 @@ func (in *TrustAccessGroupIncludeGithubOrganizationParameters) DeepCopyInto(out
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -21999,6 +23103,16 @@ func (in *TrustAccessGroupIncludeGithubOrganizationParameters) DeepCopy() *Trust
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupIncludeGroupInitParameters) DeepCopyInto(out *TrustAccessGroupIncludeGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -22039,6 +23153,16 @@ func (in *TrustAccessGroupIncludeGroupObservation) DeepCopy() *TrustAccessGroupI
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupIncludeGroupParameters) DeepCopyInto(out *TrustAccessGroupIncludeGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -22069,6 +23193,16 @@ func (in *TrustAccessGroupIncludeGsuiteInitParameters) DeepCopyInto(out *TrustAc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeGsuiteInitParameters.
@@ -22119,6 +23253,16 @@ func (in *TrustAccessGroupIncludeGsuiteParameters) DeepCopyInto(out *TrustAccess
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeGsuiteParameters.
@@ -22159,6 +23303,16 @@ func (in *TrustAccessGroupIncludeIPListInitParameters) DeepCopyInto(out *TrustAc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeIPListInitParameters.
@@ -22199,6 +23353,16 @@ func (in *TrustAccessGroupIncludeIPListParameters) DeepCopyInto(out *TrustAccess
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeIPListParameters.
@@ -22454,6 +23618,16 @@ func (in *TrustAccessGroupIncludeLoginMethodInitParameters) DeepCopyInto(out *Tr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeLoginMethodInitParameters.
@@ -22494,6 +23668,16 @@ func (in *TrustAccessGroupIncludeLoginMethodParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeLoginMethodParameters.
@@ -22659,6 +23843,16 @@ func (in *TrustAccessGroupIncludeOidcInitParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeOidcInitParameters.
@@ -22719,6 +23913,16 @@ func (in *TrustAccessGroupIncludeOidcParameters) DeepCopyInto(out *TrustAccessGr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeOidcParameters.
@@ -22739,6 +23943,16 @@ func (in *TrustAccessGroupIncludeOktaInitParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -22789,6 +24003,16 @@ func (in *TrustAccessGroupIncludeOktaParameters) DeepCopyInto(out *TrustAccessGr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -22959,6 +24183,16 @@ func (in *TrustAccessGroupIncludeSAMLInitParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeSAMLInitParameters.
@@ -23019,6 +24253,16 @@ func (in *TrustAccessGroupIncludeSAMLParameters) DeepCopyInto(out *TrustAccessGr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupIncludeSAMLParameters.
@@ -23034,6 +24278,16 @@ func (in *TrustAccessGroupIncludeSAMLParameters) DeepCopy() *TrustAccessGroupInc
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupIncludeServiceTokenInitParameters) DeepCopyInto(out *TrustAccessGroupIncludeServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -23074,6 +24328,16 @@ func (in *TrustAccessGroupIncludeServiceTokenObservation) DeepCopy() *TrustAcces
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupIncludeServiceTokenParameters) DeepCopyInto(out *TrustAccessGroupIncludeServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -23359,6 +24623,16 @@ func (in *TrustAccessGroupRequireAuthContextInitParameters) DeepCopyInto(out *Tr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireAuthContextInitParameters.
@@ -23419,6 +24693,16 @@ func (in *TrustAccessGroupRequireAuthContextParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireAuthContextParameters.
@@ -23504,6 +24788,16 @@ func (in *TrustAccessGroupRequireAzureAdInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireAzureAdInitParameters.
@@ -23554,6 +24848,16 @@ func (in *TrustAccessGroupRequireAzureAdParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireAzureAdParameters.
@@ -23819,6 +25123,16 @@ func (in *TrustAccessGroupRequireEmailListInitParameters) DeepCopyInto(out *Trus
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireEmailListInitParameters.
@@ -23859,6 +25173,16 @@ func (in *TrustAccessGroupRequireEmailListParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireEmailListParameters.
@@ -24099,6 +25423,16 @@ func (in *TrustAccessGroupRequireGithubOrganizationInitParameters) DeepCopyInto(
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -24159,6 +25493,16 @@ func (in *TrustAccessGroupRequireGithubOrganizationParameters) DeepCopyInto(out
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -24184,6 +25528,16 @@ func (in *TrustAccessGroupRequireGithubOrganizationParameters) DeepCopy() *Trust
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupRequireGroupInitParameters) DeepCopyInto(out *TrustAccessGroupRequireGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -24224,6 +25578,16 @@ func (in *TrustAccessGroupRequireGroupObservation) DeepCopy() *TrustAccessGroupR
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupRequireGroupParameters) DeepCopyInto(out *TrustAccessGroupRequireGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -24254,6 +25618,16 @@ func (in *TrustAccessGroupRequireGsuiteInitParameters) DeepCopyInto(out *TrustAc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireGsuiteInitParameters.
@@ -24304,6 +25678,16 @@ func (in *TrustAccessGroupRequireGsuiteParameters) DeepCopyInto(out *TrustAccess
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireGsuiteParameters.
@@ -24344,6 +25728,16 @@ func (in *TrustAccessGroupRequireIPListInitParameters) DeepCopyInto(out *TrustAc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireIPListInitParameters.
@@ -24384,6 +25778,16 @@ func (in *TrustAccessGroupRequireIPListParameters) DeepCopyInto(out *TrustAccess
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireIPListParameters.
@@ -24639,6 +26043,16 @@ func (in *TrustAccessGroupRequireLoginMethodInitParameters) DeepCopyInto(out *Tr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireLoginMethodInitParameters.
@@ -24679,6 +26093,16 @@ func (in *TrustAccessGroupRequireLoginMethodParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireLoginMethodParameters.
@@ -24844,6 +26268,16 @@ func (in *TrustAccessGroupRequireOidcInitParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireOidcInitParameters.
@@ -24904,6 +26338,16 @@ func (in *TrustAccessGroupRequireOidcParameters) DeepCopyInto(out *TrustAccessGr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireOidcParameters.
@@ -24924,6 +26368,16 @@ func (in *TrustAccessGroupRequireOktaInitParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -24974,6 +26428,16 @@ func (in *TrustAccessGroupRequireOktaParameters) DeepCopyInto(out *TrustAccessGr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -25144,6 +26608,16 @@ func (in *TrustAccessGroupRequireSAMLInitParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireSAMLInitParameters.
@@ -25204,6 +26678,16 @@ func (in *TrustAccessGroupRequireSAMLParameters) DeepCopyInto(out *TrustAccessGr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessGroupRequireSAMLParameters.
@@ -25219,6 +26703,16 @@ func (in *TrustAccessGroupRequireSAMLParameters) DeepCopy() *TrustAccessGroupReq
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupRequireServiceTokenInitParameters) DeepCopyInto(out *TrustAccessGroupRequireServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -25259,6 +26753,16 @@ func (in *TrustAccessGroupRequireServiceTokenObservation) DeepCopy() *TrustAcces
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessGroupRequireServiceTokenParameters) DeepCopyInto(out *TrustAccessGroupRequireServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -26660,6 +28164,16 @@ func (in *TrustAccessPolicyExcludeAuthContextInitParameters) DeepCopyInto(out *T
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeAuthContextInitParameters.
@@ -26720,6 +28234,16 @@ func (in *TrustAccessPolicyExcludeAuthContextParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeAuthContextParameters.
@@ -26805,6 +28329,16 @@ func (in *TrustAccessPolicyExcludeAzureAdInitParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeAzureAdInitParameters.
@@ -26855,6 +28389,16 @@ func (in *TrustAccessPolicyExcludeAzureAdParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeAzureAdParameters.
@@ -27120,6 +28664,16 @@ func (in *TrustAccessPolicyExcludeEmailListInitParameters) DeepCopyInto(out *Tru
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeEmailListInitParameters.
@@ -27160,6 +28714,16 @@ func (in *TrustAccessPolicyExcludeEmailListParameters) DeepCopyInto(out *TrustAc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeEmailListParameters.
@@ -27400,6 +28964,16 @@ func (in *TrustAccessPolicyExcludeGithubOrganizationInitParameters) DeepCopyInto
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -27460,6 +29034,16 @@ func (in *TrustAccessPolicyExcludeGithubOrganizationParameters) DeepCopyInto(out
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -27485,6 +29069,16 @@ func (in *TrustAccessPolicyExcludeGithubOrganizationParameters) DeepCopy() *Trus
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyExcludeGroupInitParameters) DeepCopyInto(out *TrustAccessPolicyExcludeGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -27525,6 +29119,16 @@ func (in *TrustAccessPolicyExcludeGroupObservation) DeepCopy() *TrustAccessPolic
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyExcludeGroupParameters) DeepCopyInto(out *TrustAccessPolicyExcludeGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -27555,6 +29159,16 @@ func (in *TrustAccessPolicyExcludeGsuiteInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeGsuiteInitParameters.
@@ -27605,6 +29219,16 @@ func (in *TrustAccessPolicyExcludeGsuiteParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeGsuiteParameters.
@@ -27645,6 +29269,16 @@ func (in *TrustAccessPolicyExcludeIPListInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeIPListInitParameters.
@@ -27685,6 +29319,16 @@ func (in *TrustAccessPolicyExcludeIPListParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeIPListParameters.
@@ -27940,6 +29584,16 @@ func (in *TrustAccessPolicyExcludeLoginMethodInitParameters) DeepCopyInto(out *T
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeLoginMethodInitParameters.
@@ -27980,6 +29634,16 @@ func (in *TrustAccessPolicyExcludeLoginMethodParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeLoginMethodParameters.
@@ -28145,6 +29809,16 @@ func (in *TrustAccessPolicyExcludeOidcInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeOidcInitParameters.
@@ -28205,6 +29879,16 @@ func (in *TrustAccessPolicyExcludeOidcParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeOidcParameters.
@@ -28225,6 +29909,16 @@ func (in *TrustAccessPolicyExcludeOktaInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -28275,6 +29969,16 @@ func (in *TrustAccessPolicyExcludeOktaParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -28445,6 +30149,16 @@ func (in *TrustAccessPolicyExcludeSAMLInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeSAMLInitParameters.
@@ -28505,6 +30219,16 @@ func (in *TrustAccessPolicyExcludeSAMLParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyExcludeSAMLParameters.
@@ -28520,6 +30244,16 @@ func (in *TrustAccessPolicyExcludeSAMLParameters) DeepCopy() *TrustAccessPolicyE
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyExcludeServiceTokenInitParameters) DeepCopyInto(out *TrustAccessPolicyExcludeServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -28560,6 +30294,16 @@ func (in *TrustAccessPolicyExcludeServiceTokenObservation) DeepCopy() *TrustAcce
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyExcludeServiceTokenParameters) DeepCopyInto(out *TrustAccessPolicyExcludeServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -28640,6 +30384,16 @@ func (in *TrustAccessPolicyIncludeAuthContextInitParameters) DeepCopyInto(out *T
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeAuthContextInitParameters.
@@ -28700,6 +30454,16 @@ func (in *TrustAccessPolicyIncludeAuthContextParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeAuthContextParameters.
@@ -28785,6 +30549,16 @@ func (in *TrustAccessPolicyIncludeAzureAdInitParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeAzureAdInitParameters.
@@ -28835,6 +30609,16 @@ func (in *TrustAccessPolicyIncludeAzureAdParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeAzureAdParameters.
@@ -29100,6 +30884,16 @@ func (in *TrustAccessPolicyIncludeEmailListInitParameters) DeepCopyInto(out *Tru
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeEmailListInitParameters.
@@ -29140,6 +30934,16 @@ func (in *TrustAccessPolicyIncludeEmailListParameters) DeepCopyInto(out *TrustAc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeEmailListParameters.
@@ -29380,6 +31184,16 @@ func (in *TrustAccessPolicyIncludeGithubOrganizationInitParameters) DeepCopyInto
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -29440,6 +31254,16 @@ func (in *TrustAccessPolicyIncludeGithubOrganizationParameters) DeepCopyInto(out
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -29465,6 +31289,16 @@ func (in *TrustAccessPolicyIncludeGithubOrganizationParameters) DeepCopy() *Trus
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyIncludeGroupInitParameters) DeepCopyInto(out *TrustAccessPolicyIncludeGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -29505,6 +31339,16 @@ func (in *TrustAccessPolicyIncludeGroupObservation) DeepCopy() *TrustAccessPolic
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyIncludeGroupParameters) DeepCopyInto(out *TrustAccessPolicyIncludeGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -29535,6 +31379,16 @@ func (in *TrustAccessPolicyIncludeGsuiteInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeGsuiteInitParameters.
@@ -29585,6 +31439,16 @@ func (in *TrustAccessPolicyIncludeGsuiteParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeGsuiteParameters.
@@ -29625,6 +31489,16 @@ func (in *TrustAccessPolicyIncludeIPListInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeIPListInitParameters.
@@ -29665,6 +31539,16 @@ func (in *TrustAccessPolicyIncludeIPListParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeIPListParameters.
@@ -29920,6 +31804,16 @@ func (in *TrustAccessPolicyIncludeLoginMethodInitParameters) DeepCopyInto(out *T
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeLoginMethodInitParameters.
@@ -29960,6 +31854,16 @@ func (in *TrustAccessPolicyIncludeLoginMethodParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeLoginMethodParameters.
@@ -30125,6 +32029,16 @@ func (in *TrustAccessPolicyIncludeOidcInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeOidcInitParameters.
@@ -30185,6 +32099,16 @@ func (in *TrustAccessPolicyIncludeOidcParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeOidcParameters.
@@ -30205,6 +32129,16 @@ func (in *TrustAccessPolicyIncludeOktaInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -30255,6 +32189,16 @@ func (in *TrustAccessPolicyIncludeOktaParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -30425,6 +32369,16 @@ func (in *TrustAccessPolicyIncludeSAMLInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeSAMLInitParameters.
@@ -30485,6 +32439,16 @@ func (in *TrustAccessPolicyIncludeSAMLParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyIncludeSAMLParameters.
@@ -30500,6 +32464,16 @@ func (in *TrustAccessPolicyIncludeSAMLParameters) DeepCopy() *TrustAccessPolicyI
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyIncludeServiceTokenInitParameters) DeepCopyInto(out *TrustAccessPolicyIncludeServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -30540,6 +32514,16 @@ func (in *TrustAccessPolicyIncludeServiceTokenObservation) DeepCopy() *TrustAcce
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyIncludeServiceTokenParameters) DeepCopyInto(out *TrustAccessPolicyIncludeServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -30906,6 +32890,16 @@ func (in *TrustAccessPolicyRequireAuthContextInitParameters) DeepCopyInto(out *T
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireAuthContextInitParameters.
@@ -30966,6 +32960,16 @@ func (in *TrustAccessPolicyRequireAuthContextParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireAuthContextParameters.
@@ -31051,6 +33055,16 @@ func (in *TrustAccessPolicyRequireAzureAdInitParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireAzureAdInitParameters.
@@ -31101,6 +33115,16 @@ func (in *TrustAccessPolicyRequireAzureAdParameters) DeepCopyInto(out *TrustAcce
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireAzureAdParameters.
@@ -31366,6 +33390,16 @@ func (in *TrustAccessPolicyRequireEmailListInitParameters) DeepCopyInto(out *Tru
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireEmailListInitParameters.
@@ -31406,6 +33440,16 @@ func (in *TrustAccessPolicyRequireEmailListParameters) DeepCopyInto(out *TrustAc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireEmailListParameters.
@@ -31646,6 +33690,16 @@ func (in *TrustAccessPolicyRequireGithubOrganizationInitParameters) DeepCopyInto
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -31706,6 +33760,16 @@ func (in *TrustAccessPolicyRequireGithubOrganizationParameters) DeepCopyInto(out
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -31731,6 +33795,16 @@ func (in *TrustAccessPolicyRequireGithubOrganizationParameters) DeepCopy() *Trus
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyRequireGroupInitParameters) DeepCopyInto(out *TrustAccessPolicyRequireGroupInitParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -31771,6 +33845,16 @@ func (in *TrustAccessPolicyRequireGroupObservation) DeepCopy() *TrustAccessPolic
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyRequireGroupParameters) DeepCopyInto(out *TrustAccessPolicyRequireGroupParameters) {
 	*out = *in
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -31801,6 +33885,16 @@ func (in *TrustAccessPolicyRequireGsuiteInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireGsuiteInitParameters.
@@ -31851,6 +33945,16 @@ func (in *TrustAccessPolicyRequireGsuiteParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireGsuiteParameters.
@@ -31891,6 +33995,16 @@ func (in *TrustAccessPolicyRequireIPListInitParameters) DeepCopyInto(out *TrustA
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireIPListInitParameters.
@@ -31931,6 +34045,16 @@ func (in *TrustAccessPolicyRequireIPListParameters) DeepCopyInto(out *TrustAcces
 		*out = new(string)
 		**out = **in
 	}
+	if in.ListRef != nil {
+		in, out := &in.ListRef, &out.ListRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListSelector != nil {
+		in, out := &in.ListSelector, &out.ListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireIPListParameters.
@@ -32186,6 +34310,16 @@ func (in *TrustAccessPolicyRequireLoginMethodInitParameters) DeepCopyInto(out *T
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireLoginMethodInitParameters.
@@ -32226,6 +34360,16 @@ func (in *TrustAccessPolicyRequireLoginMethodParameters) DeepCopyInto(out *Trust
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderSelector != nil {
+		in, out := &in.IdentityProviderSelector, &out.IdentityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireLoginMethodParameters.
@@ -32391,6 +34535,16 @@ func (in *TrustAccessPolicyRequireOidcInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireOidcInitParameters.
@@ -32451,6 +34605,16 @@ func (in *TrustAccessPolicyRequireOidcParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireOidcParameters.
@@ -32471,6 +34635,16 @@ func (in *TrustAccessPolicyRequireOktaInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -32521,6 +34695,16 @@ func (in *TrustAccessPolicyRequireOktaParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -32691,6 +34875,16 @@ func (in *TrustAccessPolicyRequireSAMLInitParameters) DeepCopyInto(out *TrustAcc
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireSAMLInitParameters.
@@ -32751,6 +34945,16 @@ func (in *TrustAccessPolicyRequireSAMLParameters) DeepCopyInto(out *TrustAccessP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdentityProviderIDRef != nil {
+		in, out := &in.IdentityProviderIDRef, &out.IdentityProviderIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityProviderIDSelector != nil {
+		in, out := &in.IdentityProviderIDSelector, &out.IdentityProviderIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAccessPolicyRequireSAMLParameters.
@@ -32766,6 +34970,16 @@ func (in *TrustAccessPolicyRequireSAMLParameters) DeepCopy() *TrustAccessPolicyR
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyRequireServiceTokenInitParameters) DeepCopyInto(out *TrustAccessPolicyRequireServiceTokenInitParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
@@ -32806,6 +35020,16 @@ func (in *TrustAccessPolicyRequireServiceTokenObservation) DeepCopy() *TrustAcce
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustAccessPolicyRequireServiceTokenParameters) DeepCopyInto(out *TrustAccessPolicyRequireServiceTokenParameters) {
 	*out = *in
+	if in.ServiceTokenRef != nil {
+		in, out := &in.ServiceTokenRef, &out.ServiceTokenRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTokenSelector != nil {
+		in, out := &in.ServiceTokenSelector, &out.ServiceTokenSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenID != nil {
 		in, out := &in.TokenID, &out.TokenID
 		*out = new(string)
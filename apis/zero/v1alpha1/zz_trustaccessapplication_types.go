@@ -34,7 +34,16 @@ type AuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type AuthContextObservation struct {
@@ -66,8 +75,17 @@ type AuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type AuthMethodInitParameters struct {
@@ -220,7 +238,16 @@ type AzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type AzureAdObservation struct {
@@ -243,8 +270,17 @@ type AzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type CertificateInitParameters struct {
@@ -772,7 +808,18 @@ type EmailListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type EmailListObservation struct {
@@ -786,8 +833,19 @@ type EmailListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type EmailObservation struct {
@@ -1159,8 +1217,17 @@ type GithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the application.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -1189,8 +1256,17 @@ type GithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the application.
 	// The name of the organization.
@@ -1205,8 +1281,19 @@ type GithubOrganizationParameters struct {
 
 type GroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -1219,10 +1306,21 @@ type GroupObservation struct {
 
 type GroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type GsuiteInitParameters struct {
@@ -1233,7 +1331,16 @@ type GsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type GsuiteObservation struct {
@@ -1256,8 +1363,17 @@ type GsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type HybridAndImplicitOptionsInitParameters struct {
@@ -1306,7 +1422,18 @@ type IPListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type IPListObservation struct {
@@ -1320,8 +1447,19 @@ type IPListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type IPObservation struct {
@@ -1360,7 +1498,16 @@ type IncludeAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeAuthContextObservation struct {
@@ -1392,8 +1539,17 @@ type IncludeAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeAuthMethodInitParameters struct {
@@ -1426,7 +1582,16 @@ type IncludeAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeAzureAdObservation struct {
@@ -1449,8 +1614,17 @@ type IncludeAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeCertificateInitParameters struct {
@@ -1539,7 +1713,18 @@ type IncludeEmailListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type IncludeEmailListObservation struct {
@@ -1553,8 +1738,19 @@ type IncludeEmailListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type IncludeEmailObservation struct {
@@ -1642,8 +1838,17 @@ type IncludeGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the application.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -1672,8 +1877,17 @@ type IncludeGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the application.
 	// The name of the organization.
@@ -1688,8 +1902,19 @@ type IncludeGithubOrganizationParameters struct {
 
 type IncludeGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -1702,10 +1927,21 @@ type IncludeGroupObservation struct {
 
 type IncludeGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type IncludeGsuiteInitParameters struct {
@@ -1716,7 +1952,16 @@ type IncludeGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeGsuiteObservation struct {
@@ -1739,8 +1984,17 @@ type IncludeGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeIPInitParameters struct {
@@ -1754,7 +2008,18 @@ type IncludeIPListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type IncludeIPListObservation struct {
@@ -1768,8 +2033,19 @@ type IncludeIPListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type IncludeIPObservation struct {
@@ -1888,7 +2164,18 @@ type IncludeLoginMethodInitParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type IncludeLoginMethodObservation struct {
@@ -1902,8 +2189,19 @@ type IncludeLoginMethodParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type IncludeObservation struct {
@@ -1993,7 +2291,16 @@ type IncludeOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeOidcObservation struct {
@@ -2025,16 +2332,34 @@ type IncludeOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the application.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -2055,8 +2380,17 @@ type IncludeOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the application.
 	// The name of the Okta group.
@@ -2175,7 +2509,16 @@ type IncludeSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeSAMLObservation struct {
@@ -2207,14 +2550,34 @@ type IncludeSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type IncludeServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -2227,10 +2590,21 @@ type IncludeServiceTokenObservation struct {
 
 type IncludeServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 type LandingPageDesignInitParameters struct {
@@ -2333,7 +2707,18 @@ type LoginMethodInitParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type LoginMethodObservation struct {
@@ -2347,8 +2732,19 @@ type LoginMethodParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type MappingsInitParameters struct {
@@ -2488,7 +2884,16 @@ type OidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type OidcObservation struct {
@@ -2520,16 +2925,34 @@ type OidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type OktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the application.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -2550,8 +2973,17 @@ type OktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the application.
 	// The name of the Okta group.
@@ -2623,6 +3055,9 @@ type PoliciesInitParameters struct {
 
 	// (String) UUID.
 	// The UUID of the policy
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessPolicy
+	// +crossplane:generate:reference:refFieldName=PolicyRef
+	// +crossplane:generate:reference:selectorFieldName=PolicySelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
 	// (Attributes Set) Rules evaluated with an OR logical operator. A user needs to meet only one of the Include rules. (see below for nested schema)
@@ -2632,6 +3067,14 @@ type PoliciesInitParameters struct {
 	// The name of the Access policy.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
 
+	// Reference to a TrustAccessPolicy in zero to populate id.
+	// +kubebuilder:validation:Optional
+	PolicyRef *v1.Reference `json:"policyRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessPolicy in zero to populate id.
+	// +kubebuilder:validation:Optional
+	PolicySelector *v1.Selector `json:"policySelector,omitempty" tf:"-"`
+
 	// (Number) The order of execution for this policy. Must be unique for each policy within an app.
 	// The order of execution for this policy. Must be unique for each policy within an app.
 	Precedence *float64 `json:"precedence,omitempty" tf:"precedence,omitempty"`
@@ -2692,6 +3135,9 @@ type PoliciesParameters struct {
 
 	// (String) UUID.
 	// The UUID of the policy
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessPolicy
+	// +crossplane:generate:reference:refFieldName=PolicyRef
+	// +crossplane:generate:reference:selectorFieldName=PolicySelector
 	// +kubebuilder:validation:Optional
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
@@ -2704,6 +3150,14 @@ type PoliciesParameters struct {
 	// +kubebuilder:validation:Optional
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
 
+	// Reference to a TrustAccessPolicy in zero to populate id.
+	// +kubebuilder:validation:Optional
+	PolicyRef *v1.Reference `json:"policyRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessPolicy in zero to populate id.
+	// +kubebuilder:validation:Optional
+	PolicySelector *v1.Selector `json:"policySelector,omitempty" tf:"-"`
+
 	// (Number) The order of execution for this policy. Must be unique for each policy within an app.
 	// The order of execution for this policy. Must be unique for each policy within an app.
 	// +kubebuilder:validation:Optional
@@ -2757,7 +3211,16 @@ type RequireAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireAuthContextObservation struct {
@@ -2789,8 +3252,17 @@ type RequireAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireAuthMethodInitParameters struct {
@@ -2823,7 +3295,16 @@ type RequireAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireAzureAdObservation struct {
@@ -2846,8 +3327,17 @@ type RequireAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireCertificateInitParameters struct {
@@ -2936,7 +3426,18 @@ type RequireEmailListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type RequireEmailListObservation struct {
@@ -2950,8 +3451,19 @@ type RequireEmailListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type RequireEmailObservation struct {
@@ -3039,8 +3551,17 @@ type RequireGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the application.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -3069,8 +3590,17 @@ type RequireGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the application.
 	// The name of the organization.
@@ -3085,8 +3615,19 @@ type RequireGithubOrganizationParameters struct {
 
 type RequireGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -3099,10 +3640,21 @@ type RequireGroupObservation struct {
 
 type RequireGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type RequireGsuiteInitParameters struct {
@@ -3113,7 +3665,16 @@ type RequireGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireGsuiteObservation struct {
@@ -3136,8 +3697,17 @@ type RequireGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireIPInitParameters struct {
@@ -3151,7 +3721,18 @@ type RequireIPListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type RequireIPListObservation struct {
@@ -3165,8 +3746,19 @@ type RequireIPListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type RequireIPObservation struct {
@@ -3285,7 +3877,18 @@ type RequireLoginMethodInitParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type RequireLoginMethodObservation struct {
@@ -3299,8 +3902,19 @@ type RequireLoginMethodParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type RequireObservation struct {
@@ -3390,7 +4004,16 @@ type RequireOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireOidcObservation struct {
@@ -3422,16 +4045,34 @@ type RequireOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the application.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -3452,8 +4093,17 @@ type RequireOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the application.
 	// The name of the Okta group.
@@ -3572,7 +4222,16 @@ type RequireSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireSAMLObservation struct {
@@ -3604,14 +4263,34 @@ type RequireSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type RequireServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -3624,10 +4303,21 @@ type RequireServiceTokenObservation struct {
 
 type RequireServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 type SAMLInitParameters struct {
@@ -3642,7 +4332,16 @@ type SAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type SAMLObservation struct {
@@ -3674,8 +4373,17 @@ type SAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type SSHInitParameters struct {
@@ -4073,8 +4781,19 @@ type ScimConfigParameters struct {
 
 type ServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -4087,10 +4806,21 @@ type ServiceTokenObservation struct {
 
 type ServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 type SourceInitParameters struct {
@@ -4195,9 +4925,18 @@ type TrustAccessApplicationInitParameters struct {
 
 	// (Set of String) The identity providers your users can select when connecting to this application. Defaults to all IdPs configured in your account.
 	// The identity providers your users can select when connecting to this application. Defaults to all IdPs configured in your account.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +listType=set
 	AllowedIdps []*string `json:"allowedIdps,omitempty" tf:"allowed_idps,omitempty"`
 
+	// References to TrustAccessIdentityProvider in zero to populate allowedIdps.
+	// +kubebuilder:validation:Optional
+	AllowedIdpsRefs []v1.Reference `json:"allowedIdpsRefs,omitempty" tf:"-"`
+
+	// Selector for a list of TrustAccessIdentityProvider in zero to populate allowedIdps.
+	// +kubebuilder:validation:Optional
+	AllowedIdpsSelector *v1.Selector `json:"allowedIdpsSelector,omitempty" tf:"-"`
+
 	// (String) The image URL of the logo shown in the App Launcher header.
 	// The image URL of the logo shown in the App Launcher header.
 	AppLauncherLogoURL *string `json:"appLauncherLogoUrl,omitempty" tf:"app_launcher_logo_url,omitempty"`
@@ -4529,10 +5268,19 @@ type TrustAccessApplicationParameters struct {
 
 	// (Set of String) The identity providers your users can select when connecting to this application. Defaults to all IdPs configured in your account.
 	// The identity providers your users can select when connecting to this application. Defaults to all IdPs configured in your account.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
 	// +listType=set
 	AllowedIdps []*string `json:"allowedIdps,omitempty" tf:"allowed_idps,omitempty"`
 
+	// References to TrustAccessIdentityProvider in zero to populate allowedIdps.
+	// +kubebuilder:validation:Optional
+	AllowedIdpsRefs []v1.Reference `json:"allowedIdpsRefs,omitempty" tf:"-"`
+
+	// Selector for a list of TrustAccessIdentityProvider in zero to populate allowedIdps.
+	// +kubebuilder:validation:Optional
+	AllowedIdpsSelector *v1.Selector `json:"allowedIdpsSelector,omitempty" tf:"-"`
+
 	// (String) The image URL of the logo shown in the App Launcher header.
 	// The image URL of the logo shown in the App Launcher header.
 	// +kubebuilder:validation:Optional
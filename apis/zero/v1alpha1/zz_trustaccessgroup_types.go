@@ -34,7 +34,16 @@ type ExcludeAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeAuthContextObservation struct {
@@ -66,8 +75,17 @@ type ExcludeAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeAuthMethodInitParameters struct {
@@ -100,7 +118,16 @@ type ExcludeAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeAzureAdObservation struct {
@@ -123,8 +150,17 @@ type ExcludeAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeCertificateInitParameters struct {
@@ -213,7 +249,18 @@ type ExcludeEmailListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type ExcludeEmailListObservation struct {
@@ -227,8 +274,19 @@ type ExcludeEmailListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type ExcludeEmailObservation struct {
@@ -316,8 +374,17 @@ type ExcludeGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access group.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -346,8 +413,17 @@ type ExcludeGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access group.
 	// The name of the organization.
@@ -362,8 +438,19 @@ type ExcludeGithubOrganizationParameters struct {
 
 type ExcludeGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -376,10 +463,21 @@ type ExcludeGroupObservation struct {
 
 type ExcludeGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type ExcludeGsuiteInitParameters struct {
@@ -390,7 +488,16 @@ type ExcludeGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeGsuiteObservation struct {
@@ -413,8 +520,17 @@ type ExcludeGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeIPInitParameters struct {
@@ -428,7 +544,18 @@ type ExcludeIPListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type ExcludeIPListObservation struct {
@@ -442,8 +569,19 @@ type ExcludeIPListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type ExcludeIPObservation struct {
@@ -487,7 +625,18 @@ type ExcludeLoginMethodInitParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type ExcludeLoginMethodObservation struct {
@@ -501,8 +650,19 @@ type ExcludeLoginMethodParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type ExcludeOidcInitParameters struct {
@@ -517,7 +677,16 @@ type ExcludeOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeOidcObservation struct {
@@ -549,16 +718,34 @@ type ExcludeOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access group.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -579,8 +766,17 @@ type ExcludeOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access group.
 	// The name of the Okta group.
@@ -600,7 +796,16 @@ type ExcludeSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeSAMLObservation struct {
@@ -632,14 +837,34 @@ type ExcludeSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type ExcludeServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -652,10 +877,21 @@ type ExcludeServiceTokenObservation struct {
 
 type ExcludeServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 type TrustAccessGroupExcludeInitParameters struct {
@@ -928,7 +1164,16 @@ type TrustAccessGroupIncludeAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeAuthContextObservation struct {
@@ -960,8 +1205,17 @@ type TrustAccessGroupIncludeAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeAuthMethodInitParameters struct {
@@ -994,7 +1248,16 @@ type TrustAccessGroupIncludeAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeAzureAdObservation struct {
@@ -1017,8 +1280,17 @@ type TrustAccessGroupIncludeAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeCertificateInitParameters struct {
@@ -1107,7 +1379,18 @@ type TrustAccessGroupIncludeEmailListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeEmailListObservation struct {
@@ -1121,8 +1404,19 @@ type TrustAccessGroupIncludeEmailListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeEmailObservation struct {
@@ -1210,8 +1504,17 @@ type TrustAccessGroupIncludeGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access group.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -1240,8 +1543,17 @@ type TrustAccessGroupIncludeGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access group.
 	// The name of the organization.
@@ -1256,8 +1568,19 @@ type TrustAccessGroupIncludeGithubOrganizationParameters struct {
 
 type TrustAccessGroupIncludeGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -1270,10 +1593,21 @@ type TrustAccessGroupIncludeGroupObservation struct {
 
 type TrustAccessGroupIncludeGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type TrustAccessGroupIncludeGsuiteInitParameters struct {
@@ -1284,7 +1618,16 @@ type TrustAccessGroupIncludeGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeGsuiteObservation struct {
@@ -1307,8 +1650,17 @@ type TrustAccessGroupIncludeGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeIPInitParameters struct {
@@ -1322,7 +1674,18 @@ type TrustAccessGroupIncludeIPListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeIPListObservation struct {
@@ -1336,8 +1699,19 @@ type TrustAccessGroupIncludeIPListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeIPObservation struct {
@@ -1456,7 +1830,18 @@ type TrustAccessGroupIncludeLoginMethodInitParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeLoginMethodObservation struct {
@@ -1470,8 +1855,19 @@ type TrustAccessGroupIncludeLoginMethodParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeObservation struct {
@@ -1561,7 +1957,16 @@ type TrustAccessGroupIncludeOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeOidcObservation struct {
@@ -1593,16 +1998,34 @@ type TrustAccessGroupIncludeOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access group.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -1623,8 +2046,17 @@ type TrustAccessGroupIncludeOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access group.
 	// The name of the Okta group.
@@ -1743,7 +2175,16 @@ type TrustAccessGroupIncludeSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeSAMLObservation struct {
@@ -1775,14 +2216,34 @@ type TrustAccessGroupIncludeSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupIncludeServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -1795,10 +2256,21 @@ type TrustAccessGroupIncludeServiceTokenObservation struct {
 
 type TrustAccessGroupIncludeServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 type TrustAccessGroupInitParameters struct {
@@ -1916,7 +2388,16 @@ type TrustAccessGroupRequireAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireAuthContextObservation struct {
@@ -1948,8 +2429,17 @@ type TrustAccessGroupRequireAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireAuthMethodInitParameters struct {
@@ -1982,7 +2472,16 @@ type TrustAccessGroupRequireAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireAzureAdObservation struct {
@@ -2005,8 +2504,17 @@ type TrustAccessGroupRequireAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireCertificateInitParameters struct {
@@ -2095,7 +2603,18 @@ type TrustAccessGroupRequireEmailListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireEmailListObservation struct {
@@ -2109,8 +2628,19 @@ type TrustAccessGroupRequireEmailListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireEmailObservation struct {
@@ -2198,8 +2728,17 @@ type TrustAccessGroupRequireGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access group.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -2228,8 +2767,17 @@ type TrustAccessGroupRequireGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access group.
 	// The name of the organization.
@@ -2244,8 +2792,19 @@ type TrustAccessGroupRequireGithubOrganizationParameters struct {
 
 type TrustAccessGroupRequireGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -2258,10 +2817,21 @@ type TrustAccessGroupRequireGroupObservation struct {
 
 type TrustAccessGroupRequireGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) UUID.
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type TrustAccessGroupRequireGsuiteInitParameters struct {
@@ -2272,7 +2842,16 @@ type TrustAccessGroupRequireGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireGsuiteObservation struct {
@@ -2295,8 +2874,17 @@ type TrustAccessGroupRequireGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireIPInitParameters struct {
@@ -2310,7 +2898,18 @@ type TrustAccessGroupRequireIPListInitParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireIPListObservation struct {
@@ -2324,8 +2923,19 @@ type TrustAccessGroupRequireIPListParameters struct {
 
 	// (String) UUID.
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireIPObservation struct {
@@ -2444,7 +3054,18 @@ type TrustAccessGroupRequireLoginMethodInitParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireLoginMethodObservation struct {
@@ -2458,8 +3079,19 @@ type TrustAccessGroupRequireLoginMethodParameters struct {
 
 	// (String) UUID.
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireObservation struct {
@@ -2549,7 +3181,16 @@ type TrustAccessGroupRequireOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireOidcObservation struct {
@@ -2581,16 +3222,34 @@ type TrustAccessGroupRequireOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access group.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -2611,8 +3270,17 @@ type TrustAccessGroupRequireOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access group.
 	// The name of the Okta group.
@@ -2731,7 +3399,16 @@ type TrustAccessGroupRequireSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireSAMLObservation struct {
@@ -2763,14 +3440,34 @@ type TrustAccessGroupRequireSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessGroupRequireServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -2783,10 +3480,21 @@ type TrustAccessGroupRequireServiceTokenObservation struct {
 
 type TrustAccessGroupRequireServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 // TrustAccessGroupSpec defines the desired state of TrustAccessGroup
@@ -25,7 +25,16 @@ type ApprovalGroupsInitParameters struct {
 
 	// usable email list.
 	// The UUID of an re-usable email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
 	EmailListUUID *string `json:"emailListUuid,omitempty" tf:"email_list_uuid,omitempty"`
+
+	// Reference to a TrustList in zero to populate emailListUuid.
+	// +kubebuilder:validation:Optional
+	EmailListUUIDRef *v1.Reference `json:"emailListUuidRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate emailListUuid.
+	// +kubebuilder:validation:Optional
+	EmailListUUIDSelector *v1.Selector `json:"emailListUuidSelector,omitempty" tf:"-"`
 }
 
 type ApprovalGroupsObservation struct {
@@ -57,8 +66,17 @@ type ApprovalGroupsParameters struct {
 
 	// usable email list.
 	// The UUID of an re-usable email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
 	// +kubebuilder:validation:Optional
 	EmailListUUID *string `json:"emailListUuid,omitempty" tf:"email_list_uuid,omitempty"`
+
+	// Reference to a TrustList in zero to populate emailListUuid.
+	// +kubebuilder:validation:Optional
+	EmailListUUIDRef *v1.Reference `json:"emailListUuidRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate emailListUuid.
+	// +kubebuilder:validation:Optional
+	EmailListUUIDSelector *v1.Selector `json:"emailListUuidSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeAnyValidServiceTokenInitParameters struct {
@@ -82,7 +100,16 @@ type TrustAccessPolicyExcludeAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeAuthContextObservation struct {
@@ -114,8 +141,17 @@ type TrustAccessPolicyExcludeAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeAuthMethodInitParameters struct {
@@ -148,7 +184,16 @@ type TrustAccessPolicyExcludeAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeAzureAdObservation struct {
@@ -171,8 +216,17 @@ type TrustAccessPolicyExcludeAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeCertificateInitParameters struct {
@@ -261,7 +315,18 @@ type TrustAccessPolicyExcludeEmailListInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeEmailListObservation struct {
@@ -275,8 +340,19 @@ type TrustAccessPolicyExcludeEmailListParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeEmailObservation struct {
@@ -364,8 +440,17 @@ type TrustAccessPolicyExcludeGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access policy.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -394,8 +479,17 @@ type TrustAccessPolicyExcludeGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access policy.
 	// The name of the organization.
@@ -410,8 +504,19 @@ type TrustAccessPolicyExcludeGithubOrganizationParameters struct {
 
 type TrustAccessPolicyExcludeGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) The UUID of the policy
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -424,10 +529,21 @@ type TrustAccessPolicyExcludeGroupObservation struct {
 
 type TrustAccessPolicyExcludeGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) The UUID of the policy
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type TrustAccessPolicyExcludeGsuiteInitParameters struct {
@@ -438,7 +554,16 @@ type TrustAccessPolicyExcludeGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeGsuiteObservation struct {
@@ -461,8 +586,17 @@ type TrustAccessPolicyExcludeGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeIPInitParameters struct {
@@ -476,7 +610,18 @@ type TrustAccessPolicyExcludeIPListInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeIPListObservation struct {
@@ -490,8 +635,19 @@ type TrustAccessPolicyExcludeIPListParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeIPObservation struct {
@@ -610,7 +766,18 @@ type TrustAccessPolicyExcludeLoginMethodInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeLoginMethodObservation struct {
@@ -624,8 +791,19 @@ type TrustAccessPolicyExcludeLoginMethodParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeObservation struct {
@@ -715,7 +893,16 @@ type TrustAccessPolicyExcludeOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeOidcObservation struct {
@@ -747,16 +934,34 @@ type TrustAccessPolicyExcludeOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access policy.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -777,8 +982,17 @@ type TrustAccessPolicyExcludeOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access policy.
 	// The name of the Okta group.
@@ -897,7 +1111,16 @@ type TrustAccessPolicyExcludeSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeSAMLObservation struct {
@@ -929,14 +1152,34 @@ type TrustAccessPolicyExcludeSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyExcludeServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -949,10 +1192,21 @@ type TrustAccessPolicyExcludeServiceTokenObservation struct {
 
 type TrustAccessPolicyExcludeServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 type TrustAccessPolicyIncludeAnyValidServiceTokenInitParameters struct {
@@ -976,7 +1230,16 @@ type TrustAccessPolicyIncludeAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeAuthContextObservation struct {
@@ -1008,8 +1271,17 @@ type TrustAccessPolicyIncludeAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeAuthMethodInitParameters struct {
@@ -1042,7 +1314,16 @@ type TrustAccessPolicyIncludeAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeAzureAdObservation struct {
@@ -1065,8 +1346,17 @@ type TrustAccessPolicyIncludeAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeCertificateInitParameters struct {
@@ -1155,7 +1445,18 @@ type TrustAccessPolicyIncludeEmailListInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeEmailListObservation struct {
@@ -1169,8 +1470,19 @@ type TrustAccessPolicyIncludeEmailListParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeEmailObservation struct {
@@ -1258,8 +1570,17 @@ type TrustAccessPolicyIncludeGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access policy.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -1288,8 +1609,17 @@ type TrustAccessPolicyIncludeGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access policy.
 	// The name of the organization.
@@ -1304,8 +1634,19 @@ type TrustAccessPolicyIncludeGithubOrganizationParameters struct {
 
 type TrustAccessPolicyIncludeGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) The UUID of the policy
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -1318,10 +1659,21 @@ type TrustAccessPolicyIncludeGroupObservation struct {
 
 type TrustAccessPolicyIncludeGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) The UUID of the policy
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type TrustAccessPolicyIncludeGsuiteInitParameters struct {
@@ -1332,7 +1684,16 @@ type TrustAccessPolicyIncludeGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeGsuiteObservation struct {
@@ -1355,8 +1716,17 @@ type TrustAccessPolicyIncludeGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeIPInitParameters struct {
@@ -1370,7 +1740,18 @@ type TrustAccessPolicyIncludeIPListInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeIPListObservation struct {
@@ -1384,8 +1765,19 @@ type TrustAccessPolicyIncludeIPListParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeIPObservation struct {
@@ -1504,7 +1896,18 @@ type TrustAccessPolicyIncludeLoginMethodInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeLoginMethodObservation struct {
@@ -1518,8 +1921,19 @@ type TrustAccessPolicyIncludeLoginMethodParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeObservation struct {
@@ -1609,7 +2023,16 @@ type TrustAccessPolicyIncludeOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeOidcObservation struct {
@@ -1641,16 +2064,34 @@ type TrustAccessPolicyIncludeOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access policy.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -1671,8 +2112,17 @@ type TrustAccessPolicyIncludeOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access policy.
 	// The name of the Okta group.
@@ -1791,7 +2241,16 @@ type TrustAccessPolicyIncludeSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeSAMLObservation struct {
@@ -1823,14 +2282,34 @@ type TrustAccessPolicyIncludeSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyIncludeServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -1843,10 +2322,21 @@ type TrustAccessPolicyIncludeServiceTokenObservation struct {
 
 type TrustAccessPolicyIncludeServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 type TrustAccessPolicyInitParameters struct {
@@ -2032,7 +2522,16 @@ type TrustAccessPolicyRequireAuthContextInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireAuthContextObservation struct {
@@ -2064,8 +2563,17 @@ type TrustAccessPolicyRequireAuthContextParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireAuthMethodInitParameters struct {
@@ -2098,7 +2606,16 @@ type TrustAccessPolicyRequireAzureAdInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireAzureAdObservation struct {
@@ -2121,8 +2638,17 @@ type TrustAccessPolicyRequireAzureAdParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Azure identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireCertificateInitParameters struct {
@@ -2211,7 +2737,18 @@ type TrustAccessPolicyRequireEmailListInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireEmailListObservation struct {
@@ -2225,8 +2762,19 @@ type TrustAccessPolicyRequireEmailListParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created email list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireEmailObservation struct {
@@ -2314,8 +2862,17 @@ type TrustAccessPolicyRequireGithubOrganizationInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access policy.
 	// The name of the organization.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -2344,8 +2901,17 @@ type TrustAccessPolicyRequireGithubOrganizationParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Github identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access policy.
 	// The name of the organization.
@@ -2360,8 +2926,19 @@ type TrustAccessPolicyRequireGithubOrganizationParameters struct {
 
 type TrustAccessPolicyRequireGroupInitParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) The UUID of the policy
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
@@ -2374,10 +2951,21 @@ type TrustAccessPolicyRequireGroupObservation struct {
 
 type TrustAccessPolicyRequireGroupParameters struct {
 
+	// Reference to a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessGroup in zero to populate id.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
 	// (String) The UUID of the policy
 	// The ID of a previously created Access group.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessGroup
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 }
 
 type TrustAccessPolicyRequireGsuiteInitParameters struct {
@@ -2388,7 +2976,16 @@ type TrustAccessPolicyRequireGsuiteInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireGsuiteObservation struct {
@@ -2411,8 +3008,17 @@ type TrustAccessPolicyRequireGsuiteParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Google Workspace identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +kubebuilder:validation:Optional
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireIPInitParameters struct {
@@ -2426,7 +3032,18 @@ type TrustAccessPolicyRequireIPListInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireIPListObservation struct {
@@ -2440,8 +3057,19 @@ type TrustAccessPolicyRequireIPListParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of a previously created IP list.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustList
+	// +crossplane:generate:reference:refFieldName=ListRef
+	// +crossplane:generate:reference:selectorFieldName=ListSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListRef *v1.Reference `json:"listRef,omitempty" tf:"-"`
+
+	// Selector for a TrustList in zero to populate id.
+	// +kubebuilder:validation:Optional
+	ListSelector *v1.Selector `json:"listSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireIPObservation struct {
@@ -2560,7 +3188,18 @@ type TrustAccessPolicyRequireLoginMethodInitParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireLoginMethodObservation struct {
@@ -2574,8 +3213,19 @@ type TrustAccessPolicyRequireLoginMethodParameters struct {
 
 	// (String) The UUID of the policy
 	// The ID of an identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
+	// +crossplane:generate:reference:refFieldName=IdentityProviderRef
+	// +crossplane:generate:reference:selectorFieldName=IdentityProviderSelector
 	// +kubebuilder:validation:Optional
-	ID *string `json:"id" tf:"id,omitempty"`
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderRef *v1.Reference `json:"identityProviderRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate id.
+	// +kubebuilder:validation:Optional
+	IdentityProviderSelector *v1.Selector `json:"identityProviderSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireObservation struct {
@@ -2665,7 +3315,16 @@ type TrustAccessPolicyRequireOidcInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireOidcObservation struct {
@@ -2697,16 +3356,34 @@ type TrustAccessPolicyRequireOidcParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your OIDC identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireOktaInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
 
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
+
 	// (String) The name of the Access policy.
 	// The name of the Okta group.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -2727,8 +3404,17 @@ type TrustAccessPolicyRequireOktaParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your Okta identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 
 	// (String) The name of the Access policy.
 	// The name of the Okta group.
@@ -2847,7 +3533,16 @@ type TrustAccessPolicyRequireSAMLInitParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireSAMLObservation struct {
@@ -2879,14 +3574,34 @@ type TrustAccessPolicyRequireSAMLParameters struct {
 
 	// (String) The ID of your Azure identity provider.
 	// The ID of your SAML identity provider.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessIdentityProvider
 	// +kubebuilder:validation:Optional
-	IdentityProviderID *string `json:"identityProviderId" tf:"identity_provider_id,omitempty"`
+	IdentityProviderID *string `json:"identityProviderId,omitempty" tf:"identity_provider_id,omitempty"`
+
+	// Reference to a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDRef *v1.Reference `json:"identityProviderIdRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessIdentityProvider in zero to populate identityProviderId.
+	// +kubebuilder:validation:Optional
+	IdentityProviderIDSelector *v1.Selector `json:"identityProviderIdSelector,omitempty" tf:"-"`
 }
 
 type TrustAccessPolicyRequireServiceTokenInitParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
@@ -2899,10 +3614,21 @@ type TrustAccessPolicyRequireServiceTokenObservation struct {
 
 type TrustAccessPolicyRequireServiceTokenParameters struct {
 
+	// Reference to a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenRef *v1.Reference `json:"serviceTokenRef,omitempty" tf:"-"`
+
+	// Selector for a TrustAccessServiceToken in zero to populate tokenId.
+	// +kubebuilder:validation:Optional
+	ServiceTokenSelector *v1.Selector `json:"serviceTokenSelector,omitempty" tf:"-"`
+
 	// (String) The ID of a Service Token.
 	// The ID of a Service Token.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1.TrustAccessServiceToken
+	// +crossplane:generate:reference:refFieldName=ServiceTokenRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceTokenSelector
 	// +kubebuilder:validation:Optional
-	TokenID *string `json:"tokenId" tf:"token_id,omitempty"`
+	TokenID *string `json:"tokenId,omitempty" tf:"token_id,omitempty"`
 }
 
 // TrustAccessPolicySpec defines the desired state of TrustAccessPolicy
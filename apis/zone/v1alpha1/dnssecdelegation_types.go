@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Custom resource - NOT generated by upjet
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// DNSSECDelegationParameters defines the desired state of a
+// DNSSECDelegation
+type DNSSECDelegationParameters struct {
+	// DNSSECRef references the DNSSEC whose DS record is published.
+	// +kubebuilder:validation:Required
+	DNSSECRef xpv1.Reference `json:"dnssecRef"`
+
+	// ParentZoneID is the identifier of the parent zone the DS record is
+	// created in.
+	// +crossplane:generate:reference:type=gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1.Zone
+	// +kubebuilder:validation:Optional
+	ParentZoneID *string `json:"parentZoneId,omitempty"`
+
+	// Reference to a Zone in cloudflare to populate parentZoneId.
+	// +kubebuilder:validation:Optional
+	ParentZoneIDRef *xpv1.Reference `json:"parentZoneIdRef,omitempty"`
+
+	// Selector for a Zone in cloudflare to populate parentZoneId.
+	// +kubebuilder:validation:Optional
+	ParentZoneIDSelector *xpv1.Selector `json:"parentZoneIdSelector,omitempty"`
+
+	// RecordTTL is the TTL of the DS record in seconds, 1 for automatic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	RecordTTL int `json:"recordTtl,omitempty"`
+}
+
+// DNSSECDelegationObservation defines the observed state of a
+// DNSSECDelegation
+type DNSSECDelegationObservation struct {
+	// ZoneName is the name of the signed zone.
+	ZoneName string `json:"zoneName,omitempty"`
+
+	// DNSSECStatus is the status of DNSSEC of the zone, e.g. pending or
+	// active.
+	DNSSECStatus string `json:"dnssecStatus,omitempty"`
+
+	// DS is the DS record of the zone.
+	DS string `json:"ds,omitempty"`
+
+	// Records are the names of the dns.Record resources created for the
+	// DS record.
+	Records []string `json:"records,omitempty"`
+}
+
+// DNSSECDelegationSpec defines the desired state of DNSSECDelegation
+type DNSSECDelegationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DNSSECDelegationParameters `json:"forProvider"`
+}
+
+// DNSSECDelegationStatus defines the observed state of DNSSECDelegation
+type DNSSECDelegationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DNSSECDelegationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".status.atProvider.zoneName"
+// +kubebuilder:printcolumn:name="DNSSEC",type="string",JSONPath=".status.atProvider.dnssecStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+
+// DNSSECDelegation is the Schema for the DNSSECDelegation API.
+// It publishes the DS record of a zone with DNSSEC to its parent zone as a
+// dns.Record resource. While the record exists, the DNSSEC of the zone can
+// neither be deleted nor disabled, so that the zone is never left without
+// signatures while the parent zone still vouches for them. It is ready
+// once DNSSEC of the zone is active.
+type DNSSECDelegation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DNSSECDelegationSpec   `json:"spec"`
+	Status            DNSSECDelegationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSSECDelegationList contains a list of DNSSECDelegations
+type DNSSECDelegationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSSECDelegation `json:"items"`
+}
+
+// LabelDNSSECDelegation labels the records created for a DNSSECDelegation
+// with its name.
+const LabelDNSSECDelegation = "zone.cloudflare.crossplane.io/dnssec-delegation"
+
+// LabelDNSSEC labels the records created for a DNSSECDelegation with the
+// name of its DNSSEC, which cannot be deleted or disabled while they exist.
+const LabelDNSSEC = "zone.cloudflare.crossplane.io/dnssec"
+
+// Repository type metadata.
+var (
+	DNSSECDelegation_Kind             = "DNSSECDelegation"
+	DNSSECDelegation_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DNSSECDelegation_Kind}.String()
+	DNSSECDelegation_KindAPIVersion   = DNSSECDelegation_Kind + "." + CRDGroupVersion.String()
+	DNSSECDelegation_GroupVersionKind = CRDGroupVersion.WithKind(DNSSECDelegation_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DNSSECDelegation{}, &DNSSECDelegationList{})
+}
+
+func (mg *DNSSECDelegation) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+func (mg *DNSSECDelegation) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+func (mg *DNSSECDelegation) GetManagementPolicies() xpv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+func (mg *DNSSECDelegation) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+func (mg *DNSSECDelegation) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+func (mg *DNSSECDelegation) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+func (mg *DNSSECDelegation) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+func (mg *DNSSECDelegation) SetManagementPolicies(r xpv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+func (mg *DNSSECDelegation) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+func (mg *DNSSECDelegation) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -9,7 +9,8 @@
 package v1alpha1
 
 import (
-	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -483,6 +484,150 @@ func (in *DNSSEC) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECDelegation) DeepCopyInto(out *DNSSECDelegation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECDelegation.
+func (in *DNSSECDelegation) DeepCopy() *DNSSECDelegation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECDelegation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSSECDelegation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECDelegationList) DeepCopyInto(out *DNSSECDelegationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSSECDelegation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECDelegationList.
+func (in *DNSSECDelegationList) DeepCopy() *DNSSECDelegationList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECDelegationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSSECDelegationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECDelegationObservation) DeepCopyInto(out *DNSSECDelegationObservation) {
+	*out = *in
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECDelegationObservation.
+func (in *DNSSECDelegationObservation) DeepCopy() *DNSSECDelegationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECDelegationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECDelegationParameters) DeepCopyInto(out *DNSSECDelegationParameters) {
+	*out = *in
+	in.DNSSECRef.DeepCopyInto(&out.DNSSECRef)
+	if in.ParentZoneID != nil {
+		in, out := &in.ParentZoneID, &out.ParentZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentZoneIDRef != nil {
+		in, out := &in.ParentZoneIDRef, &out.ParentZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentZoneIDSelector != nil {
+		in, out := &in.ParentZoneIDSelector, &out.ParentZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECDelegationParameters.
+func (in *DNSSECDelegationParameters) DeepCopy() *DNSSECDelegationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECDelegationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECDelegationSpec) DeepCopyInto(out *DNSSECDelegationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECDelegationSpec.
+func (in *DNSSECDelegationSpec) DeepCopy() *DNSSECDelegationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECDelegationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECDelegationStatus) DeepCopyInto(out *DNSSECDelegationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECDelegationStatus.
+func (in *DNSSECDelegationStatus) DeepCopy() *DNSSECDelegationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECDelegationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSSECInitParameters) DeepCopyInto(out *DNSSECInitParameters) {
 	*out = *in
@@ -1764,7 +1909,7 @@ func (in *SettingInitParameters) DeepCopyInto(out *SettingInitParameters) {
 	}
 	if in.Value != nil {
 		in, out := &in.Value, &out.Value
-		*out = new(v1.JSON)
+		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ZoneID != nil {
@@ -1851,7 +1996,7 @@ func (in *SettingObservation) DeepCopyInto(out *SettingObservation) {
 	}
 	if in.Value != nil {
 		in, out := &in.Value, &out.Value
-		*out = new(v1.JSON)
+		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ZoneID != nil {
@@ -1886,7 +2031,7 @@ func (in *SettingParameters) DeepCopyInto(out *SettingParameters) {
 	}
 	if in.Value != nil {
 		in, out := &in.Value, &out.Value
-		*out = new(v1.JSON)
+		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ZoneID != nil {
@@ -26,6 +26,15 @@ func (l *CacheVariantsList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this DNSSECDelegationList.
+func (l *DNSSECDelegationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this DNSSECList.
 func (l *DNSSECList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
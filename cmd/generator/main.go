@@ -1,15 +1,33 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
+	ujconfig "github.com/crossplane/upjet/v2/pkg/config"
 	"github.com/crossplane/upjet/v2/pkg/pipeline"
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/ast/astutil"
 
 	"gitlab.com/jarvisai.run/provider-cloudflare/config"
 )
 
+// readinessPackage is the package of the ExternalConnecter evaluating the
+// readiness checks of config.ReadinessChecks.
+const readinessPackage = "gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/readiness"
+
+// secretRefPackage is the package of the source of the Secrets referenced by
+// managed resources.
+const secretRefPackage = "gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/secretref"
+
 func main() {
 	if len(os.Args) < 2 || os.Args[1] == "" {
 		panic("root directory is required to be given as argument")
@@ -19,5 +37,155 @@ func main() {
 	if err != nil {
 		panic(fmt.Sprintf("cannot calculate the absolute path with %s", rootDir))
 	}
-	pipeline.Run(config.GetProvider(), config.GetProviderNamespaced(), absRootDir)
+	pc := config.GetProvider()
+	pipeline.Run(pc, config.GetProviderNamespaced(), absRootDir)
+	for name, columns := range config.PrinterColumns() {
+		if err := addPrinterColumns(absRootDir, pc.Resources[name], columns); err != nil {
+			panic(errors.Wrapf(err, "cannot add the printer columns of resource %s", name))
+		}
+	}
+	for name := range config.ReadinessChecks() {
+		if err := wireReadiness(absRootDir, pc.Resources[name]); err != nil {
+			panic(errors.Wrapf(err, "cannot wire the readiness check of resource %s", name))
+		}
+	}
+	for name, r := range pc.Resources {
+		if err := wireSecretRefs(absRootDir, r); err != nil {
+			panic(errors.Wrapf(err, "cannot wire the Secret watch of resource %s", name))
+		}
+	}
+}
+
+// wireReadiness wraps the external connecter of the generated controller of
+// the given resource with the one of the readiness package, so that the
+// controller evaluates the readiness check of the resource when observing
+// it. Upjet generates controllers from a fixed template, which has no other
+// way to hook into the observation of a resource.
+func wireReadiness(rootDir string, r *ujconfig.Resource) error {
+	if r == nil {
+		return errors.New("resource is not configured")
+	}
+	path := filepath.Join(rootDir, "internal", "controller", r.ShortGroup, strings.ToLower(r.Kind), "zz_controller.go")
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return errors.Wrap(err, "cannot parse the controller")
+	}
+	wired := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !isSelector(call.Fun, "managed", "WithExternalConnecter") || len(call.Args) != 1 {
+			return true
+		}
+		// readiness.NewConnecter(o.Provider.Resources["<name>"], <connecter>)
+		call.Args[0] = &ast.CallExpr{
+			Fun: &ast.SelectorExpr{X: ast.NewIdent("readiness"), Sel: ast.NewIdent("NewConnecter")},
+			Args: []ast.Expr{
+				&ast.IndexExpr{
+					X: &ast.SelectorExpr{
+						X:   &ast.SelectorExpr{X: ast.NewIdent("o"), Sel: ast.NewIdent("Provider")},
+						Sel: ast.NewIdent("Resources"),
+					},
+					Index: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(r.Name)},
+				},
+				call.Args[0],
+			},
+		}
+		wired = true
+		return false
+	})
+	if !wired {
+		return errors.New("the controller has no external connecter")
+	}
+	astutil.AddImport(fset, f, readinessPackage)
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return errors.Wrap(err, "cannot format the controller")
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "cannot format the controller")
+	}
+	return errors.Wrap(os.WriteFile(path, out, 0o600), "cannot write the controller")
+}
+
+// wireSecretRefs makes the generated controller of the given resource watch
+// the Secrets referenced by its managed resources through a
+// SecretKeySelector, if its spec has one, so that they are reconciled when
+// those Secrets change. The watch has to be added when the controller is
+// built, which the Upjet template has no option for.
+func wireSecretRefs(rootDir string, r *ujconfig.Resource) error {
+	b, err := os.ReadFile(filepath.Join(rootDir, "apis", r.ShortGroup, r.Version, "zz_"+strings.ToLower(r.Kind)+"_types.go"))
+	if err != nil {
+		return errors.Wrap(err, "cannot read the types")
+	}
+	if !bytes.Contains(b, []byte(".SecretKeySelector")) {
+		return nil
+	}
+	path := filepath.Join(rootDir, "internal", "controller", r.ShortGroup, strings.ToLower(r.Kind), "zz_controller.go")
+	b, err = os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "cannot read the controller")
+	}
+	s := string(b)
+	const build, complete = "\n\treturn ctrl.NewControllerManagedBy(mgr).", "\n\t\tComplete("
+	i, j := strings.Index(s, build), strings.LastIndex(s, complete)
+	if i == -1 || j < i {
+		return errors.New("the controller is not built with the controller builder")
+	}
+	source := fmt.Sprintf("\n\tsecrets, err := secretref.NewSource(mgr, %s.%s_GroupVersionKind, o.Logger)\n\tif err != nil {\n\t\treturn err\n\t}\n", r.Version, r.Kind)
+	s = s[:i] + source + s[i:j] + "\n\t\tWatchesRawSource(secrets)." + s[j:]
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, s, parser.ParseComments)
+	if err != nil {
+		return errors.Wrap(err, "cannot parse the controller")
+	}
+	astutil.AddImport(fset, f, secretRefPackage)
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return errors.Wrap(err, "cannot format the controller")
+	}
+	return errors.Wrap(os.WriteFile(path, buf.Bytes(), 0o600), "cannot write the controller")
+}
+
+// addPrinterColumns adds printcolumn markers for the given columns to the
+// generated type of the given resource, before the SYNCED column every
+// managed resource has. Upjet has no option for additional printer columns,
+// and the markers have to be in place before the CRDs are generated from the
+// types.
+func addPrinterColumns(rootDir string, r *ujconfig.Resource, columns []config.PrinterColumn) error {
+	if r == nil {
+		return errors.New("resource is not configured")
+	}
+	path := filepath.Join(rootDir, "apis", r.ShortGroup, r.Version, "zz_"+strings.ToLower(r.Kind)+"_types.go")
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "cannot read the types")
+	}
+	s := string(b)
+	typ := strings.Index(s, "\ntype "+r.Kind+" struct {")
+	if typ == -1 {
+		return errors.Errorf("the types have no type %s", r.Kind)
+	}
+	i := strings.LastIndex(s[:typ], "\n// +kubebuilder:printcolumn:name=\"SYNCED\"")
+	if i == -1 {
+		return errors.Errorf("type %s has no printer columns", r.Kind)
+	}
+	var markers strings.Builder
+	for _, c := range columns {
+		fmt.Fprintf(&markers, "\n// +kubebuilder:printcolumn:name=%q,type=%q,JSONPath=%q", c.Name, c.Type, c.JSONPath)
+	}
+	s = s[:i] + markers.String() + s[i:]
+	return errors.Wrap(os.WriteFile(path, []byte(s), 0o600), "cannot write the types")
+}
+
+// isSelector returns whether the expression is pkg.name.
+func isSelector(e ast.Expr, pkg, name string) bool {
+	s, ok := e.(*ast.SelectorExpr)
+	if !ok || s.Sel.Name != name {
+		return false
+	}
+	x, ok := s.X.(*ast.Ident)
+	return ok && x.Name == pkg
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/customresourcesgate"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
 	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
 	"github.com/crossplane/upjet/v2/pkg/terraform"
@@ -24,6 +26,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	authv1 "k8s.io/api/authorization/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -35,11 +39,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"gitlab.com/jarvisai.run/provider-cloudflare/apis"
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/v1alpha1"
 	"gitlab.com/jarvisai.run/provider-cloudflare/config"
+	r2config "gitlab.com/jarvisai.run/provider-cloudflare/config/r2"
 	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/secretstore"
+	workersclient "gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/workers"
 	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller"
 	"gitlab.com/jarvisai.run/provider-cloudflare/internal/features"
 	"gitlab.com/jarvisai.run/provider-cloudflare/internal/version"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/webhooks"
 )
 
 const (
@@ -70,6 +79,10 @@ func main() {
 		enableManagementPolicies = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
 		enableChangeLogs         = app.Flag("enable-changelogs", "Enable support for capturing change logs during reconciliation.").Default("false").Envar("ENABLE_CHANGE_LOGS").Bool()
 
+		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
+		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+		essTLSCertsPath            = app.Flag("ess-tls-cert-dir", "Path of ESS TLS certificates.").Envar("ESS_TLS_CERTS_DIR").String()
+
 		certsDirSet = false
 		certsDir    = app.Flag("certs-dir", "The directory that contains the server key and certificate.").Default(tlsServerCertDir).Envar(certsDirEnvVar).PreAction(func(_ *kingpin.ParseContext) error {
 			certsDirSet = true
@@ -126,6 +139,19 @@ func main() {
 	kingpin.FatalIfError(apiextensionsv1.AddToScheme(mgr.GetScheme()), "Cannot add api-extensions APIs to scheme")
 	kingpin.FatalIfError(authv1.AddToScheme(mgr.GetScheme()), "Cannot add k8s authorization APIs to scheme")
 
+	// The Terraform provider uploads multi-module Workers sourced through
+	// contentFrom through a local proxy adding their further modules.
+	upstream, err := url.Parse(workersclient.DefaultUpstream)
+	kingpin.FatalIfError(err, "Cannot parse the Cloudflare API URL")
+	workersProxy, err := workersclient.NewProxy(upstream)
+	kingpin.FatalIfError(err, "Cannot create the Worker upload proxy")
+	kingpin.FatalIfError(mgr.Add(workersProxy), "Cannot add the Worker upload proxy to the manager")
+
+	// R2 buckets deleted with forceDestroy set are emptied with S3
+	// credentials derived from the API token of their ProviderConfig.
+	provider := config.GetProvider()
+	r2config.ConfigureForceDestroy(provider, clients.R2Credentials)
+
 	metricRecorder := managed.NewMRMetricRecorder()
 	stateMetrics := statemetrics.NewMRStateMetrics()
 
@@ -145,9 +171,9 @@ func main() {
 				MRStateMetrics:          stateMetrics,
 			},
 		},
-		Provider:       config.GetProvider(),
+		Provider:       provider,
 		WorkspaceStore: terraform.NewWorkspaceStore(log),
-		SetupFn:        clients.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion),
+		SetupFn:        workersclient.WithProxy(clients.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion), workersProxy),
 		StartWebhooks:  *certsDir != "",
 	}
 
@@ -156,6 +182,32 @@ func main() {
 		log.Info("Beta feature enabled", "flag", features.EnableBetaManagementPolicies)
 	}
 
+	if *enableExternalSecretStores {
+		o.Features.Enable(features.EnableAlphaExternalSecretStores)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaExternalSecretStores)
+
+		// Ensure default store config exists.
+		kingpin.FatalIfError(resource.Ignore(kerrors.IsAlreadyExists, mgr.GetClient().Create(context.Background(), &v1alpha1.StoreConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: secretstore.DefaultStoreConfig,
+			},
+			Spec: v1alpha1.StoreConfigSpec{
+				DefaultScope: *namespace,
+			},
+		})), "cannot create default store config")
+	}
+
+	// The managed resource controllers write connection secrets to the
+	// secret store of their StoreConfig rather than the API server when it
+	// is a plugin.
+	ctrlMgr := mgr
+	if *enableExternalSecretStores {
+		if *essTLSCertsPath == "" {
+			kingpin.Fatalf("--ess-tls-cert-dir is required with --enable-external-secret-stores")
+		}
+		ctrlMgr = secretstore.NewManager(mgr, *essTLSCertsPath)
+	}
+
 	if *enableChangeLogs {
 		o.Features.Enable(feature.EnableAlphaChangeLogs)
 		log.Info("Alpha feature enabled", "flag", feature.EnableAlphaChangeLogs)
@@ -181,12 +233,17 @@ func main() {
 			Gate:                    crdGate,
 			MaxConcurrentReconciles: 1,
 		}), "Cannot setup CRD gate")
-		kingpin.FatalIfError(controller.SetupGated(mgr, o), "Cannot setup Cloudflare controllers")
-		kingpin.FatalIfError(controller.SetupCustomControllersGated(mgr, o), "Cannot setup custom Cloudflare controllers")
+		kingpin.FatalIfError(controller.SetupGated(ctrlMgr, o), "Cannot setup Cloudflare controllers")
+		kingpin.FatalIfError(controller.SetupCustomControllersGated(ctrlMgr, o), "Cannot setup custom Cloudflare controllers")
 	} else {
 		log.Info("Provider has missing RBAC permissions for watching CRDs, controller SafeStart capability will be disabled")
-		kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup Cloudflare controllers")
-		kingpin.FatalIfError(controller.SetupCustomControllers(mgr, o), "Cannot setup custom Cloudflare controllers")
+		kingpin.FatalIfError(controller.Setup(ctrlMgr, o), "Cannot setup Cloudflare controllers")
+		kingpin.FatalIfError(controller.SetupCustomControllers(ctrlMgr, o), "Cannot setup custom Cloudflare controllers")
+	}
+
+	if *certsDir != "" {
+		kingpin.FatalIfError(webhooks.SetupExpressionValidation(mgr), "Cannot setup expression validation webhook")
+		kingpin.FatalIfError(webhooks.SetupDNSSECDisableValidation(mgr), "Cannot setup DNSSEC disable validation webhook")
 	}
 
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
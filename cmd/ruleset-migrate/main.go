@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cloudflarev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	firewallv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/firewall/v1alpha1"
+	pagev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/page/v1alpha1"
+	ratev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/rate/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/legacyrules"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/manifests"
+)
+
+func main() {
+	var (
+		app            = kingpin.New(filepath.Base(os.Args[0]), "Convert legacy firewall rules, filters, rate limits and page rules into Cloudflare rulesets.").DefaultEnvars()
+		from           = app.Flag("from", "Read the legacy configuration from the managed resources of the current cluster, or from the live zones.").Default("cluster").Enum("cluster", "zone")
+		zoneIDs        = app.Flag("zone-id", "Zone to convert. Repeat for several zones. Required when reading from the live zones.").Strings()
+		apiToken       = app.Flag("api-token", "Cloudflare API token used to read the live zones.").Envar("CLOUDFLARE_API_TOKEN").String()
+		name           = app.Flag("name", "Prefix of the object names of the rulesets, defaults to the zone ID.").String()
+		providerConfig = app.Flag("provider-config", "Name of the ProviderConfig of the rulesets.").Default("default").String()
+		report         = app.Flag("report", "Write the report of what could not be converted one to one to this file instead of stderr.").String()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+	if *name != "" && len(*zoneIDs) != 1 {
+		kingpin.Fatalf("--name requires a single --zone-id")
+	}
+
+	ctx := context.Background()
+	var zones []*legacyrules.Zone
+	switch *from {
+	case "zone":
+		if len(*zoneIDs) == 0 {
+			kingpin.Fatalf("--zone-id is required when reading from the live zones")
+		}
+		api, err := cloudflare.NewWithAPIToken(*apiToken)
+		kingpin.FatalIfError(err, "Cannot create the Cloudflare API client")
+		for _, id := range *zoneIDs {
+			z, err := legacyrules.FromAPI(ctx, api, id)
+			kingpin.FatalIfError(err, "Cannot read zone %s", id)
+			zones = append(zones, z)
+		}
+	default:
+		s := runtime.NewScheme()
+		for _, add := range []func(*runtime.Scheme) error{
+			cloudflarev1alpha1.AddToScheme, firewallv1alpha1.AddToScheme, pagev1alpha1.AddToScheme, ratev1alpha1.AddToScheme,
+		} {
+			kingpin.FatalIfError(add(s), "Cannot add the APIs to the scheme")
+		}
+		cfg, err := ctrl.GetConfig()
+		kingpin.FatalIfError(err, "Cannot get the cluster config")
+		kube, err := client.New(cfg, client.Options{Scheme: s})
+		kingpin.FatalIfError(err, "Cannot create the cluster client")
+		zones, err = legacyrules.FromCluster(ctx, kube, *zoneIDs...)
+		kingpin.FatalIfError(err, "Cannot read the managed resources")
+	}
+
+	var objs []runtime.Object
+	var findings []string
+	for _, z := range zones {
+		res := legacyrules.Convert(z, legacyrules.Options{Name: *name, ProviderConfig: *providerConfig})
+		objs = append(objs, res.Objects...)
+		for _, f := range res.Findings {
+			findings = append(findings, fmt.Sprintf("zone %s: %s", z.ID, f))
+		}
+	}
+	out, err := manifests.MarshalYAML(objs)
+	kingpin.FatalIfError(err, "Cannot render the rulesets")
+
+	var w io.Writer = os.Stderr
+	if *report != "" {
+		f, err := os.Create(*report)
+		kingpin.FatalIfError(err, "Cannot create the report")
+		defer f.Close() //nolint:errcheck
+		w = f
+	}
+	for _, f := range findings {
+		fmt.Fprintln(w, f)
+	}
+	_, err = os.Stdout.Write(out)
+	kingpin.FatalIfError(err, "Cannot write the rulesets")
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/manifests"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/wrangler"
+)
+
+func main() {
+	var (
+		app            = kingpin.New(filepath.Base(os.Args[0]), "Convert a wrangler.toml, wrangler.json or wrangler.jsonc file into Cloudflare managed resources.").DefaultEnvars()
+		file           = app.Arg("file", "Path to the Wrangler configuration file.").Default("wrangler.toml").ExistingFile()
+		env            = app.Flag("env", "Wrangler environment to convert.").Short('e').String()
+		accountID      = app.Flag("account-id", "Cloudflare account ID, overrides the account_id of the file.").String()
+		namespace      = app.Flag("content-namespace", "Namespace of the ConfigMap holding the Worker bundle.").Default("crossplane-system").String()
+		providerConfig = app.Flag("provider-config", "Name of the ProviderConfig of the resources.").Default("default").String()
+		references     = app.Flag("references", "Reference KV namespaces, buckets, databases, queues, Hyperdrive configs, zones and Workers by object name instead of setting their names and identifiers.").Default("true").Bool()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	data, err := os.ReadFile(*file)
+	kingpin.FatalIfError(err, "Cannot read the Wrangler configuration file")
+	cfg, err := wrangler.Parse(*file, data)
+	kingpin.FatalIfError(err, "Cannot parse the Wrangler configuration file")
+	cfg, err = cfg.ForEnv(*env)
+	kingpin.FatalIfError(err, "Cannot select the Wrangler environment")
+
+	res, err := wrangler.Convert(cfg, wrangler.Options{
+		AccountID:        *accountID,
+		ContentNamespace: *namespace,
+		ProviderConfig:   *providerConfig,
+		References:       *references,
+	})
+	kingpin.FatalIfError(err, "Cannot convert the Wrangler configuration")
+	out, err := manifests.MarshalYAML(res.Objects)
+	kingpin.FatalIfError(err, "Cannot render the managed resources")
+
+	for _, w := range res.Warnings {
+		fmt.Fprintln(os.Stderr, "warning:", w)
+	}
+	_, err = os.Stdout.Write(out)
+	kingpin.FatalIfError(err, "Cannot write the managed resources")
+}
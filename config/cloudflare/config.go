@@ -0,0 +1,36 @@
+package cloudflare
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/upjet/v2/pkg/config"
+)
+
+// Connection detail keys of the nameservers assigned to a zone.
+const (
+	KeyNameServers = "name_servers"
+	KeyNameServer  = "name_server_%d"
+)
+
+// Configure publishes the nameservers assigned to zones as connection
+// details, so that they can be handed to the registrar or the parent zone.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("cloudflare_zone", func(r *config.Resource) {
+		r.Sensitive.AdditionalConnectionDetailsFn = func(attr map[string]any) (map[string][]byte, error) {
+			conn := map[string][]byte{}
+			l, _ := attr["name_servers"].([]any)
+			var ns []string
+			for _, v := range l {
+				if s, ok := v.(string); ok && s != "" {
+					conn[fmt.Sprintf(KeyNameServer, len(ns))] = []byte(s)
+					ns = append(ns, s)
+				}
+			}
+			if len(ns) > 0 {
+				conn[KeyNameServers] = []byte(strings.Join(ns, ","))
+			}
+			return conn, nil
+		}
+	})
+}
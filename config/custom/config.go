@@ -0,0 +1,96 @@
+package custom
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/pkg/errors"
+)
+
+const errMarshalValidationRecords = "cannot marshal the validation records"
+
+// Connection detail keys of the ownership verification of a custom hostname.
+const (
+	KeyOwnershipVerificationType     = "ownership_verification_type"
+	KeyOwnershipVerificationName     = "ownership_verification_name"
+	KeyOwnershipVerificationValue    = "ownership_verification_value"
+	KeyOwnershipVerificationHTTPURL  = "ownership_verification_http_url"
+	KeyOwnershipVerificationHTTPBody = "ownership_verification_http_body"
+)
+
+// Connection detail keys of the certificate validation records of a custom
+// hostname: all of them as a JSON list, and each field of each record, e.g.
+// validation_record_0_txt_name.
+const (
+	KeyValidationRecords = "validation_records"
+	KeyValidationRecord  = "validation_record_%d_%s"
+)
+
+// validationRecordFields are the fields of a certificate validation record
+// published under their own keys.
+var validationRecordFields = []string{"txt_name", "txt_value", "cname", "cname_target", "http_url", "http_body"}
+
+// Configure publishes the ownership verification and certificate validation
+// records of custom hostnames as connection details, so that they can be
+// handed to the owner of the hostname. The validation records are published
+// under the keys a HostnameVerification uses when the state of the hostname
+// holds them, which the Terraform provider currently does not, so a
+// HostnameVerification remains the way to read them.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("cloudflare_custom_hostname", func(r *config.Resource) {
+		r.Sensitive.AdditionalConnectionDetailsFn = func(attr map[string]any) (map[string][]byte, error) {
+			conn := map[string][]byte{}
+			for block, keys := range map[string]map[string]string{
+				"ownership_verification": {
+					"type":  KeyOwnershipVerificationType,
+					"name":  KeyOwnershipVerificationName,
+					"value": KeyOwnershipVerificationValue,
+				},
+				"ownership_verification_http": {
+					"http_url":  KeyOwnershipVerificationHTTPURL,
+					"http_body": KeyOwnershipVerificationHTTPBody,
+				},
+			} {
+				o := object(attr[block])
+				for attr, key := range keys {
+					if v, ok := o[attr].(string); ok && v != "" {
+						conn[key] = []byte(v)
+					}
+				}
+			}
+			records, _ := object(attr["ssl"])["validation_records"].([]any)
+			for i, r := range records {
+				o, _ := r.(map[string]any)
+				for _, f := range validationRecordFields {
+					if v, ok := o[f].(string); ok && v != "" {
+						conn[fmt.Sprintf(KeyValidationRecord, i, f)] = []byte(v)
+					}
+				}
+			}
+			if len(records) > 0 {
+				b, err := json.Marshal(records)
+				if err != nil {
+					return nil, errors.Wrap(err, errMarshalValidationRecords)
+				}
+				conn[KeyValidationRecords] = b
+			}
+			return conn, nil
+		}
+	})
+}
+
+// object returns the given single nested attribute as a map, which the
+// state holds either as an object or as a list of one object.
+func object(v any) map[string]any {
+	switch o := v.(type) {
+	case map[string]any:
+		return o
+	case []any:
+		if len(o) == 1 {
+			m, _ := o[0].(map[string]any)
+			return m
+		}
+	}
+	return nil
+}
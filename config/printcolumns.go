@@ -0,0 +1,22 @@
+package config
+
+// PrinterColumn is an additional printer column of the kind of a resource.
+type PrinterColumn struct {
+	// Name of the column.
+	Name string
+	// Type of the column, e.g. string or integer.
+	Type string
+	// JSONPath of the field shown in the column.
+	JSONPath string
+}
+
+// PrinterColumns returns the printer columns shown before the ones every
+// managed resource has, keyed by Terraform resource name.
+func PrinterColumns() map[string][]PrinterColumn {
+	return map[string][]PrinterColumn{
+		"cloudflare_zone": {
+			{Name: "STATUS", Type: "string", JSONPath: ".status.atProvider.status"},
+			{Name: "NAME-SERVERS", Type: "string", JSONPath: ".status.atProvider.nameServers"},
+		},
+	}
+}
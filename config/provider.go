@@ -4,6 +4,14 @@ import (
 	_ "embed"
 
 	ujconfig "github.com/crossplane/upjet/v2/pkg/config"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/cloudflare"
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/custom"
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/queue"
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/r2"
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/workers"
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/zero"
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/zone"
 )
 
 const (
@@ -26,6 +34,18 @@ func GetProvider() *ujconfig.Provider {
 			ExternalNameConfigurations(),
 		))
 
+	for _, configure := range []func(provider *ujconfig.Provider){
+		cloudflare.Configure,
+		custom.Configure,
+		queue.Configure,
+		r2.Configure,
+		workers.Configure,
+		zero.Configure,
+		zone.Configure,
+	} {
+		configure(pc)
+	}
+
 	pc.ConfigureResources()
 	return pc
 }
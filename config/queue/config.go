@@ -0,0 +1,21 @@
+package queue
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+)
+
+const extractScriptName = `github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)`
+
+// Configure adds the references from a queue consumer to the queue it
+// consumes and to the Worker script consuming it.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("cloudflare_queue_consumer", func(r *config.Resource) {
+		r.References["queue_id"] = config.Reference{
+			TerraformName: "cloudflare_queue",
+		}
+		r.References["script_name"] = config.Reference{
+			TerraformName: "cloudflare_workers_script",
+			Extractor:     extractScriptName,
+		}
+	})
+}
@@ -0,0 +1,249 @@
+package r2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/r2"
+)
+
+const (
+	// forceDestroyField and s3CredentialsField are arguments injected into
+	// the R2 bucket so that a bucket holding objects can be deleted. They
+	// are removed before reaching Terraform.
+	forceDestroyField  = "force_destroy"
+	s3CredentialsField = "s3_credentials_secret_ref"
+
+	// ConditionTypeForceDestroy reports the progress of emptying a bucket
+	// that is being deleted.
+	ConditionTypeForceDestroy xpv1.ConditionType = "ForceDestroy"
+
+	// ReasonEmptying and ReasonEmptied are the reasons of the ForceDestroy
+	// condition.
+	ReasonEmptying xpv1.ConditionReason = "Emptying"
+	ReasonEmptied  xpv1.ConditionReason = "Emptied"
+
+	errNoBucketName     = "cannot determine the name of the bucket to empty"
+	errNoAccountID      = "cannot determine the account of the bucket to empty"
+	errDecodeSecretRef  = "cannot decode s3CredentialsSecretRef"
+	errEmptyBucket      = "cannot empty the bucket"
+	errBucketNotEmptied = "the bucket is not empty yet: %s"
+)
+
+// emptyBudget bounds the time spent emptying a bucket in a single reconcile
+// so that the Terraform delete still fits in the reconcile timeout. Larger
+// buckets are emptied over several reconciles.
+var emptyBudget = 90 * time.Second
+
+// Configure lets R2 buckets holding objects be deleted, reads all the
+// Sippy credentials from Secrets and adds the reference from a bucket event
+// notification to the queue it targets.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("cloudflare_r2_bucket", func(r *config.Resource) {
+		addForceDestroy(r)
+	})
+	p.AddResourceConfigurator("cloudflare_r2_bucket_sippy", func(r *config.Resource) {
+		// The secret halves of the credentials are sensitive upstream. The
+		// identifiers are marked sensitive too so that a credential is
+		// read from a single Secret, such as the connection secret of an
+		// R2 Credentials resource, instead of being split between the
+		// Secret and the spec. This removed the plain identifier fields,
+		// see Upgrading in the README for the migration.
+		for block, fields := range map[string][]string{
+			"source":      {"access_key_id", "client_email"},
+			"destination": {"access_key_id"},
+		} {
+			elem := r.TerraformResource.Schema[block].Elem.(*schema.Resource)
+			for _, f := range fields {
+				elem.Schema[f].Sensitive = true
+			}
+		}
+	})
+	p.AddResourceConfigurator("cloudflare_r2_bucket_event_notification", func(r *config.Resource) {
+		r.References["queue_id"] = config.Reference{
+			TerraformName: "cloudflare_queue",
+		}
+	})
+}
+
+func addForceDestroy(r *config.Resource) {
+	r.TerraformResource.Schema[forceDestroyField] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Delete all objects and abort all incomplete multipart uploads of the bucket through the S3 API before deleting it. The progress is reported in the ForceDestroy condition.",
+	}
+	r.TerraformResource.Schema[s3CredentialsField] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Secret with the access_key_id, secret_access_key and optional endpoint keys used to empty the bucket, such as the connection secret of an R2 Credentials resource. The endpoint may point at any S3 compatible server. Defaults to credentials derived from the api_token of the ProviderConfig.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the Secret.",
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Namespace of the Secret.",
+				},
+			},
+		},
+	}
+	if r.MetaResource != nil {
+		r.MetaResource.ArgumentDocs[forceDestroyField] = "(Boolean) " + r.TerraformResource.Schema[forceDestroyField].Description
+		r.MetaResource.ArgumentDocs[s3CredentialsField] = "(Attributes) " + r.TerraformResource.Schema[s3CredentialsField].Description
+		r.MetaResource.ArgumentDocs[s3CredentialsField+".name"] = "(String) Name of the Secret."
+		r.MetaResource.ArgumentDocs[s3CredentialsField+".namespace"] = "(String) Namespace of the Secret."
+	}
+	r.AddSingletonListConversion(s3CredentialsField+"[*]", "s3CredentialsSecretRef[*]")
+	r.TerraformConversions = append(r.TerraformConversions, forceDestroyConversion{})
+}
+
+// ProviderCredentialsFn derives the S3 credentials of the bucket at the given
+// endpoint from the ProviderConfig of the given managed resource.
+type ProviderCredentialsFn func(ctx context.Context, kube client.Client, mg xpresource.Managed, endpoint string) (r2.Credentials, error)
+
+// ConfigureForceDestroy empties the R2 buckets deleted with forceDestroy set,
+// deriving the S3 credentials of the buckets without s3CredentialsSecretRef
+// with the given function. The provider passes clients.R2Credentials, which
+// this package cannot import as the clients depend on the API types
+// generated from it.
+func ConfigureForceDestroy(p *config.Provider, creds ProviderCredentialsFn) {
+	r := p.Resources["cloudflare_r2_bucket"]
+	r.InitializerFns = append(r.InitializerFns, func(kube client.Client) managed.Initializer {
+		return NewForceDestroyInitializer(kube, creds)
+	})
+}
+
+// ForceDestroyInitializer empties an R2 bucket that is being deleted with
+// forceDestroy set. Initializers run before the managed reconciler deletes
+// the external resource, so the Terraform delete is only attempted once the
+// bucket is empty.
+type ForceDestroyInitializer struct {
+	kube        client.Client
+	credentials ProviderCredentialsFn
+}
+
+// NewForceDestroyInitializer returns a new ForceDestroyInitializer.
+func NewForceDestroyInitializer(kube client.Client, creds ProviderCredentialsFn) managed.Initializer {
+	return &ForceDestroyInitializer{kube: kube, credentials: creds}
+}
+
+// Initialize empties the bucket of the given managed resource if it is being
+// deleted with forceDestroy set.
+func (f *ForceDestroyInitializer) Initialize(ctx context.Context, mg xpresource.Managed) error {
+	if !meta.WasDeleted(mg) || !deletesExternal(mg) {
+		return nil
+	}
+	paved, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return err
+	}
+	if force, err := paved.GetBool("spec.forProvider.forceDestroy"); err != nil || !force {
+		return nil
+	}
+	bucket, _ := paved.GetString("spec.forProvider.name")
+	if bucket == "" {
+		bucket = meta.GetExternalName(mg)
+	}
+	if bucket == "" {
+		return errors.New(errNoBucketName)
+	}
+	account, _ := paved.GetString("spec.forProvider.accountId")
+	if account == "" {
+		return errors.New(errNoAccountID)
+	}
+	jurisdiction, _ := paved.GetString("spec.forProvider.jurisdiction")
+	endpoint := r2.Endpoint(account, jurisdiction)
+
+	var creds r2.Credentials
+	if _, err := paved.GetValue("spec.forProvider.s3CredentialsSecretRef"); err == nil {
+		ref := r2.SecretReference{}
+		if err := paved.GetValueInto("spec.forProvider.s3CredentialsSecretRef", &ref); err != nil {
+			return errors.Wrap(err, errDecodeSecretRef)
+		}
+		creds, err = r2.CredentialsFromSecret(ctx, f.kube, ref, endpoint)
+		if err != nil {
+			return err
+		}
+	} else {
+		creds, err = f.credentials(ctx, f.kube, mg, endpoint)
+		if err != nil {
+			return err
+		}
+	}
+	s3, err := r2.NewClient(creds)
+	if err != nil {
+		return err
+	}
+
+	p, err := r2.EmptyBucket(ctx, s3, bucket, time.Now().Add(emptyBudget))
+	msg := fmt.Sprintf("Deleted %d objects and aborted %d incomplete multipart uploads in the last pass", p.ObjectsDeleted, p.UploadsAborted)
+	cond := xpv1.Condition{
+		Type:               ConditionTypeForceDestroy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonEmptying,
+		Message:            msg,
+	}
+	if p.Empty && err == nil {
+		cond.Status, cond.Reason = corev1.ConditionTrue, ReasonEmptied
+	}
+	mg.SetConditions(cond)
+	if err != nil {
+		return errors.Wrap(err, errEmptyBucket)
+	}
+	if !p.Empty {
+		// Returning an error keeps the managed reconciler from deleting the
+		// bucket and requeues the deletion.
+		return errors.Errorf(errBucketNotEmptied, msg)
+	}
+	return nil
+}
+
+// deletesExternal reports whether deleting the given managed resource
+// deletes the external resource.
+func deletesExternal(mg xpresource.Managed) bool {
+	if o, ok := mg.(xpresource.Orphanable); ok && o.GetDeletionPolicy() == xpv1.DeletionOrphan {
+		return false
+	}
+	policies := mg.GetManagementPolicies()
+	if len(policies) == 0 {
+		return true
+	}
+	for _, p := range policies {
+		if p == xpv1.ManagementActionAll || p == xpv1.ManagementActionDelete {
+			return true
+		}
+	}
+	return false
+}
+
+// forceDestroyConversion removes the injected force_destroy and
+// s3_credentials_secret_ref arguments of an R2 bucket.
+type forceDestroyConversion struct{}
+
+func (forceDestroyConversion) Convert(params map[string]any, _ *config.Resource, mode config.Mode) (map[string]any, error) {
+	if mode != config.ToTerraform {
+		return params, nil
+	}
+	delete(params, forceDestroyField)
+	delete(params, s3CredentialsField)
+	return params, nil
+}
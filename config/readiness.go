@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ReadinessCheck reports whether a resource with the given observation,
+// its observed Terraform attributes, is actually serving. When it is
+// not, the returned message describes what the resource is waiting for.
+type ReadinessCheck func(observation map[string]any) (ready bool, message string)
+
+// ReadinessChecks returns the readiness checks of the resources that exist
+// in Cloudflare some time before they serve, keyed by Terraform resource
+// name. Such resources are kept Ready=False until their check passes
+// instead of becoming ready as soon as they have been created.
+func ReadinessChecks() map[string]ReadinessCheck {
+	return map[string]ReadinessCheck{
+		"cloudflare_zone": statusIn("zone",
+			"the nameservers of the zone have not been delegated to Cloudflare yet", "active"),
+		"cloudflare_certificate_pack": statusIn("certificate pack",
+			"the certificates of the pack have not been validated and deployed yet", "active"),
+		"cloudflare_custom_hostname": statusIn("custom hostname",
+			"the ownership of the hostname has not been verified yet", "active"),
+		"cloudflare_zero_trust_tunnel_cloudflared": statusIn("tunnel",
+			"no cloudflared connector is connected to the tunnel", "healthy", "degraded"),
+	}
+}
+
+// statusIn returns a ReadinessCheck passing when the observed status is one
+// of the serving ones.
+func statusIn(noun, waiting string, serving ...string) ReadinessCheck {
+	return func(observation map[string]any) (bool, string) {
+		status, _ := observation["status"].(string)
+		if slices.Contains(serving, status) {
+			return true, ""
+		}
+		if status == "" {
+			return false, fmt.Sprintf("The %s has not reported a status yet", noun)
+		}
+		return false, fmt.Sprintf("The %s is %q: %s", noun, status, waiting)
+	}
+}
@@ -0,0 +1,123 @@
+package workers
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// hyperdriveIDField is an argument injected into the Worker script
+	// bindings so that Hyperdrive bindings can reference a Hyperdrive config
+	// independently of D1 bindings, which reference their database through
+	// the same id argument. It is moved into id before reaching Terraform.
+	hyperdriveIDField = "hyperdrive_id"
+	hyperdriveIDDoc   = "Identifier of the Hyperdrive config to bind in a hyperdrive binding. Used as the binding id when id is not set."
+
+	extractName       = `github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("name",false)`
+	extractQueueName  = `github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("queue_name",false)`
+	extractScriptName = `github.com/crossplane/upjet/v2/pkg/resource.ExtractParamPath("script_name",false)`
+)
+
+// Configure adds the references from the Worker script bindings to the
+// resources they bind to and lets the Worker content be sourced from
+// ConfigMaps, Secrets or OCI artifacts. Routes, custom domains and cron
+// triggers reference the Worker script they attach to.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("cloudflare_workers_route", func(r *config.Resource) {
+		r.References["script"] = config.Reference{
+			TerraformName: "cloudflare_workers_script",
+			Extractor:     extractScriptName,
+		}
+		r.References["zone_id"] = config.Reference{
+			TerraformName: "cloudflare_zone",
+		}
+	})
+	p.AddResourceConfigurator("cloudflare_workers_custom_domain", func(r *config.Resource) {
+		r.References["service"] = config.Reference{
+			TerraformName: "cloudflare_workers_script",
+			Extractor:     extractScriptName,
+		}
+		r.References["zone_id"] = config.Reference{
+			TerraformName: "cloudflare_zone",
+		}
+	})
+	p.AddResourceConfigurator("cloudflare_workers_cron_trigger", func(r *config.Resource) {
+		r.References["script_name"] = config.Reference{
+			TerraformName: "cloudflare_workers_script",
+			Extractor:     extractScriptName,
+		}
+	})
+
+	p.AddResourceConfigurator("cloudflare_workers_script", func(r *config.Resource) {
+		if s, ok := r.TerraformResource.Schema["bindings"]; ok {
+			if b, ok := s.Elem.(*schema.Resource); ok {
+				b.Schema[hyperdriveIDField] = &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: hyperdriveIDDoc,
+				}
+			}
+		}
+		if r.MetaResource != nil {
+			r.MetaResource.ArgumentDocs["bindings."+hyperdriveIDField] = "(String) " + hyperdriveIDDoc
+		}
+		r.TerraformConversions = append(r.TerraformConversions, hyperdriveBindingConversion{})
+		addContentFrom(r)
+
+		r.References["bindings.namespace_id"] = config.Reference{
+			TerraformName: "cloudflare_workers_kv_namespace",
+		}
+		r.References["bindings.bucket_name"] = config.Reference{
+			TerraformName: "cloudflare_r2_bucket",
+			Extractor:     extractName,
+		}
+		r.References["bindings.id"] = config.Reference{
+			TerraformName:     "cloudflare_d1_database",
+			RefFieldName:      "D1DatabaseRef",
+			SelectorFieldName: "D1DatabaseSelector",
+		}
+		r.References["bindings."+hyperdriveIDField] = config.Reference{
+			TerraformName:     "cloudflare_hyperdrive_config",
+			RefFieldName:      "HyperdriveConfigRef",
+			SelectorFieldName: "HyperdriveConfigSelector",
+		}
+		r.References["bindings.queue_name"] = config.Reference{
+			TerraformName: "cloudflare_queue",
+			Extractor:     extractQueueName,
+		}
+		r.References["bindings.service"] = config.Reference{
+			TerraformName: "cloudflare_workers_script",
+			Extractor:     extractScriptName,
+		}
+	})
+}
+
+// hyperdriveBindingConversion moves the injected hyperdrive_id argument of
+// the Worker script bindings into the id argument the Terraform provider
+// expects. The injected argument never reaches Terraform.
+type hyperdriveBindingConversion struct{}
+
+func (hyperdriveBindingConversion) Convert(params map[string]any, _ *config.Resource, mode config.Mode) (map[string]any, error) {
+	if mode != config.ToTerraform {
+		return params, nil
+	}
+	bindings, ok := params["bindings"].([]any)
+	if !ok {
+		return params, nil
+	}
+	for _, b := range bindings {
+		binding, ok := b.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, ok := binding[hyperdriveIDField]
+		if !ok {
+			continue
+		}
+		delete(binding, hyperdriveIDField)
+		if _, ok := binding["id"]; !ok {
+			binding["id"] = id
+		}
+	}
+	return params, nil
+}
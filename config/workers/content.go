@@ -0,0 +1,370 @@
+package workers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/workers"
+)
+
+const (
+	// contentFromField is an argument injected into the Worker script so
+	// that its content can be sourced from a ConfigMap, a Secret or an OCI
+	// artifact. It is replaced by content_file before reaching Terraform.
+	contentFromField = "content_from"
+
+	errContentConflict  = "contentFrom cannot be combined with content or contentFile"
+	errContentSource    = "contentFrom must set exactly one of configMapKeyRef, secretKeyRef or oci"
+	errContentFromInit  = "contentFrom cannot be set in initProvider, set it in forProvider"
+	errGetConfigMap     = "cannot get ConfigMap with the Worker content"
+	errGetSecret        = "cannot get Secret with the Worker content"
+	errMissingKey       = "key %q not found in %s %s/%s"
+	errNoModules        = "%s %s/%s has no keys"
+	errMainModule       = "mainModule must name one of the modules of contentFrom: %s"
+	errMainModuleType   = "main module %s must be a JavaScript or Python module"
+	errUpdateScript     = "cannot update the Worker script with the content hash"
+	errDecodeContentSrc = "cannot decode contentFrom"
+)
+
+// moduleTypes are the content types of the modules of a multi-module Worker
+// by file extension. Other files are uploaded as data modules.
+var moduleTypes = map[string]string{
+	".js":   "application/javascript+module",
+	".mjs":  "application/javascript+module",
+	".cjs":  "text/javascript",
+	".py":   "text/x-python",
+	".wasm": "application/wasm",
+	".txt":  "text/plain",
+	".html": "text/plain",
+}
+
+// moduleComments are the line comment prefixes of the main modules of a
+// multi-module Worker by file extension.
+var moduleComments = map[string]string{
+	".js":  "//",
+	".mjs": "//",
+	".cjs": "//",
+	".py":  "#",
+}
+
+func addContentFrom(r *config.Resource) {
+	keySelector := func(kind string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Selects a key of a " + kind + " holding the Worker content.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the " + kind + ".",
+					},
+					"namespace": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Namespace of the " + kind + ".",
+					},
+					"key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Key of the " + kind + " holding the Worker content. When omitted, every key is uploaded as a module of a multi-module Worker, named by the key, and main_module names the main module.",
+					},
+				},
+			},
+		}
+	}
+	r.TerraformResource.Schema[contentFromField] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Source of the Worker content, either a single file or the modules of a multi-module Worker. The content_sha256 argument is computed from the resolved content and the Worker is re-deployed when it changes. Modules are typed by their extension: .js and .mjs are ES modules, .cjs CommonJS, .py Python, .wasm WebAssembly, .txt and .html text and others data modules. Can only be set in forProvider. Conflicts with content and content_file.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"config_map_key_ref": keySelector("ConfigMap"),
+				"secret_key_ref":     keySelector("Secret"),
+				"oci": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "OCI artifact holding the Worker content, such as one pushed with oras.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"reference": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Reference of the artifact, e.g. ghcr.io/example/worker:v1 or ghcr.io/example/worker@sha256:...",
+							},
+							"path": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Title of the artifact layer holding the Worker content. When omitted, the only layer of an artifact is uploaded as the Worker content, and every layer of an artifact with several layers as a module of a multi-module Worker, named by the layer title, with main_module naming the main module.",
+							},
+							"pull_secret_ref": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    1,
+								Description: "Secret of type kubernetes.io/dockerconfigjson with the registry credentials.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "Name of the Secret.",
+										},
+										"namespace": {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "Namespace of the Secret.",
+										},
+									},
+								},
+							},
+							"plain_http": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Access the registry over plain HTTP.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for tf, crd := range map[string]string{
+		"content_from[*]":                           "contentFrom[*]",
+		"content_from[*].config_map_key_ref[*]":     "contentFrom[*].configMapKeyRef[*]",
+		"content_from[*].secret_key_ref[*]":         "contentFrom[*].secretKeyRef[*]",
+		"content_from[*].oci[*]":                    "contentFrom[*].oci[*]",
+		"content_from[*].oci[*].pull_secret_ref[*]": "contentFrom[*].oci[*].pullSecretRef[*]",
+	} {
+		r.AddSingletonListConversion(tf, crd)
+	}
+	// content_file points at the provider cache once contentFrom is
+	// resolved and must not be late-initialized, or it would conflict with
+	// contentFrom on the next reconcile.
+	r.LateInitializer.IgnoredFields = append(r.LateInitializer.IgnoredFields, "content", "content_file")
+	r.InitializerFns = append(r.InitializerFns, NewContentInitializer)
+	r.TerraformConversions = append(r.TerraformConversions, contentFromConversion{})
+}
+
+// contentSource is the contentFrom argument of a Worker script.
+type contentSource struct {
+	ConfigMapKeyRef *keySelector `json:"configMapKeyRef,omitempty"`
+	SecretKeyRef    *keySelector `json:"secretKeyRef,omitempty"`
+	OCI             *ociSource   `json:"oci,omitempty"`
+}
+
+type keySelector struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key,omitempty"`
+}
+
+// ContentInitializer resolves the contentFrom argument of a Worker script,
+// writes the content to the provider cache and records its hash in
+// contentSha256.
+type ContentInitializer struct {
+	kube client.Client
+}
+
+// NewContentInitializer returns a new ContentInitializer.
+func NewContentInitializer(kube client.Client) managed.Initializer {
+	return &ContentInitializer{kube: kube}
+}
+
+// Initialize resolves the Worker content of the given managed resource.
+func (c *ContentInitializer) Initialize(ctx context.Context, mg xpresource.Managed) error {
+	if sets.New[xpv1.ManagementAction](mg.GetManagementPolicies()...).Equal(sets.New[xpv1.ManagementAction](xpv1.ManagementActionObserve)) {
+		return nil
+	}
+	paved, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return err
+	}
+	// Fields of initProvider are ignored by Terraform once the resource
+	// exists, which the content_file replacing contentFrom cannot be.
+	if raw, err := paved.GetValue("spec.initProvider.contentFrom"); err == nil && raw != nil {
+		return errors.New(errContentFromInit)
+	}
+	raw, err := paved.GetValue("spec.forProvider.contentFrom")
+	if fieldpath.IsNotFound(err) || (err == nil && raw == nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	src := contentSource{}
+	if err := paved.GetValueInto("spec.forProvider.contentFrom", &src); err != nil {
+		return errors.Wrap(err, errDecodeContentSrc)
+	}
+	for _, f := range []string{"spec.forProvider.content", "spec.forProvider.contentFile"} {
+		if s, err := paved.GetString(f); err == nil && s != "" {
+			return errors.New(errContentConflict)
+		}
+	}
+
+	mainModule, _ := paved.GetString("spec.forProvider.mainModule")
+	sum, err := c.resolve(ctx, src, mainModule)
+	if err != nil {
+		return err
+	}
+	if current, err := paved.GetString("spec.forProvider.contentSha256"); err == nil && current == sum {
+		return nil
+	}
+	if err := paved.SetValue("spec.forProvider.contentSha256", sum); err != nil {
+		return err
+	}
+	b, err := paved.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, mg); err != nil {
+		return err
+	}
+	return errors.Wrap(c.kube.Update(ctx, mg), errUpdateScript)
+}
+
+// resolve fetches the Worker content from the given source, writes it to
+// the provider cache and returns the hex encoded SHA-256 hash of the content
+// uploaded by the Terraform provider. A source with several modules is
+// uploaded as a multi-module Worker with the given main module.
+func (c *ContentInitializer) resolve(ctx context.Context, src contentSource, mainModule string) (string, error) {
+	var modules map[string][]byte
+	var err error
+	switch {
+	case src.ConfigMapKeyRef != nil && src.SecretKeyRef == nil && src.OCI == nil:
+		modules, err = c.fromConfigMap(ctx, src.ConfigMapKeyRef)
+	case src.SecretKeyRef != nil && src.ConfigMapKeyRef == nil && src.OCI == nil:
+		modules, err = c.fromSecret(ctx, src.SecretKeyRef)
+	case src.OCI != nil && src.ConfigMapKeyRef == nil && src.SecretKeyRef == nil:
+		return resolveOCI(ctx, c.kube, src.OCI, mainModule)
+	default:
+		return "", errors.New(errContentSource)
+	}
+	if err != nil {
+		return "", err
+	}
+	return writeModules(modules, mainModule)
+}
+
+// fromConfigMap returns the selected key of a ConfigMap, or all of its keys
+// if none is selected, keyed by their names.
+func (c *ContentInitializer) fromConfigMap(ctx context.Context, sel *keySelector) (map[string][]byte, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: sel.Name, Namespace: sel.Namespace}, cm); err != nil {
+		return nil, errors.Wrap(err, errGetConfigMap)
+	}
+	all := map[string][]byte{}
+	for k, v := range cm.Data {
+		all[k] = []byte(v)
+	}
+	maps.Copy(all, cm.BinaryData)
+	return selectKey(all, sel, "ConfigMap")
+}
+
+// fromSecret returns the selected key of a Secret, or all of its keys if
+// none is selected, keyed by their names.
+func (c *ContentInitializer) fromSecret(ctx context.Context, sel *keySelector) (map[string][]byte, error) {
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: sel.Name, Namespace: sel.Namespace}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+	return selectKey(s.Data, sel, "Secret")
+}
+
+func selectKey(all map[string][]byte, sel *keySelector, kind string) (map[string][]byte, error) {
+	if sel.Key == "" {
+		if len(all) == 0 {
+			return nil, errors.Errorf(errNoModules, kind, sel.Namespace, sel.Name)
+		}
+		return all, nil
+	}
+	v, ok := all[sel.Key]
+	if !ok {
+		return nil, errors.Errorf(errMissingKey, sel.Key, kind, sel.Namespace, sel.Name)
+	}
+	return map[string][]byte{sel.Key: v}, nil
+}
+
+// writeModules writes the given modules to the provider cache and returns
+// the hex encoded SHA-256 hash of the content uploaded by the Terraform
+// provider. A single module is that content. Otherwise the Terraform
+// provider uploads the given main module, and the Proxy adds the other
+// modules, which are recorded under the hash of the main module. The hash of
+// the other modules is appended to the main module as a comment, so that the
+// Worker is re-deployed when any of them changes.
+func writeModules(modules map[string][]byte, mainModule string) (string, error) {
+	if len(modules) == 1 {
+		for _, content := range modules {
+			return workers.WriteContent(content)
+		}
+	}
+	content, ok := modules[mainModule]
+	if !ok {
+		return "", errors.Errorf(errMainModule, strings.Join(slices.Sorted(maps.Keys(modules)), ", "))
+	}
+	comment, ok := moduleComments[path.Ext(mainModule)]
+	if !ok {
+		return "", errors.Errorf(errMainModuleType, mainModule)
+	}
+	others := make([]workers.Module, 0, len(modules)-1)
+	for name, c := range modules {
+		if name == mainModule {
+			continue
+		}
+		sum, err := workers.WriteContent(c)
+		if err != nil {
+			return "", err
+		}
+		typ, ok := moduleTypes[path.Ext(name)]
+		if !ok {
+			typ = "application/octet-stream"
+		}
+		others = append(others, workers.Module{Name: name, ContentType: typ, SHA256: sum})
+	}
+	main := append(append([]byte{}, content...), []byte("\n"+comment+" modules: "+workers.ModulesSHA256(others)+"\n")...)
+	h := sha256.Sum256(main)
+	if err := workers.WriteModules(hex.EncodeToString(h[:]), others); err != nil {
+		return "", err
+	}
+	return workers.WriteContent(main)
+}
+
+// contentFromConversion replaces the injected content_from argument of a
+// Worker script with the content_file argument pointing at the cached
+// content resolved by the ContentInitializer.
+type contentFromConversion struct{}
+
+func (contentFromConversion) Convert(params map[string]any, _ *config.Resource, mode config.Mode) (map[string]any, error) {
+	if mode != config.ToTerraform {
+		return params, nil
+	}
+	if _, ok := params[contentFromField]; !ok {
+		return params, nil
+	}
+	delete(params, contentFromField)
+	if sum, ok := params["content_sha256"].(string); ok && sum != "" {
+		params["content_file"] = workers.ContentPath(sum)
+	}
+	return params, nil
+}
@@ -0,0 +1,196 @@
+package workers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/workers"
+)
+
+const (
+	errParseReference   = "cannot parse the OCI artifact reference"
+	errResolveArtifact  = "cannot resolve the OCI artifact"
+	errFetchManifest    = "cannot fetch the OCI artifact manifest"
+	errDecodeManifest   = "cannot decode the OCI artifact manifest"
+	errFetchLayer       = "cannot fetch the OCI artifact layer"
+	errGetPullSecret    = "cannot get the OCI pull Secret"
+	errDecodePullSecret = "cannot decode the OCI pull Secret"
+	errNoLayer          = "OCI artifact has no layer titled %q"
+	errNoLayers         = "OCI artifact has no layers"
+	errUntitledLayer    = "layer %s of the OCI artifact has no title"
+)
+
+type ociSource struct {
+	Reference     string         `json:"reference"`
+	Path          string         `json:"path,omitempty"`
+	PullSecretRef *pullSecretRef `json:"pullSecretRef,omitempty"`
+	PlainHTTP     bool           `json:"plainHttp,omitempty"`
+}
+
+type pullSecretRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// dockerConfig is the content of a kubernetes.io/dockerconfigjson Secret.
+type dockerConfig struct {
+	Auths map[string]struct {
+		Username string `json:"username,omitempty"`
+		Password string `json:"password,omitempty"`
+		Auth     string `json:"auth,omitempty"`
+	} `json:"auths"`
+}
+
+// ociDigests caches the content hashes of the already fetched artifacts by
+// manifest digest, layer path and main module, so that an unchanged artifact
+// is only resolved and not fetched again.
+var ociDigests sync.Map
+
+// resolveOCI fetches the Worker content from the given OCI artifact, writes
+// it to the provider cache and returns the hex encoded SHA-256 hash of the
+// content uploaded by the Terraform provider. The layers of an artifact with
+// several layers are uploaded as a multi-module Worker with the given main
+// module, unless path selects one of them.
+func resolveOCI(ctx context.Context, kube client.Client, src *ociSource, mainModule string) (string, error) {
+	ref, err := registry.ParseReference(src.Reference)
+	if err != nil {
+		return "", errors.Wrap(err, errParseReference)
+	}
+	repo, err := remote.NewRepository(src.Reference)
+	if err != nil {
+		return "", errors.Wrap(err, errParseReference)
+	}
+	repo.PlainHTTP = src.PlainHTTP
+	c := &auth.Client{
+		Client: retry.DefaultClient,
+		Cache:  auth.NewCache(),
+	}
+	if src.PullSecretRef != nil {
+		cred, err := pullCredential(ctx, kube, src.PullSecretRef, ref.Registry)
+		if err != nil {
+			return "", err
+		}
+		c.Credential = auth.StaticCredential(ref.Registry, cred)
+	}
+	repo.Client = c
+
+	desc, err := repo.Resolve(ctx, ref.ReferenceOrDefault())
+	if err != nil {
+		return "", errors.Wrap(err, errResolveArtifact)
+	}
+	key := desc.Digest.String() + "/" + src.Path + "/" + mainModule
+	if sum, ok := ociDigests.Load(key); ok {
+		if _, err := os.Stat(workers.ContentPath(sum.(string))); err == nil {
+			return sum.(string), nil
+		}
+	}
+
+	b, err := content.FetchAll(ctx, repo, desc)
+	if err != nil {
+		return "", errors.Wrap(err, errFetchManifest)
+	}
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return "", errors.Wrap(err, errDecodeManifest)
+	}
+	layers, err := selectLayers(manifest.Layers, src.Path)
+	if err != nil {
+		return "", err
+	}
+	modules := make(map[string][]byte, len(layers))
+	for name, layer := range layers {
+		data, err := content.FetchAll(ctx, repo, layer)
+		if err != nil {
+			return "", errors.Wrap(err, errFetchLayer)
+		}
+		modules[name] = data
+	}
+	sum, err := writeModules(modules, mainModule)
+	if err != nil {
+		return "", err
+	}
+	ociDigests.Store(key, sum)
+	return sum, nil
+}
+
+// selectLayers returns the layer titled with the given path, or all layers
+// of the artifact if no path is given, keyed by their titles. The only layer
+// of an artifact may be untitled.
+func selectLayers(layers []ocispec.Descriptor, path string) (map[string]ocispec.Descriptor, error) {
+	if path != "" {
+		for _, l := range layers {
+			if l.Annotations[ocispec.AnnotationTitle] == path {
+				return map[string]ocispec.Descriptor{path: l}, nil
+			}
+		}
+		return nil, errors.Errorf(errNoLayer, path)
+	}
+	switch len(layers) {
+	case 0:
+		return nil, errors.New(errNoLayers)
+	case 1:
+		return map[string]ocispec.Descriptor{layers[0].Annotations[ocispec.AnnotationTitle]: layers[0]}, nil
+	}
+	selected := make(map[string]ocispec.Descriptor, len(layers))
+	for _, l := range layers {
+		title := l.Annotations[ocispec.AnnotationTitle]
+		if title == "" {
+			return nil, errors.Errorf(errUntitledLayer, l.Digest)
+		}
+		selected[title] = l
+	}
+	return selected, nil
+}
+
+// pullCredential returns the credential for the given registry from the
+// referenced dockerconfigjson Secret. An empty credential is returned if the
+// Secret has no entry for the registry.
+func pullCredential(ctx context.Context, kube client.Client, ref *pullSecretRef, host string) (auth.Credential, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return auth.EmptyCredential, errors.Wrap(err, errGetPullSecret)
+	}
+	cfg := dockerConfig{}
+	if err := json.Unmarshal(s.Data[corev1.DockerConfigJsonKey], &cfg); err != nil {
+		return auth.EmptyCredential, errors.Wrap(err, errDecodePullSecret)
+	}
+	for server, a := range cfg.Auths {
+		if registryHost(server) != host {
+			continue
+		}
+		if a.Username != "" || a.Password != "" {
+			return auth.Credential{Username: a.Username, Password: a.Password}, nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(a.Auth)
+		if err != nil {
+			return auth.EmptyCredential, errors.Wrap(err, errDecodePullSecret)
+		}
+		user, pass, _ := strings.Cut(string(decoded), ":")
+		return auth.Credential{Username: user, Password: pass}, nil
+	}
+	return auth.EmptyCredential, nil
+}
+
+// registryHost strips the scheme and path from a dockerconfigjson server
+// entry such as https://index.docker.io/v1/.
+func registryHost(server string) string {
+	server = strings.TrimPrefix(server, "https://")
+	server = strings.TrimPrefix(server, "http://")
+	host, _, _ := strings.Cut(server, "/")
+	return host
+}
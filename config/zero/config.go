@@ -0,0 +1,93 @@
+package zero
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+)
+
+const (
+	accessGroup            = "cloudflare_zero_trust_access_group"
+	accessIdentityProvider = "cloudflare_zero_trust_access_identity_provider"
+	accessPolicy           = "cloudflare_zero_trust_access_policy"
+	accessServiceToken     = "cloudflare_zero_trust_access_service_token"
+	trustList              = "cloudflare_zero_trust_list"
+)
+
+// identityProviderRules are the Access rule selectors which scope the match
+// to a single identity provider through an identity_provider_id argument.
+var identityProviderRules = []string{
+	"auth_context",
+	"azure_ad",
+	"github_organization",
+	"gsuite",
+	"oidc",
+	"okta",
+	"saml",
+}
+
+// Configure adds the cross resource references between the Zero Trust Access
+// applications, policies, groups, service tokens and identity providers.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("cloudflare_zero_trust_access_application", func(r *config.Resource) {
+		r.References["allowed_idps"] = config.Reference{
+			TerraformName: accessIdentityProvider,
+		}
+		r.References["policies.id"] = config.Reference{
+			TerraformName:     accessPolicy,
+			RefFieldName:      "PolicyRef",
+			SelectorFieldName: "PolicySelector",
+		}
+		for _, rule := range []string{"policies.include", "policies.exclude", "policies.require"} {
+			addAccessRuleReferences(r, rule)
+		}
+	})
+
+	p.AddResourceConfigurator("cloudflare_zero_trust_access_policy", func(r *config.Resource) {
+		for _, rule := range []string{"include", "exclude", "require"} {
+			addAccessRuleReferences(r, rule)
+		}
+		r.References["approval_groups.email_list_uuid"] = config.Reference{
+			TerraformName: trustList,
+		}
+	})
+
+	p.AddResourceConfigurator("cloudflare_zero_trust_access_group", func(r *config.Resource) {
+		for _, rule := range []string{"include", "exclude", "require"} {
+			addAccessRuleReferences(r, rule)
+		}
+	})
+}
+
+// addAccessRuleReferences configures the references of the Access rule
+// selectors found under the given include, exclude or require block.
+func addAccessRuleReferences(r *config.Resource, rule string) {
+	r.References[rule+".group.id"] = config.Reference{
+		TerraformName:     accessGroup,
+		RefFieldName:      "GroupRef",
+		SelectorFieldName: "GroupSelector",
+	}
+	r.References[rule+".service_token.token_id"] = config.Reference{
+		TerraformName:     accessServiceToken,
+		RefFieldName:      "ServiceTokenRef",
+		SelectorFieldName: "ServiceTokenSelector",
+	}
+	r.References[rule+".login_method.id"] = config.Reference{
+		TerraformName:     accessIdentityProvider,
+		RefFieldName:      "IdentityProviderRef",
+		SelectorFieldName: "IdentityProviderSelector",
+	}
+	r.References[rule+".email_list.id"] = config.Reference{
+		TerraformName:     trustList,
+		RefFieldName:      "ListRef",
+		SelectorFieldName: "ListSelector",
+	}
+	r.References[rule+".ip_list.id"] = config.Reference{
+		TerraformName:     trustList,
+		RefFieldName:      "ListRef",
+		SelectorFieldName: "ListSelector",
+	}
+	for _, idp := range identityProviderRules {
+		r.References[rule+"."+idp+".identity_provider_id"] = config.Reference{
+			TerraformName: accessIdentityProvider,
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package zone
+
+import (
+	"strconv"
+
+	"github.com/crossplane/upjet/v2/pkg/config"
+)
+
+// Connection detail keys of the DS record of a zone with DNSSEC.
+const (
+	KeyDS              = "ds"
+	KeyDigest          = "digest"
+	KeyDigestType      = "digest_type"
+	KeyDigestAlgorithm = "digest_algorithm"
+	KeyKeyTag          = "key_tag"
+	KeyAlgorithm       = "algorithm"
+	KeyFlags           = "flags"
+	KeyPublicKey       = "public_key"
+)
+
+// Configure publishes the DS record of zones with DNSSEC as connection
+// details, so that it can be handed to the registrar or the parent zone.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("cloudflare_zone_dnssec", func(r *config.Resource) {
+		r.Sensitive.AdditionalConnectionDetailsFn = func(attr map[string]any) (map[string][]byte, error) {
+			conn := map[string][]byte{}
+			for name, key := range map[string]string{
+				"ds":               KeyDS,
+				"digest":           KeyDigest,
+				"digest_type":      KeyDigestType,
+				"digest_algorithm": KeyDigestAlgorithm,
+				"key_tag":          KeyKeyTag,
+				"algorithm":        KeyAlgorithm,
+				"flags":            KeyFlags,
+				"public_key":       KeyPublicKey,
+			} {
+				switch v := attr[name].(type) {
+				case string:
+					if v != "" {
+						conn[key] = []byte(v)
+					}
+				case float64:
+					conn[key] = []byte(strconv.FormatFloat(v, 'f', -1, 64))
+				}
+			}
+			return conn, nil
+		}
+	})
+}
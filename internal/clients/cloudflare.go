@@ -3,7 +3,9 @@ package clients
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/upjet/v2/pkg/terraform"
 	"github.com/pkg/errors"
@@ -11,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"gitlab.com/jarvisai.run/provider-cloudflare/apis/v1beta1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/r2"
 )
 
 const (
@@ -20,6 +23,9 @@ const (
 	errExtractCredentials   = "cannot extract credentials"
 	errUnmarshalCredentials = "cannot unmarshal cloudflare credentials as JSON"
 	errNotManagedResource   = "resource is not a managed resource"
+	errNewAPIClient         = "cannot create Cloudflare client"
+	errNoAPICredentials     = "credentials must contain either api_token or api_key and email"
+	errNoR2APIToken         = "S3 credentials can only be derived from an api_token, reference a Secret written by an R2 Credentials resource instead"
 
 	keyAPIToken = "api_token"
 	keyEmail    = "email"
@@ -36,19 +42,9 @@ func TerraformSetupBuilder(version, providerSource, providerVersion string) terr
 			},
 		}
 
-		pcSpec, err := resolveProviderConfig(ctx, client, mg)
+		creds, err := ExtractCredentials(ctx, client, mg)
 		if err != nil {
-			return ps, errors.Wrap(err, "cannot resolve provider config")
-		}
-
-		data, err := resource.CommonCredentialExtractor(ctx, pcSpec.Credentials.Source, client, pcSpec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return ps, errors.Wrap(err, errExtractCredentials)
-		}
-
-		creds := map[string]string{}
-		if err := json.Unmarshal(data, &creds); err != nil {
-			return ps, errors.Wrap(err, errUnmarshalCredentials)
+			return ps, err
 		}
 
 		ps.Configuration = map[string]any{}
@@ -66,6 +62,71 @@ func TerraformSetupBuilder(version, providerSource, providerVersion string) terr
 	}
 }
 
+// ExtractCredentials returns the Cloudflare credentials of the ProviderConfig
+// referenced by the given managed resource, keyed by api_token, api_key and
+// email.
+func ExtractCredentials(ctx context.Context, client client.Client, mg resource.Managed) (map[string]string, error) {
+	pcSpec, err := resolveProviderConfig(ctx, client, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot resolve provider config")
+	}
+
+	data, err := resource.CommonCredentialExtractor(ctx, pcSpec.Credentials.Source, client, pcSpec.Credentials.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errExtractCredentials)
+	}
+
+	creds := map[string]string{}
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalCredentials)
+	}
+	return creds, nil
+}
+
+// NewAPI returns a Cloudflare API client authenticated with the given
+// credentials, preferring an API token over a global API key.
+func NewAPI(creds map[string]string) (*cloudflare.API, error) {
+	if v := creds[keyAPIToken]; v != "" {
+		api, err := cloudflare.NewWithAPIToken(v)
+		return api, errors.Wrap(err, errNewAPIClient)
+	}
+	if creds[keyAPIKey] != "" && creds[keyEmail] != "" {
+		api, err := cloudflare.New(creds[keyAPIKey], creds[keyEmail])
+		return api, errors.Wrap(err, errNewAPIClient)
+	}
+	return nil, errors.New(errNoAPICredentials)
+}
+
+// R2Credentials derives the S3 credentials of an R2 bucket at the given
+// endpoint from the API token of the ProviderConfig referenced by the given
+// managed resource.
+func R2Credentials(ctx context.Context, client client.Client, mg resource.Managed, endpoint string) (r2.Credentials, error) {
+	creds, err := ExtractCredentials(ctx, client, mg)
+	if err != nil {
+		return r2.Credentials{}, err
+	}
+	if creds[keyAPIToken] == "" {
+		return r2.Credentials{}, errors.New(errNoR2APIToken)
+	}
+	api, err := NewAPI(creds)
+	if err != nil {
+		return r2.Credentials{}, err
+	}
+	return r2.CredentialsFromAPIToken(ctx, api, creds[keyAPIToken], endpoint)
+}
+
+// SetAuthHeaders sets the authentication headers of the given credentials on
+// a request to the Cloudflare API that is not covered by the API client, such
+// as a GraphQL Analytics query.
+func SetAuthHeaders(h http.Header, creds map[string]string) {
+	if v := creds[keyAPIToken]; v != "" {
+		h.Set("Authorization", "Bearer "+v)
+		return
+	}
+	h.Set("X-Auth-Email", creds[keyEmail])
+	h.Set("X-Auth-Key", creds[keyAPIKey])
+}
+
 func resolveProviderConfig(ctx context.Context, crClient client.Client, mg resource.Managed) (*v1beta1.ProviderConfigSpec, error) {
 	switch managed := mg.(type) {
 	case resource.LegacyManaged:
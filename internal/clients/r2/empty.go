@@ -0,0 +1,110 @@
+package r2
+
+import (
+	"context"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+)
+
+const (
+	errListUploads  = "cannot list the incomplete multipart uploads"
+	errAbortUpload  = "cannot abort the multipart upload of %s"
+	errListObjects  = "cannot list the objects"
+	errDeleteObject = "cannot delete object %s"
+)
+
+// EmptyProgress reports the progress of emptying a bucket.
+type EmptyProgress struct {
+	// ObjectsDeleted is the number of deleted objects.
+	ObjectsDeleted int
+	// UploadsAborted is the number of aborted multipart uploads.
+	UploadsAborted int
+	// Empty is true when the bucket holds neither objects nor incomplete
+	// multipart uploads.
+	Empty bool
+}
+
+// EmptyBucket aborts the incomplete multipart uploads of the given bucket and
+// deletes its objects with batched DeleteObjects requests of up to 1000 keys.
+// It stops at the given deadline and reports whether the bucket is empty, so
+// that a large bucket can be emptied over several calls.
+func EmptyBucket(ctx context.Context, c *minio.Client, bucket string, deadline time.Time) (EmptyProgress, error) {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	p := EmptyProgress{}
+
+	for u := range c.ListIncompleteUploads(ctx, bucket, "", true) {
+		if u.Err != nil {
+			return p, timeout(ctx, errors.Wrap(u.Err, errListUploads))
+		}
+		if err := c.RemoveIncompleteUpload(ctx, bucket, u.Key); err != nil {
+			return p, timeout(ctx, errors.Wrapf(err, errAbortUpload, u.Key))
+		}
+		p.UploadsAborted++
+	}
+
+	// The objects are counted as they are handed to RemoveObjects, which
+	// reports only the failed deletions. The count is read once the listing
+	// goroutine is done.
+	var sent int
+	objects := make(chan minio.ObjectInfo)
+	listErr := make(chan error, 1)
+	listed := make(chan struct{})
+	go func() {
+		defer close(listed)
+		defer close(objects)
+		for o := range c.ListObjects(ctx, bucket, minio.ListObjectsOptions{Recursive: true}) {
+			if o.Err != nil {
+				listErr <- errors.Wrap(o.Err, errListObjects)
+				return
+			}
+			select {
+			case objects <- o:
+				sent++
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var failed int
+	var firstErr error
+	for e := range c.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		failed++
+		if firstErr == nil {
+			firstErr = errors.Wrapf(e.Err, errDeleteObject, e.ObjectName)
+		}
+	}
+	<-listed
+	p.ObjectsDeleted = sent - failed
+	select {
+	case err := <-listErr:
+		return p, timeout(ctx, err)
+	default:
+	}
+	if firstErr != nil {
+		return p, timeout(ctx, firstErr)
+	}
+	if ctx.Err() != nil {
+		return p, nil
+	}
+
+	for o := range c.ListObjects(ctx, bucket, minio.ListObjectsOptions{Recursive: true, MaxKeys: 1}) {
+		if o.Err != nil {
+			return p, timeout(ctx, errors.Wrap(o.Err, errListObjects))
+		}
+		return p, nil
+	}
+	p.Empty = ctx.Err() == nil
+	return p, nil
+}
+
+// timeout drops the given error when it was caused by reaching the deadline,
+// which only means that the bucket is not empty yet.
+func timeout(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,188 @@
+package r2
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is a local stand-in for R2 that serves the requests made to empty a
+// bucket: listing objects and multipart uploads, aborting uploads and batched
+// deletes.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]bool
+	uploads map[string]string
+	// failDelete are keys whose deletion is reported as failed.
+	failDelete map[string]bool
+}
+
+type listObjectsResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string
+	KeyCount              int
+	MaxKeys               int
+	IsTruncated           bool
+	NextContinuationToken string `xml:",omitempty"`
+	Contents              []struct{ Key string }
+}
+
+type listUploadsResult struct {
+	XMLName     xml.Name `xml:"ListMultipartUploadsResult"`
+	Bucket      string
+	IsTruncated bool
+	Upload      []struct {
+		Key      string
+		UploadID string `xml:"UploadId"`
+	}
+}
+
+type deleteRequest struct {
+	Object []struct{ Key string }
+}
+
+type deleteResult struct {
+	XMLName xml.Name `xml:"DeleteResult"`
+	Deleted []struct{ Key string }
+	Error   []struct{ Key, Code, Message string }
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet && q.Has("uploads"):
+		res := listUploadsResult{Bucket: bucket}
+		for _, k := range slices.Sorted(maps.Keys(f.uploads)) {
+			if strings.HasPrefix(k, q.Get("prefix")) {
+				res.Upload = append(res.Upload, struct {
+					Key      string
+					UploadID string `xml:"UploadId"`
+				}{Key: k, UploadID: f.uploads[k]})
+			}
+		}
+		writeXML(w, res)
+	case r.Method == http.MethodDelete && q.Has("uploadId"):
+		delete(f.uploads, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && q.Get("list-type") == "2":
+		maxKeys := 1000
+		if v := q.Get("max-keys"); v != "" {
+			maxKeys, _ = strconv.Atoi(v)
+		}
+		// The continuation token is the last listed key, so that listing
+		// is not disturbed by concurrent deletes.
+		after := q.Get("continuation-token")
+		res := listObjectsResult{Name: bucket, MaxKeys: maxKeys}
+		for _, k := range slices.Sorted(maps.Keys(f.objects)) {
+			if k <= after {
+				continue
+			}
+			if len(res.Contents) == maxKeys {
+				res.IsTruncated = true
+				res.NextContinuationToken = res.Contents[len(res.Contents)-1].Key
+				break
+			}
+			res.Contents = append(res.Contents, struct{ Key string }{k})
+		}
+		res.KeyCount = len(res.Contents)
+		writeXML(w, res)
+	case r.Method == http.MethodPost && q.Has("delete"):
+		req := deleteRequest{}
+		if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		res := deleteResult{}
+		for _, o := range req.Object {
+			if f.failDelete[o.Key] {
+				res.Error = append(res.Error, struct{ Key, Code, Message string }{o.Key, "AccessDenied", "Access Denied"})
+				continue
+			}
+			delete(f.objects, o.Key)
+			res.Deleted = append(res.Deleted, struct{ Key string }{o.Key})
+		}
+		writeXML(w, res)
+	default:
+		http.Error(w, fmt.Sprintf("unexpected request %s %s", r.Method, r.URL), http.StatusNotImplemented)
+	}
+}
+
+func writeXML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func TestEmptyBucket(t *testing.T) {
+	objects := func(n int) map[string]bool {
+		m := make(map[string]bool, n)
+		for i := range n {
+			m[fmt.Sprintf("object-%05d", i)] = true
+		}
+		return m
+	}
+
+	cases := map[string]struct {
+		s3       *fakeS3
+		deadline time.Duration
+		want     EmptyProgress
+		wantErr  bool
+		left     int
+	}{
+		"AlreadyEmpty": {
+			s3:       &fakeS3{objects: map[string]bool{}, uploads: map[string]string{}},
+			deadline: time.Minute,
+			want:     EmptyProgress{Empty: true},
+		},
+		"ObjectsAndUploads": {
+			s3:       &fakeS3{objects: objects(2500), uploads: map[string]string{"a": "1", "b": "2"}},
+			deadline: time.Minute,
+			want:     EmptyProgress{ObjectsDeleted: 2500, UploadsAborted: 2, Empty: true},
+		},
+		"DeleteFailed": {
+			s3:       &fakeS3{objects: objects(10), uploads: map[string]string{}, failDelete: map[string]bool{"object-00003": true}},
+			deadline: time.Minute,
+			want:     EmptyProgress{ObjectsDeleted: 9},
+			wantErr:  true,
+			left:     1,
+		},
+		"DeadlineReached": {
+			s3:       &fakeS3{objects: objects(10), uploads: map[string]string{}},
+			deadline: -time.Second,
+			want:     EmptyProgress{},
+			left:     10,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(tc.s3)
+			defer srv.Close()
+			c, err := NewClient(Credentials{AccessKeyID: "id", SecretAccessKey: "secret", Endpoint: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := EmptyBucket(context.Background(), c, "bucket", time.Now().Add(tc.deadline))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("EmptyBucket(...): error %v, want error %t", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("EmptyBucket(...): got %+v, want %+v", got, tc.want)
+			}
+			if left := len(tc.s3.objects); left != tc.left {
+				t.Errorf("EmptyBucket(...): %d objects left, want %d", left, tc.left)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Package r2 accesses R2 buckets through their S3 compatible API.
+package r2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/minio/minio-go/v7"
+	miniocreds "github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errGetSecret        = "cannot get the Secret with the R2 credentials"
+	errMissingSecretKey = "key %q not found in Secret %s/%s"
+	errVerifyAPIToken   = "cannot look up the identifier of the API token"
+	errNewClient        = "cannot create the S3 client"
+
+	// KeyAccessKeyID, KeySecretAccessKey and KeyEndpoint are the keys of the
+	// connection secret written by an R2 Credentials resource.
+	KeyAccessKeyID     = "access_key_id"
+	KeySecretAccessKey = "secret_access_key"
+	KeyEndpoint        = "endpoint"
+)
+
+// Credentials are the S3 credentials of an R2 bucket.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// Endpoint is the URL of the S3 API, e.g.
+	// https://<account>.r2.cloudflarestorage.com. It may point at any S3
+	// compatible server, such as a local stand-in for R2.
+	Endpoint string
+	// Region of the bucket, auto for R2 if empty.
+	Region string
+}
+
+// SecretReference references a Secret by name and namespace.
+type SecretReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// Endpoint returns the S3 endpoint of the given account and bucket
+// jurisdiction, e.g. eu or fedramp.
+func Endpoint(accountID, jurisdiction string) string {
+	if jurisdiction == "" || jurisdiction == "default" {
+		return "https://" + accountID + ".r2.cloudflarestorage.com"
+	}
+	return "https://" + accountID + "." + jurisdiction + ".r2.cloudflarestorage.com"
+}
+
+// CredentialsFromSecret reads the access_key_id, secret_access_key and
+// optional endpoint keys of the given Secret, as written by an R2
+// Credentials resource. The given endpoint is used when the Secret has none.
+func CredentialsFromSecret(ctx context.Context, kube client.Client, ref SecretReference, endpoint string) (Credentials, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return Credentials{}, errors.Wrap(err, errGetSecret)
+	}
+	c := Credentials{Endpoint: endpoint}
+	for k, v := range map[string]*string{KeyAccessKeyID: &c.AccessKeyID, KeySecretAccessKey: &c.SecretAccessKey} {
+		b, ok := s.Data[k]
+		if !ok {
+			return Credentials{}, errors.Errorf(errMissingSecretKey, k, ref.Namespace, ref.Name)
+		}
+		*v = string(b)
+	}
+	if e := string(s.Data[KeyEndpoint]); e != "" {
+		c.Endpoint = e
+	}
+	if !strings.Contains(c.Endpoint, "://") {
+		c.Endpoint = "https://" + c.Endpoint
+	}
+	return c, nil
+}
+
+// CredentialsFromAPIToken derives S3 credentials from the given API token,
+// which the given client authenticates with. As documented for R2, the
+// access key ID is the identifier of the token and the secret access key is
+// the SHA-256 hash of its value.
+func CredentialsFromAPIToken(ctx context.Context, api *cloudflare.API, token, endpoint string) (Credentials, error) {
+	v, err := api.VerifyAPIToken(ctx)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, errVerifyAPIToken)
+	}
+	h := sha256.Sum256([]byte(token))
+	return Credentials{
+		AccessKeyID:     v.ID,
+		SecretAccessKey: hex.EncodeToString(h[:]),
+		Endpoint:        endpoint,
+	}, nil
+}
+
+// NewClient returns an S3 client for the given credentials.
+func NewClient(c Credentials) (*minio.Client, error) {
+	secure := !strings.HasPrefix(c.Endpoint, "http://")
+	host := strings.TrimPrefix(strings.TrimPrefix(c.Endpoint, "https://"), "http://")
+	region := c.Region
+	if region == "" {
+		region = "auto"
+	}
+	mc, err := minio.New(strings.TrimSuffix(host, "/"), &minio.Options{
+		Creds:        miniocreds.NewStaticV4(c.AccessKeyID, c.SecretAccessKey, ""),
+		Secure:       secure,
+		Region:       region,
+		BucketLookup: minio.BucketLookupPath,
+	})
+	return mc, errors.Wrap(err, errNewClient)
+}
@@ -0,0 +1,140 @@
+// Package records manages the dns.Records a managed resource creates in zones
+// managed by the provider, such as the records delegating a zone or
+// verifying a custom hostname.
+package records
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dnsv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/dns/v1alpha1"
+)
+
+const (
+	errListRecords  = "cannot list the records of %s"
+	errApplyRecord  = "cannot apply record %s"
+	errNotOwned     = "record %s exists and is not controlled by %s"
+	errDeleteRecord = "cannot delete record %s"
+)
+
+// Records manages the records of the managed resources of a kind. The
+// records are controlled by their managed resource and labelled with its
+// name.
+type Records struct {
+	kube  client.Client
+	label string
+}
+
+// New returns Records listing the records of a managed resource by the
+// given label.
+func New(kube client.Client, label string) *Records {
+	return &Records{kube: kube, label: label}
+}
+
+// Owned lists the records of the given managed resource.
+func (r *Records) Owned(ctx context.Context, owner resource.LegacyManaged) ([]dnsv1alpha1.Record, error) {
+	l := &dnsv1alpha1.RecordList{}
+	if err := r.kube.List(ctx, l, client.MatchingLabels{r.label: owner.GetName()}); err != nil {
+		return nil, errors.Wrapf(err, errListRecords, owner.GetName())
+	}
+	var out []dnsv1alpha1.Record
+	for _, rec := range l.Items {
+		if metav1.IsControlledBy(&rec, owner) {
+			out = append(out, rec)
+		}
+	}
+	return out, nil
+}
+
+// Apply creates or updates the desired records, keyed by the names of their
+// resources, and deletes the other records of the given managed resource.
+// The records are labelled with the given labels as well. New records are
+// created before old ones are deleted, so that replacing a record never
+// leaves the zone without one.
+func (r *Records) Apply(ctx context.Context, owner resource.LegacyManaged, desired map[string]dnsv1alpha1.RecordParameters, labels map[string]string) error {
+	for name, p := range desired {
+		rec := &dnsv1alpha1.Record{}
+		rec.SetName(name)
+		_, err := controllerutil.CreateOrUpdate(ctx, r.kube, rec, func() error {
+			if rec.GetUID() != "" && !metav1.IsControlledBy(rec, owner) {
+				return errors.Errorf(errNotOwned, name, owner.GetName())
+			}
+			meta.AddLabels(rec, labels)
+			meta.AddLabels(rec, map[string]string{r.label: owner.GetName()})
+			rec.Spec.ForProvider = p
+			rec.Spec.ProviderConfigReference = owner.GetProviderConfigReference()
+			rec.Spec.ManagementPolicies = owner.GetManagementPolicies()
+			rec.Spec.DeletionPolicy = owner.GetDeletionPolicy()
+			return controllerutil.SetControllerReference(owner, rec, r.kube.Scheme())
+		})
+		if err != nil {
+			return errors.Wrapf(err, errApplyRecord, name)
+		}
+	}
+	existing, err := r.Owned(ctx, owner)
+	if err != nil {
+		return err
+	}
+	for i := range existing {
+		rec := &existing[i]
+		if _, ok := desired[rec.GetName()]; ok {
+			continue
+		}
+		if err := r.kube.Delete(ctx, rec); client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, errDeleteRecord, rec.GetName())
+		}
+	}
+	return nil
+}
+
+// UpToDate returns whether the existing records are the desired ones.
+func UpToDate(existing []dnsv1alpha1.Record, desired map[string]dnsv1alpha1.RecordParameters) bool {
+	if len(existing) != len(desired) {
+		return false
+	}
+	for _, rec := range existing {
+		d, ok := desired[rec.GetName()]
+		if !ok || !Equal(rec.Spec.ForProvider, d) {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the name of the resource of a record of the given type
+// created for the named managed resource, unique by the given key.
+func Name(owner, typ, key string) string {
+	h := sha256.Sum256([]byte(key))
+	return owner + "-" + strings.ToLower(typ) + "-" + hex.EncodeToString(h[:4])
+}
+
+// Equal returns whether both records have the same zone, name, type, content
+// and TTL. The digest of a DS record is compared regardless of case.
+func Equal(a, b dnsv1alpha1.RecordParameters) bool {
+	var ad, bd dnsv1alpha1.DataParameters
+	if a.Data != nil {
+		ad = *a.Data
+	}
+	if b.Data != nil {
+		bd = *b.Data
+	}
+	return ptr.Deref(a.ZoneID, "") == ptr.Deref(b.ZoneID, "") &&
+		ptr.Deref(a.Name, "") == ptr.Deref(b.Name, "") &&
+		ptr.Deref(a.Type, "") == ptr.Deref(b.Type, "") &&
+		ptr.Deref(a.Content, "") == ptr.Deref(b.Content, "") &&
+		ptr.Deref(a.TTL, 0) == ptr.Deref(b.TTL, 0) &&
+		ptr.Deref(ad.KeyTag, 0) == ptr.Deref(bd.KeyTag, 0) &&
+		ptr.Deref(ad.Algorithm, 0) == ptr.Deref(bd.Algorithm, 0) &&
+		ptr.Deref(ad.DigestType, 0) == ptr.Deref(bd.DigestType, 0) &&
+		strings.EqualFold(ptr.Deref(ad.Digest, ""), ptr.Deref(bd.Digest, ""))
+}
@@ -0,0 +1,146 @@
+// Package rulesets reads and writes the phase entrypoint rulesets of a zone.
+// Rules are handled as JSON objects rather than typed structs so that the
+// fields of rules that are not managed by the provider are written back as
+// they were read.
+package rulesets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	errGetEntrypoint    = "cannot get the %s entrypoint ruleset"
+	errUpdateEntrypoint = "cannot update the %s entrypoint ruleset"
+	errDecodeRuleset    = "cannot decode the %s entrypoint ruleset"
+)
+
+// Rule is a rule of a ruleset as returned by the API.
+type Rule map[string]any
+
+// Ref returns the ref of the rule.
+func (r Rule) Ref() string {
+	s, _ := r["ref"].(string)
+	return s
+}
+
+// ID returns the identifier of the rule.
+func (r Rule) ID() string {
+	s, _ := r["id"].(string)
+	return s
+}
+
+// Entrypoint is the entrypoint ruleset of a phase of a zone.
+type Entrypoint struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+	Rules       []Rule `json:"rules"`
+}
+
+// locks serializes the read-modify-write cycles of an entrypoint within the
+// provider, so that rules written concurrently by different resources are
+// not lost.
+var locks sync.Map
+
+// Lock locks the entrypoint of the given phase of the given zone and returns
+// the function unlocking it.
+func Lock(zoneID, phase string) func() {
+	m, _ := locks.LoadOrStore(zoneID+"/"+phase, &sync.Mutex{})
+	mu := m.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+func endpoint(zoneID, phase string) string {
+	return "/zones/" + zoneID + "/rulesets/phases/" + phase + "/entrypoint"
+}
+
+// Get returns the entrypoint of the given phase of the given zone, or nil if
+// the zone has none.
+func Get(ctx context.Context, api *cloudflare.API, zoneID, phase string) (*Entrypoint, error) {
+	res, err := api.Raw(ctx, http.MethodGet, endpoint(zoneID, phase), nil, nil)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetEntrypoint, phase)
+	}
+	ep := &Entrypoint{}
+	return ep, errors.Wrapf(json.Unmarshal(res.Result, ep), errDecodeRuleset, phase)
+}
+
+// Put replaces the rules of the entrypoint of the given phase of the given
+// zone, creating the entrypoint if needed, and returns the updated
+// entrypoint. Rules keep their identity through their id.
+func Put(ctx context.Context, api *cloudflare.API, zoneID, phase string, rules []Rule) (*Entrypoint, error) {
+	body := struct {
+		Rules []Rule `json:"rules"`
+	}{Rules: make([]Rule, 0, len(rules))}
+	for _, r := range rules {
+		w := Rule{}
+		for k, v := range r {
+			switch k {
+			case "version", "last_updated":
+			default:
+				w[k] = v
+			}
+		}
+		body.Rules = append(body.Rules, w)
+	}
+	res, err := api.Raw(ctx, http.MethodPut, endpoint(zoneID, phase), body, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, errUpdateEntrypoint, phase)
+	}
+	ep := &Entrypoint{}
+	return ep, errors.Wrapf(json.Unmarshal(res.Result, ep), errDecodeRuleset, phase)
+}
+
+// IsNotFound reports whether the given error is an HTTP 404 of the API.
+func IsNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
+
+// Contains reports whether the rule returned by the API holds every field
+// of the desired rule, ignoring the fields the API adds, such as defaults.
+func Contains(actual, desired any) bool {
+	switch d := desired.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			if r, isRule := actual.(Rule); isRule {
+				a, ok = map[string]any(r), true
+			}
+		}
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if !Contains(a[k], v) {
+				return false
+			}
+		}
+		return true
+	case Rule:
+		return Contains(actual, map[string]any(d))
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !Contains(a[i], d[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	ab, _ := json.Marshal(actual)
+	db, _ := json.Marshal(desired)
+	return string(ab) == string(db)
+}
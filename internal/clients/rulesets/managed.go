@@ -0,0 +1,60 @@
+package rulesets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	errListRulesets  = "cannot list the rulesets of the zone"
+	errGetRuleset    = "cannot get ruleset %s"
+	errDecodeManaged = "cannot decode ruleset %s"
+)
+
+// KindManaged is the kind of the rulesets managed by Cloudflare.
+const KindManaged = "managed"
+
+// ManagedRule is a rule of a managed ruleset.
+type ManagedRule struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Action      string   `json:"action"`
+	Categories  []string `json:"categories"`
+	Enabled     bool     `json:"enabled"`
+}
+
+// Ruleset is a ruleset of a zone as listed by the API. Rules are only set
+// when the ruleset is fetched by id.
+type Ruleset struct {
+	ID      string        `json:"id"`
+	Name    string        `json:"name"`
+	Kind    string        `json:"kind"`
+	Phase   string        `json:"phase"`
+	Version string        `json:"version"`
+	Rules   []ManagedRule `json:"rules,omitempty"`
+}
+
+// List returns the rulesets available to the given zone, including the
+// managed rulesets the zone is entitled to.
+func List(ctx context.Context, api *cloudflare.API, zoneID string) ([]Ruleset, error) {
+	res, err := api.Raw(ctx, http.MethodGet, "/zones/"+zoneID+"/rulesets", nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errListRulesets)
+	}
+	var rs []Ruleset
+	return rs, errors.Wrap(json.Unmarshal(res.Result, &rs), errListRulesets)
+}
+
+// GetRuleset returns the ruleset with the given id, including its rules.
+func GetRuleset(ctx context.Context, api *cloudflare.API, zoneID, id string) (*Ruleset, error) {
+	res, err := api.Raw(ctx, http.MethodGet, "/zones/"+zoneID+"/rulesets/"+id, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetRuleset, id)
+	}
+	rs := &Ruleset{}
+	return rs, errors.Wrapf(json.Unmarshal(res.Result, rs), errDecodeManaged, id)
+}
@@ -0,0 +1,214 @@
+// Package secretstore publishes the connection details of managed resources
+// to an External Secret Store plugin, such as a Vault plugin, instead of
+// Secrets of the API server.
+package secretstore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/v1alpha1"
+)
+
+const (
+	errGetStoreConfig = "cannot get StoreConfig %s"
+	errNoPlugin       = "StoreConfig %s is of type Plugin but configures no plugin"
+	errGetOwner       = "cannot get %s %s owning connection secret %s"
+)
+
+// DefaultStoreConfig is the name of the StoreConfig used by default.
+const DefaultStoreConfig = "default"
+
+// AnnotationStoreConfig is set on a managed resource to the name of the
+// StoreConfig its connection secret is stored with, instead of the default.
+const AnnotationStoreConfig = "cloudflare.crossplane.io/store-config"
+
+// The metadata keys of a secret written to a plugin.
+const (
+	metadataOwnerAPIVersion = "owner.apiVersion"
+	metadataOwnerKind       = "owner.kind"
+	metadataOwnerName       = "owner.name"
+	metadataType            = "type"
+)
+
+// NewManager returns a manager whose client stores the connection secrets
+// of the managed resources as NewClient does. It is passed to the set up
+// functions of the managed resource controllers, so that the client of the
+// manager itself, used e.g. by webhooks, keeps writing Secrets.
+func NewManager(mgr ctrl.Manager, certsDir string) ctrl.Manager {
+	return &manager{Manager: mgr, client: NewClient(mgr.GetClient(), certsDir)}
+}
+
+type manager struct {
+	ctrl.Manager
+	client client.Client
+}
+
+func (m *manager) GetClient() client.Client {
+	return m.client
+}
+
+// NewClient returns a client that writes the connection secret of a
+// managed resource to the secret store of its StoreConfig if it is of type
+// Plugin, and to the API server otherwise. A managed resource uses the
+// StoreConfig named by its AnnotationStoreConfig, or the DefaultStoreConfig.
+// Its connection secret is deleted from the plugin once the update removing
+// its last finalizer succeeded. The plugins are connected to with the client
+// certificate and CA of certsDir, and not at all if it is empty.
+func NewClient(c client.Client, certsDir string) client.Client {
+	return &storeClient{Client: c, plugins: &plugins{certsDir: certsDir}}
+}
+
+type storeClient struct {
+	client.Client
+
+	plugins *plugins
+}
+
+func (c *storeClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if ok, err := c.publish(ctx, obj); ok || err != nil {
+		return err
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *storeClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if ok, err := c.publish(ctx, obj); ok || err != nil {
+		return err
+	}
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	return c.unpublish(ctx, obj)
+}
+
+func (c *storeClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if ok, err := c.publish(ctx, obj); ok || err != nil {
+		return err
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+// plugin returns the StoreConfig of the given managed resource if it is of
+// type Plugin, or nil if its secrets are stored in the API server.
+func (c *storeClient) plugin(ctx context.Context, mg resource.Managed) (*v1alpha1.StoreConfig, error) {
+	name := DefaultStoreConfig
+	if v := mg.GetAnnotations()[AnnotationStoreConfig]; v != "" {
+		name = v
+	}
+	sc := &v1alpha1.StoreConfig{}
+	err := c.Client.Get(ctx, types.NamespacedName{Name: name}, sc)
+	if kerrors.IsNotFound(err) && name == DefaultStoreConfig {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetStoreConfig, name)
+	}
+	if sc.Spec.Type == nil || *sc.Spec.Type != v1alpha1.SecretStorePlugin {
+		return nil, nil
+	}
+	if sc.Spec.Plugin == nil {
+		return nil, errors.Errorf(errNoPlugin, name)
+	}
+	return sc, nil
+}
+
+// owner returns the managed resource the given Secret is the connection
+// secret of, or nil if it is none.
+func (c *storeClient) owner(ctx context.Context, s *corev1.Secret) (resource.LegacyManaged, error) {
+	ref := metav1.GetControllerOf(s)
+	if ref == nil || !managedGroup(ref.APIVersion) {
+		return nil, nil
+	}
+	obj, err := c.Scheme().New(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	if err != nil {
+		return nil, nil //nolint:nilerr // Kinds of other providers own no connection secrets of this one.
+	}
+	mg, ok := obj.(resource.LegacyManaged)
+	if !ok {
+		return nil, nil
+	}
+	if err := c.Client.Get(ctx, types.NamespacedName{Name: ref.Name}, mg); err != nil {
+		return nil, errors.Wrapf(err, errGetOwner, ref.Kind, ref.Name, s.GetNamespace()+"/"+s.GetName())
+	}
+	w := mg.GetWriteConnectionSecretToReference()
+	if mg.GetUID() != ref.UID || w == nil || w.Namespace != s.GetNamespace() || w.Name != s.GetName() {
+		return nil, nil
+	}
+	return mg, nil
+}
+
+// publish writes the given object to the plugin if it is a connection
+// secret whose StoreConfig is of type Plugin, and returns whether it did.
+func (c *storeClient) publish(ctx context.Context, obj client.Object) (bool, error) {
+	s, ok := obj.(*corev1.Secret)
+	if !ok {
+		return false, nil
+	}
+	mg, err := c.owner(ctx, s)
+	if mg == nil || err != nil {
+		return false, err
+	}
+	sc, err := c.plugin(ctx, mg)
+	if sc == nil || err != nil {
+		return false, err
+	}
+	owner := metav1.GetControllerOf(s)
+	md := map[string]string{
+		metadataOwnerAPIVersion: owner.APIVersion,
+		metadataOwnerKind:       owner.Kind,
+		metadataOwnerName:       owner.Name,
+		metadataType:            string(s.Type),
+	}
+	for k, v := range s.GetLabels() {
+		md[k] = v
+	}
+	return true, c.plugins.apply(ctx, sc.Spec.Plugin, scopedName(sc, s.GetNamespace(), s.GetName()), md, s.Data)
+}
+
+// unpublish deletes the connection secret of the given object from the
+// plugin if it is a managed resource whose last finalizer was removed.
+func (c *storeClient) unpublish(ctx context.Context, obj client.Object) error {
+	mg, ok := obj.(resource.LegacyManaged)
+	if !ok || mg.GetDeletionTimestamp() == nil || len(mg.GetFinalizers()) > 0 {
+		return nil
+	}
+	ref := mg.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil
+	}
+	if gvk, err := c.GroupVersionKindFor(obj); err != nil || !managedGroup(gvk.GroupVersion().String()) {
+		return nil //nolint:nilerr // Objects of unknown kinds are not managed resources of this provider.
+	}
+	sc, err := c.plugin(ctx, mg)
+	if sc == nil || err != nil {
+		return err
+	}
+	return c.plugins.delete(ctx, sc.Spec.Plugin, scopedName(sc, ref.Namespace, ref.Name))
+}
+
+// managedGroup returns whether the given API version is of a group of the
+// managed resources of this provider.
+func managedGroup(apiVersion string) bool {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	return err == nil && strings.HasSuffix(gv.Group, "."+v1alpha1.Group)
+}
+
+// scopedName returns the name of a secret in the plugin, scoped by its
+// namespace or the default scope of the StoreConfig.
+func scopedName(sc *v1alpha1.StoreConfig, namespace, name string) string {
+	if namespace == "" {
+		namespace = sc.Spec.DefaultScope
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,328 @@
+package secretstore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"maps"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	essproto "github.com/crossplane/crossplane-runtime/v2/apis/proto/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/certificates"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/dns/v1alpha1"
+	storev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/v1alpha1"
+)
+
+// fakePlugin is a local stand-in for an External Secret Store plugin in front
+// of a Vault dev server, keeping its secrets in memory.
+type fakePlugin struct {
+	essproto.UnimplementedExternalSecretStorePluginServiceServer
+
+	mu      sync.Mutex
+	secrets map[string]map[string][]byte
+}
+
+func (p *fakePlugin) ApplySecret(_ context.Context, req *essproto.ApplySecretRequest) (*essproto.ApplySecretResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.secrets[req.GetSecret().GetScopedName()] = req.GetSecret().GetData()
+	return &essproto.ApplySecretResponse{Changed: true}, nil
+}
+
+func (p *fakePlugin) DeleteKeys(_ context.Context, req *essproto.DeleteKeysRequest) (*essproto.DeleteKeysResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.secrets, req.GetSecret().GetScopedName())
+	return &essproto.DeleteKeysResponse{}, nil
+}
+
+func (p *fakePlugin) get(name string) (map[string][]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	d, ok := p.secrets[name]
+	return d, ok
+}
+
+// writeCerts writes a CA and a certificate and key signed by it for the given
+// IP address to dir, under the names the plugins are connected to with.
+func writeCerts(t *testing.T, dir string, ip net.IP) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ess-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "ess"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{ip},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, cert, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, block := range map[string]*pem.Block{
+		fileCA:   {Type: "CERTIFICATE", Bytes: caDER},
+		fileCert: {Type: "CERTIFICATE", Bytes: certDER},
+		fileKey:  {Type: "EC PRIVATE KEY", Bytes: keyDER},
+	} {
+		if err := os.WriteFile(filepath.Join(dir, file), pem.EncodeToMemory(block), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// startPlugin serves a fakePlugin over mutual TLS with the certificates of
+// certsDir, and returns it with its endpoint.
+func startPlugin(t *testing.T, certsDir string) (*fakePlugin, string) {
+	t.Helper()
+	cfg, err := certificates.LoadMTLSConfig(filepath.Join(certsDir, fileCA), filepath.Join(certsDir, fileCert), filepath.Join(certsDir, fileKey), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(cfg)))
+	p := &fakePlugin{secrets: map[string]map[string][]byte{}}
+	essproto.RegisterExternalSecretStorePluginServiceServer(srv, p)
+	go srv.Serve(lis) //nolint:errcheck // Serve returns when the server is stopped.
+	t.Cleanup(srv.Stop)
+	return p, lis.Addr().String()
+}
+
+func pluginStoreConfig(name, endpoint string) *storev1alpha1.StoreConfig {
+	return &storev1alpha1.StoreConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: storev1alpha1.StoreConfigSpec{
+			Type:         ptr.To(storev1alpha1.SecretStorePlugin),
+			DefaultScope: "crossplane-system",
+			Plugin: &storev1alpha1.PluginStoreConfig{
+				Endpoint:  endpoint,
+				ConfigRef: storev1alpha1.PluginConfigReference{APIVersion: "secrets.crossplane.io/v1alpha1", Kind: "VaultConfig", Name: "vault-dev"},
+			},
+		},
+	}
+}
+
+func record(annotations map[string]string) *v1alpha1.Record {
+	return &v1alpha1.Record{
+		ObjectMeta: metav1.ObjectMeta{Name: "www", UID: types.UID("uid"), Annotations: annotations},
+		Spec: v1alpha1.RecordSpec{ResourceSpec: xpv1.ResourceSpec{
+			WriteConnectionSecretToReference: &xpv1.SecretReference{Namespace: "team", Name: "www-conn"},
+		}},
+	}
+}
+
+func secret(owner *v1alpha1.Record, name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team",
+			Name:      name,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+				Kind:       v1alpha1.Record_Kind,
+				Name:       owner.GetName(),
+				UID:        owner.GetUID(),
+				Controller: ptr.To(true),
+			}},
+		},
+		Data: map[string][]byte{"token": []byte("secret")},
+	}
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, v1alpha1.SchemeBuilder.AddToScheme, storev1alpha1.SchemeBuilder.AddToScheme} {
+		if err := add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestCreate(t *testing.T) {
+	certsDir := t.TempDir()
+	writeCerts(t, certsDir, net.ParseIP("127.0.0.1"))
+
+	cases := map[string]struct {
+		// storeConfigs are the names of the StoreConfigs of type Plugin.
+		storeConfigs []string
+		annotations  map[string]string
+		secret       string
+		certsDir     string
+		// published is whether the secret is written to the plugin rather
+		// than the API server.
+		published bool
+		err       string
+	}{
+		"DefaultPluginStore": {
+			storeConfigs: []string{DefaultStoreConfig},
+			secret:       "www-conn",
+			certsDir:     certsDir,
+			published:    true,
+		},
+		"AnnotatedPluginStore": {
+			storeConfigs: []string{"vault"},
+			annotations:  map[string]string{AnnotationStoreConfig: "vault"},
+			secret:       "www-conn",
+			certsDir:     certsDir,
+			published:    true,
+		},
+		"KubernetesStore": {
+			secret:   "www-conn",
+			certsDir: certsDir,
+		},
+		"AnnotatedStoreMissing": {
+			annotations: map[string]string{AnnotationStoreConfig: "vault"},
+			secret:      "www-conn",
+			certsDir:    certsDir,
+			err:         "cannot get StoreConfig vault",
+		},
+		"NotConnectionSecret": {
+			storeConfigs: []string{DefaultStoreConfig},
+			secret:       "www-other",
+			certsDir:     certsDir,
+		},
+		"NoTLS": {
+			storeConfigs: []string{DefaultStoreConfig},
+			secret:       "www-conn",
+			err:          "without TLS certificates",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plugin, endpoint := startPlugin(t, certsDir)
+			mg := record(tc.annotations)
+			objs := []client.Object{mg}
+			for _, sc := range tc.storeConfigs {
+				objs = append(objs, pluginStoreConfig(sc, endpoint))
+			}
+			kube := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(objs...).Build()
+			c := NewClient(kube, tc.certsDir)
+
+			err := c.Create(context.Background(), secret(mg, tc.secret))
+			switch {
+			case tc.err == "" && err != nil:
+				t.Fatalf("Create(...): unexpected error: %s", err)
+			case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+				t.Fatalf("Create(...): error %v does not contain %q", err, tc.err)
+			case tc.err != "":
+				return
+			}
+
+			data, published := plugin.get("team/" + tc.secret)
+			if published != tc.published {
+				t.Errorf("Create(...): published to the plugin %t, want %t", published, tc.published)
+			}
+			if published && !maps.EqualFunc(data, map[string][]byte{"token": []byte("secret")}, func(a, b []byte) bool { return string(a) == string(b) }) {
+				t.Errorf("Create(...): published data %q", data)
+			}
+			err = kube.Get(context.Background(), types.NamespacedName{Namespace: "team", Name: tc.secret}, &corev1.Secret{})
+			if stored := err == nil; stored == tc.published {
+				t.Errorf("Create(...): stored in the API server %t, want %t (%v)", stored, !tc.published, err)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	certsDir := t.TempDir()
+	writeCerts(t, certsDir, net.ParseIP("127.0.0.1"))
+
+	cases := map[string]struct {
+		// finalizers are the finalizers left by the update.
+		finalizers []string
+		// stale makes the update fail with a conflict.
+		stale bool
+		// unpublished is whether the secret is deleted from the plugin.
+		unpublished bool
+	}{
+		"LastFinalizerRemoved": {
+			unpublished: true,
+		},
+		"FinalizerLeft": {
+			finalizers: []string{"other"},
+		},
+		"UpdateFailed": {
+			stale: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plugin, endpoint := startPlugin(t, certsDir)
+			plugin.secrets["team/www-conn"] = map[string][]byte{"token": []byte("secret")}
+			mg := record(nil)
+			mg.SetFinalizers([]string{"finalizer.managedresource.crossplane.io", "other"})
+			kube := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(mg, pluginStoreConfig(DefaultStoreConfig, endpoint)).Build()
+			if err := kube.Delete(context.Background(), mg); err != nil {
+				t.Fatal(err)
+			}
+			if err := kube.Get(context.Background(), types.NamespacedName{Name: mg.GetName()}, mg); err != nil {
+				t.Fatal(err)
+			}
+			c := NewClient(kube, certsDir)
+
+			mg.SetFinalizers(tc.finalizers)
+			if tc.stale {
+				mg.SetResourceVersion("1")
+			}
+			err := c.Update(context.Background(), mg)
+			if tc.stale != kerrors.IsConflict(err) {
+				t.Fatalf("Update(...): error %v, want a conflict %t", err, tc.stale)
+			}
+			if _, published := plugin.get("team/www-conn"); published == tc.unpublished {
+				t.Errorf("Update(...): published to the plugin %t, want %t", published, !tc.unpublished)
+			}
+		})
+	}
+}
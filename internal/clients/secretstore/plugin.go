@@ -0,0 +1,101 @@
+package secretstore
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	essproto "github.com/crossplane/crossplane-runtime/v2/apis/proto/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/certificates"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/v1alpha1"
+)
+
+const (
+	errNoTLS      = "cannot connect to secret store plugin %s without TLS certificates, set --ess-tls-cert-dir"
+	errLoadTLS    = "cannot load the TLS certificates of the secret store plugin"
+	errDial       = "cannot connect to secret store plugin %s"
+	errApply      = "cannot apply secret %s to secret store plugin"
+	errDeleteKeys = "cannot delete secret %s from secret store plugin"
+)
+
+// The files of the TLS certificates a plugin is connected to with.
+const (
+	fileCA   = "ca.crt"
+	fileCert = "tls.crt"
+	fileKey  = "tls.key"
+)
+
+// plugins connects to External Secret Store plugins, keeping a connection
+// per endpoint.
+type plugins struct {
+	// certsDir holds the client certificate and CA of the plugins. The
+	// plugins are not connected to if it is empty, as connection details
+	// must not be sent in the clear.
+	certsDir string
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func (p *plugins) client(endpoint string) (essproto.ExternalSecretStorePluginServiceClient, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if c, ok := p.conns[endpoint]; ok {
+		return essproto.NewExternalSecretStorePluginServiceClient(c), nil
+	}
+
+	if p.certsDir == "" {
+		return nil, errors.Errorf(errNoTLS, endpoint)
+	}
+	cfg, err := certificates.LoadMTLSConfig(filepath.Join(p.certsDir, fileCA), filepath.Join(p.certsDir, fileCert), filepath.Join(p.certsDir, fileKey), false)
+	if err != nil {
+		return nil, errors.Wrap(err, errLoadTLS)
+	}
+	c, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
+	if err != nil {
+		return nil, errors.Wrapf(err, errDial, endpoint)
+	}
+	if p.conns == nil {
+		p.conns = map[string]*grpc.ClientConn{}
+	}
+	p.conns[endpoint] = c
+	return essproto.NewExternalSecretStorePluginServiceClient(c), nil
+}
+
+// apply writes the given data as a secret of the given scoped name.
+func (p *plugins) apply(ctx context.Context, cfg *v1alpha1.PluginStoreConfig, name string, metadata map[string]string, data map[string][]byte) error {
+	c, err := p.client(cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+	_, err = c.ApplySecret(ctx, &essproto.ApplySecretRequest{
+		Config: configReference(cfg),
+		Secret: &essproto.Secret{ScopedName: name, Metadata: metadata, Data: data},
+	})
+	return errors.Wrapf(err, errApply, name)
+}
+
+// delete deletes the secret of the given scoped name.
+func (p *plugins) delete(ctx context.Context, cfg *v1alpha1.PluginStoreConfig, name string) error {
+	c, err := p.client(cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+	_, err = c.DeleteKeys(ctx, &essproto.DeleteKeysRequest{
+		Config: configReference(cfg),
+		Secret: &essproto.Secret{ScopedName: name},
+	})
+	return errors.Wrapf(err, errDeleteKeys, name)
+}
+
+func configReference(cfg *v1alpha1.PluginStoreConfig) *essproto.ConfigReference {
+	return &essproto.ConfigReference{
+		ApiVersion: cfg.ConfigRef.APIVersion,
+		Kind:       cfg.ConfigRef.Kind,
+		Name:       cfg.ConfigRef.Name,
+	}
+}
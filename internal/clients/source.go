@@ -0,0 +1,50 @@
+package clients
+
+import (
+	"context"
+	"maps"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errSource       = "every source must set exactly one of configMapRef or secretRef"
+	errGetConfigMap = "cannot get ConfigMap %s/%s"
+	errGetSecret    = "cannot get Secret %s/%s"
+)
+
+// SourceReference references the ConfigMap or Secret of a source by name and
+// namespace.
+type SourceReference struct {
+	Name      string
+	Namespace string
+}
+
+// SourceData returns the data of the ConfigMap or the Secret of a source,
+// exactly one of which must be referenced. The data of a ConfigMap include
+// its binary data.
+func SourceData(ctx context.Context, kube client.Client, configMap, secret *SourceReference) (map[string][]byte, error) {
+	switch {
+	case configMap != nil && secret == nil:
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}, cm); err != nil {
+			return nil, errors.Wrapf(err, errGetConfigMap, configMap.Namespace, configMap.Name)
+		}
+		data := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+		for k, v := range cm.Data {
+			data[k] = []byte(v)
+		}
+		maps.Copy(data, cm.BinaryData)
+		return data, nil
+	case secret != nil && configMap == nil:
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, s); err != nil {
+			return nil, errors.Wrapf(err, errGetSecret, secret.Namespace, secret.Name)
+		}
+		return s.Data, nil
+	}
+	return nil, errors.New(errSource)
+}
@@ -0,0 +1,64 @@
+package clients
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSourceData(t *testing.T) {
+	kube := fake.NewClientBuilder().WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "site", Namespace: "web"},
+			Data:       map[string]string{"index.html": "<html></html>"},
+			BinaryData: map[string][]byte{"favicon.ico": {0x00, 0x01}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "keys", Namespace: "web"},
+			Data:       map[string][]byte{"token": []byte("secret")},
+		},
+	).Build()
+
+	cases := map[string]struct {
+		configMap *SourceReference
+		secret    *SourceReference
+		want      map[string][]byte
+		wantErr   bool
+	}{
+		"ConfigMap": {
+			configMap: &SourceReference{Name: "site", Namespace: "web"},
+			want:      map[string][]byte{"index.html": []byte("<html></html>"), "favicon.ico": {0x00, 0x01}},
+		},
+		"Secret": {
+			secret: &SourceReference{Name: "keys", Namespace: "web"},
+			want:   map[string][]byte{"token": []byte("secret")},
+		},
+		"Missing": {
+			secret:  &SourceReference{Name: "missing", Namespace: "web"},
+			wantErr: true,
+		},
+		"Both": {
+			configMap: &SourceReference{Name: "site", Namespace: "web"},
+			secret:    &SourceReference{Name: "keys", Namespace: "web"},
+			wantErr:   true,
+		},
+		"Neither": {
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SourceData(context.Background(), kube, tc.configMap, tc.secret)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SourceData(...): error %v, want error %t", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("SourceData(...): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package workers caches the content of Worker scripts sourced from
+// ConfigMaps, Secrets or OCI artifacts for the Terraform provider, and adds
+// the further modules of multi-module Workers to the scripts it uploads.
+package workers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errWriteContent   = "cannot write the Worker content to the provider cache"
+	errWriteModules   = "cannot write the Worker modules to the provider cache"
+	errReadModules    = "cannot read the Worker modules from the provider cache"
+	errDecodeModules  = "cannot decode the Worker modules"
+	errMissingContent = "the content of Worker module %s is not in the provider cache"
+)
+
+// ContentDir is where the resolved Worker content is written, keyed by its
+// SHA-256 hash, so that the Terraform provider can read it through
+// content_file.
+var ContentDir = filepath.Join(os.TempDir(), "provider-cloudflare", "workers-content")
+
+// Module is a module of a multi-module Worker uploaded alongside its main
+// module.
+type Module struct {
+	// Name of the module, as imported by the other modules.
+	Name string `json:"name"`
+	// ContentType of the module, which tells its type, e.g.
+	// application/javascript+module or application/wasm.
+	ContentType string `json:"contentType"`
+	// SHA256 is the hex encoded SHA-256 hash of the cached content.
+	SHA256 string `json:"sha256"`
+}
+
+// WriteContent writes the given content to the provider cache unless it is
+// already there and returns its hex encoded SHA-256 hash.
+func WriteContent(content []byte) (string, error) {
+	h := sha256.Sum256(content)
+	sum := hex.EncodeToString(h[:])
+	p := ContentPath(sum)
+	if _, err := os.Stat(p); err == nil {
+		return sum, nil
+	}
+	return sum, errors.Wrap(writeFile(p, content), errWriteContent)
+}
+
+// ContentPath returns the path of the cached content with the given hash.
+func ContentPath(sum string) string {
+	return filepath.Join(ContentDir, sum)
+}
+
+// WriteModules records the further modules of the Worker whose main module
+// has the given hash. Their content must have been written with
+// WriteContent.
+func WriteModules(main string, modules []Module) error {
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Name < modules[j].Name })
+	b, err := json.Marshal(modules)
+	if err != nil {
+		return errors.Wrap(err, errWriteModules)
+	}
+	return errors.Wrap(writeFile(modulesPath(main), b), errWriteModules)
+}
+
+// ReadModules returns the further modules of the Worker whose main module
+// has the given hash, or none if it is a single module Worker.
+func ReadModules(main string) ([]Module, error) {
+	b, err := os.ReadFile(modulesPath(main))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errReadModules)
+	}
+	var modules []Module
+	return modules, errors.Wrap(json.Unmarshal(b, &modules), errDecodeModules)
+}
+
+// ModulesSHA256 returns the hex encoded SHA-256 hash of the names, types and
+// content of the given modules.
+func ModulesSHA256(modules []Module) string {
+	sorted := append([]Module(nil), modules...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	h := sha256.New()
+	for _, m := range sorted {
+		_, _ = h.Write([]byte(m.Name + "\x00" + m.ContentType + "\x00" + m.SHA256 + "\x00"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func modulesPath(main string) string {
+	return ContentPath(main) + ".modules.json"
+}
+
+// writeFile atomically writes the given content to the given path in the
+// provider cache.
+func writeFile(p string, content []byte) error {
+	if err := os.MkdirAll(ContentDir, 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(ContentDir, filepath.Base(p)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck // the file is renamed on success
+	if _, err := f.Write(content); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
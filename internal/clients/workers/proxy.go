@@ -0,0 +1,245 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/textproto"
+	"net/url"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/upjet/v2/pkg/terraform"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errListen       = "cannot listen for the Terraform provider"
+	errReadUpload   = "cannot read the Worker script upload"
+	errDecodeUpload = "cannot decode the metadata of the Worker script upload"
+	errAddModules   = "cannot add the modules to the Worker script upload"
+)
+
+// DefaultUpstream is the Cloudflare API the Proxy forwards requests to.
+const DefaultUpstream = "https://api.cloudflare.com"
+
+// keyBaseURL is the argument of the Terraform provider overriding the URL of
+// the Cloudflare API.
+const keyBaseURL = "base_url"
+
+// scriptUpload matches the path of a Worker script upload.
+var scriptUpload = regexp.MustCompile(`/accounts/[^/]+/workers/scripts/[^/]+$`)
+
+// Proxy forwards the requests of the Terraform provider to the Cloudflare API
+// and adds the further modules of multi-module Workers to the Worker scripts
+// it uploads. The Terraform provider uploads the content_file of a Worker
+// script as its only module, so the other modules of a contentFrom source are
+// looked up in the provider cache by the hash of that main module.
+type Proxy struct {
+	listener net.Listener
+	proxy    *httputil.ReverseProxy
+}
+
+// NewProxy returns a Proxy forwarding requests to the given upstream,
+// listening on a local port.
+func NewProxy(upstream *url.URL) (*Proxy, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errors.Wrap(err, errListen)
+	}
+	return &Proxy{
+		listener: l,
+		proxy: &httputil.ReverseProxy{Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(upstream)
+			r.Out.Host = upstream.Host
+		}},
+	}, nil
+}
+
+// URL returns the base URL of the Cloudflare API served by the Proxy.
+func (p *Proxy) URL() string {
+	return "http://" + p.listener.Addr().String() + "/client/v4/"
+}
+
+// Start serves the Proxy until the given context is done.
+func (p *Proxy) Start(ctx context.Context) error {
+	srv := &http.Server{Handler: p, ReadHeaderTimeout: 30 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.Serve(p.listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false, as the Terraform provider of every
+// replica may upload Worker scripts.
+func (p *Proxy) NeedLeaderElection() bool {
+	return false
+}
+
+// ServeHTTP forwards the given request, adding the further modules of the
+// uploaded Worker script if it is a multi-module Worker.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPut && scriptUpload.MatchString(r.URL.Path) {
+		if err := addModules(r); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	p.proxy.ServeHTTP(w, r)
+}
+
+// part is a part of a multipart upload.
+type part struct {
+	header   textproto.MIMEHeader
+	name     string
+	filename string
+	content  []byte
+}
+
+// addModules adds the modules recorded for the main module of the given
+// Worker script upload to it.
+func addModules(r *http.Request) error {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, errReadUpload)
+	}
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var parts []part
+	mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		p, err := mr.NextRawPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, errReadUpload)
+		}
+		content, err := io.ReadAll(p)
+		if err != nil {
+			return errors.Wrap(err, errReadUpload)
+		}
+		parts = append(parts, part{header: p.Header, name: p.FormName(), filename: p.FileName(), content: content})
+	}
+	main := ""
+	for _, p := range parts {
+		if p.name != "metadata" {
+			continue
+		}
+		metadata := struct {
+			MainModule string `json:"main_module"`
+		}{}
+		if err := json.Unmarshal(p.content, &metadata); err != nil {
+			return errors.Wrap(err, errDecodeUpload)
+		}
+		main = metadata.MainModule
+	}
+	if main == "" {
+		return nil
+	}
+	var modules []Module
+	present := map[string]bool{}
+	for _, p := range parts {
+		present[p.name] = true
+		if p.name != main && p.filename != main {
+			continue
+		}
+		h := sha256.Sum256(p.content)
+		if modules, err = ReadModules(hex.EncodeToString(h[:])); err != nil {
+			return err
+		}
+	}
+	if len(modules) == 0 {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+	mw := multipart.NewWriter(buf)
+	for _, p := range parts {
+		if err := writePart(mw, p); err != nil {
+			return errors.Wrap(err, errAddModules)
+		}
+	}
+	for _, m := range modules {
+		if present[m.Name] {
+			continue
+		}
+		content, err := os.ReadFile(ContentPath(m.SHA256))
+		if err != nil {
+			return errors.Wrapf(err, errMissingContent, m.Name)
+		}
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, m.Name, m.Name))
+		h.Set("Content-Type", m.ContentType)
+		if err := writePart(mw, part{header: h, content: content}); err != nil {
+			return errors.Wrap(err, errAddModules)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return errors.Wrap(err, errAddModules)
+	}
+	r.Body = io.NopCloser(buf)
+	r.ContentLength = int64(buf.Len())
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	return nil
+}
+
+func writePart(mw *multipart.Writer, p part) error {
+	w, err := mw.CreatePart(p.header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(p.content)
+	return err
+}
+
+// WithProxy returns a SetupFn pointing the Terraform provider of the Worker
+// scripts sourcing their content through contentFrom at the given Proxy.
+func WithProxy(fn terraform.SetupFn, p *Proxy) terraform.SetupFn {
+	return func(ctx context.Context, kube client.Client, mg resource.Managed) (terraform.Setup, error) {
+		ps, err := fn(ctx, kube, mg)
+		if err != nil || !hasContentFrom(mg) {
+			return ps, err
+		}
+		if ps.Configuration == nil {
+			ps.Configuration = map[string]any{}
+		}
+		ps.Configuration[keyBaseURL] = p.URL()
+		return ps, nil
+	}
+}
+
+// hasContentFrom returns whether the given managed resource is a Worker
+// script sourcing its content through contentFrom. The API types are not
+// used, as the configuration they are generated from depends on this
+// package.
+func hasContentFrom(mg resource.Managed) bool {
+	paved, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return false
+	}
+	v, err := paved.GetValue("spec.forProvider.contentFrom")
+	return err == nil && v != nil
+}
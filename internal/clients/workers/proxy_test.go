@@ -0,0 +1,170 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// upload is a part of a Worker script upload.
+type upload struct {
+	Name        string
+	ContentType string
+	Content     string
+}
+
+func multipartBody(t *testing.T, parts []upload) (string, []byte) {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	mw := multipart.NewWriter(buf)
+	for _, p := range parts {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, p.Name, p.Name))
+		h.Set("Content-Type", p.ContentType)
+		w, err := mw.CreatePart(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(p.Content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return mw.FormDataContentType(), buf.Bytes()
+}
+
+func readParts(t *testing.T, r *http.Request) []upload {
+	t.Helper()
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parts []upload
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts = append(parts, upload{Name: p.FormName(), ContentType: p.Header.Get("Content-Type"), Content: string(b)})
+	}
+}
+
+func TestProxy(t *testing.T) {
+	ContentDir = t.TempDir()
+
+	const main = "export default {};\n// modules: abc\n"
+	metadata := upload{Name: "metadata", ContentType: "application/json", Content: `{"main_module":"index.js"}`}
+	mainModule := upload{Name: "index.js", ContentType: "application/javascript+module", Content: main}
+
+	h := sha256.Sum256([]byte(main))
+	lib, err := WriteContent([]byte("export const a = 1;\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wasm, err := WriteContent([]byte{0x00, 0x61, 0x73, 0x6d})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteModules(hex.EncodeToString(h[:]), []Module{
+		{Name: "lib.js", ContentType: "application/javascript+module", SHA256: lib},
+		{Name: "add.wasm", ContentType: "application/wasm", SHA256: wasm},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		method string
+		path   string
+		parts  []upload
+		want   []upload
+	}{
+		"MultiModule": {
+			method: http.MethodPut,
+			path:   "/client/v4/accounts/account/workers/scripts/worker",
+			parts:  []upload{metadata, mainModule},
+			want: []upload{
+				metadata,
+				mainModule,
+				{Name: "add.wasm", ContentType: "application/wasm", Content: "\x00asm"},
+				{Name: "lib.js", ContentType: "application/javascript+module", Content: "export const a = 1;\n"},
+			},
+		},
+		"SingleModule": {
+			method: http.MethodPut,
+			path:   "/client/v4/accounts/account/workers/scripts/worker",
+			parts:  []upload{metadata, {Name: "index.js", ContentType: "application/javascript+module", Content: "export default {};\n"}},
+			want:   []upload{metadata, {Name: "index.js", ContentType: "application/javascript+module", Content: "export default {};\n"}},
+		},
+		"OtherRequest": {
+			method: http.MethodPut,
+			path:   "/client/v4/accounts/account/workers/scripts/worker/settings",
+			parts:  []upload{metadata, mainModule},
+			want:   []upload{metadata, mainModule},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []upload
+			var gotPath string
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				got = readParts(t, r)
+				_, _ = w.Write([]byte(`{"success":true}`))
+			}))
+			defer upstream.Close()
+			u, err := url.Parse(upstream.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, err := NewProxy(u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() { _ = p.Start(ctx) }()
+
+			contentType, body := multipartBody(t, tc.parts)
+			req, err := http.NewRequestWithContext(ctx, tc.method, p.URL()+tc.path[len("/client/v4/"):], bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", contentType)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Do(...): status %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			if gotPath != tc.path {
+				t.Errorf("Do(...): upstream path %s, want %s", gotPath, tc.path)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Do(...): upstream parts %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	v1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/authenticated/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/secretref"
 	features "gitlab.com/jarvisai.run/provider-cloudflare/internal/features"
 )
 
@@ -87,10 +88,16 @@ func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
 
 	r := managed.NewReconciler(mgr, xpresource.ManagedKind(v1alpha1.OriginPullsCertificate_GroupVersionKind), opts...)
 
+	secrets, err := secretref.NewSource(mgr, v1alpha1.OriginPullsCertificate_GroupVersionKind, o.Logger)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(xpresource.DesiredStateChanged()).
 		Watches(&v1alpha1.OriginPullsCertificate{}, eventHandler).
+		WatchesRawSource(secrets).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
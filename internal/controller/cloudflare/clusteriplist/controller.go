@@ -0,0 +1,395 @@
+package clusteriplist
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	zerov1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/zero/v1alpha1"
+)
+
+const (
+	errNotClusterIPList = "managed resource is not a ClusterIPList custom resource"
+	errTarget           = "exactly one of listRef and trustListRef must be set"
+	errGetList          = "cannot get List %s"
+	errGetTrustList     = "cannot get TrustList %s"
+	errListKind         = "List %s is of kind %s, not ip"
+	errTrustListType    = "TrustList %s is of type %s, not IP"
+	errSelector         = "invalid %s selector"
+	errListNodes        = "cannot list the Nodes"
+	errListServices     = "cannot list the Services"
+	errInvalidCIDR      = "%q is not an IP address or CIDR"
+	errUpdateItems      = "cannot update the items of %s"
+	errListClusterLists = "cannot list the ClusterIPLists"
+)
+
+// listPrefixLength is the length of the prefix IPv6 addresses are widened
+// to in a List, which does not accept longer IPv6 prefixes.
+const listPrefixLength = 64
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ClusterIPList_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ClusterIPList_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	// Every ClusterIPList is reconciled when a Node or Service changes, as
+	// there are few of them and selectors cannot be indexed.
+	all := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+		l := &v1alpha1.ClusterIPListList{}
+		if err := mgr.GetClient().List(ctx, l); err != nil {
+			o.Logger.Info(errListClusterLists, "error", err)
+			return nil
+		}
+		reqs := make([]reconcile.Request, len(l.Items))
+		for i := range l.Items {
+			reqs[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: l.Items[i].GetName()}}
+		}
+		return reqs
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ClusterIPList{}).
+		Watches(&corev1.Node{}, all).
+		Watches(&corev1.Service{}, all).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+// Connect returns a client of the Kubernetes API, as the list is updated
+// through its managed resource.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.ClusterIPList); !ok {
+		return nil, errors.New(errNotClusterIPList)
+	}
+	return &external{kube: c.kube, logger: c.logger}, nil
+}
+
+type external struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterIPList)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterIPList)
+	}
+	t, err := e.target(ctx, cr)
+	if meta.WasDeleted(cr) && kerrors.IsNotFound(err) {
+		// The list was deleted before this resource, so there are no
+		// items left to remove.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	s := &cr.Status.AtProvider
+	current := t.items()
+	s.ItemCount = len(current)
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: len(current) > 0}, nil
+	}
+
+	desired, err := e.addresses(ctx, cr, t.widen)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	min := cr.Spec.ForProvider.MinItems
+	s.Withheld = len(desired) < min
+	if s.Withheld {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("%d addresses are fewer than minItems %d, the list is not updated", len(desired), min)))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	upToDate := slices.Equal(current, desired)
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterIPList)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterIPList)
+	}
+	return managed.ExternalCreation{}, e.sync(ctx, cr, false)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterIPList)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterIPList)
+	}
+	return managed.ExternalUpdate{}, e.sync(ctx, cr, false)
+}
+
+// Delete removes all the items of the list.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ClusterIPList)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotClusterIPList)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return managed.ExternalDelete{}, e.sync(ctx, cr, true)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// sync sets the items of the list to the computed addresses, or removes
+// them all if clear is true. Addresses fewer than minItems are never
+// applied.
+func (e *external) sync(ctx context.Context, cr *v1alpha1.ClusterIPList, clear bool) error {
+	t, err := e.target(ctx, cr)
+	if err != nil {
+		return err
+	}
+	var addrs []string
+	if !clear {
+		if addrs, err = e.addresses(ctx, cr, t.widen); err != nil {
+			return err
+		}
+		if len(addrs) < cr.Spec.ForProvider.MinItems {
+			return nil
+		}
+	}
+	comment := "Managed by ClusterIPList " + cr.GetName()
+	orig := t.obj.DeepCopyObject().(client.Object)
+	t.setItems(addrs, comment)
+	if err := e.kube.Patch(ctx, t.obj, client.MergeFrom(orig)); err != nil {
+		return errors.Wrapf(err, errUpdateItems, t.obj.GetName())
+	}
+	cr.Status.AtProvider.ItemCount = len(addrs)
+	cr.Status.AtProvider.LastSyncTime = ptr.To(metav1.Now())
+	return nil
+}
+
+// target is the List or TrustList whose items are kept in sync.
+type target struct {
+	obj      client.Object
+	items    func() []string
+	setItems func(addrs []string, comment string)
+	// widen widens IPv6 addresses to a prefix the list accepts.
+	widen bool
+}
+
+func (e *external) target(ctx context.Context, cr *v1alpha1.ClusterIPList) (*target, error) {
+	p := cr.Spec.ForProvider
+	switch {
+	case p.ListRef != nil && p.TrustListRef == nil:
+		l := &v1alpha1.List{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: p.ListRef.Name}, l); err != nil {
+			return nil, errors.Wrapf(err, errGetList, p.ListRef.Name)
+		}
+		if k := ptr.Deref(l.Spec.ForProvider.Kind, "ip"); k != "ip" {
+			return nil, errors.Errorf(errListKind, l.GetName(), k)
+		}
+		return &target{
+			obj:   l,
+			widen: true,
+			items: func() []string {
+				var out []string
+				for _, i := range l.Spec.ForProvider.Items {
+					if i.IP != nil {
+						out = append(out, *i.IP)
+					}
+				}
+				return normalized(out)
+			},
+			setItems: func(addrs []string, comment string) {
+				l.Spec.ForProvider.Items = nil
+				for _, a := range addrs {
+					l.Spec.ForProvider.Items = append(l.Spec.ForProvider.Items, v1alpha1.ItemsParameters{IP: ptr.To(a), Comment: ptr.To(comment)})
+				}
+			},
+		}, nil
+	case p.TrustListRef != nil && p.ListRef == nil:
+		l := &zerov1alpha1.TrustList{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: p.TrustListRef.Name}, l); err != nil {
+			return nil, errors.Wrapf(err, errGetTrustList, p.TrustListRef.Name)
+		}
+		if t := ptr.Deref(l.Spec.ForProvider.Type, "IP"); t != "IP" {
+			return nil, errors.Errorf(errTrustListType, l.GetName(), t)
+		}
+		return &target{
+			obj: l,
+			items: func() []string {
+				var out []string
+				for _, i := range l.Spec.ForProvider.Items {
+					if i.Value != nil {
+						out = append(out, *i.Value)
+					}
+				}
+				return normalized(out)
+			},
+			setItems: func(addrs []string, comment string) {
+				l.Spec.ForProvider.Items = nil
+				for _, a := range addrs {
+					l.Spec.ForProvider.Items = append(l.Spec.ForProvider.Items, zerov1alpha1.ItemsParameters{Value: ptr.To(a), Description: ptr.To(comment)})
+				}
+			},
+		}, nil
+	}
+	return nil, errors.New(errTarget)
+}
+
+// addresses computes the sorted addresses of the selected Nodes and
+// Services and of the static CIDRs.
+func (e *external) addresses(ctx context.Context, cr *v1alpha1.ClusterIPList, widen bool) ([]string, error) {
+	p := cr.Spec.ForProvider
+	s := &cr.Status.AtProvider
+	var raw []string
+
+	s.NodeCount = 0
+	if p.NodeSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(p.NodeSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, errSelector, "node")
+		}
+		nodes := &corev1.NodeList{}
+		if err := e.kube.List(ctx, nodes, client.MatchingLabelsSelector{Selector: sel}); err != nil {
+			return nil, errors.Wrap(err, errListNodes)
+		}
+		addrTypes := p.NodeAddressTypes
+		if len(addrTypes) == 0 {
+			addrTypes = []string{string(corev1.NodeExternalIP)}
+		}
+		for _, n := range nodes.Items {
+			if n.GetDeletionTimestamp() != nil {
+				continue
+			}
+			s.NodeCount++
+			for _, a := range n.Status.Addresses {
+				if slices.Contains(addrTypes, string(a.Type)) {
+					raw = append(raw, a.Address)
+				}
+			}
+		}
+	}
+
+	s.ServiceCount = 0
+	if p.ServiceSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(p.ServiceSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, errSelector, "service")
+		}
+		svcs := &corev1.ServiceList{}
+		if err := e.kube.List(ctx, svcs, client.MatchingLabelsSelector{Selector: sel}); err != nil {
+			return nil, errors.Wrap(err, errListServices)
+		}
+		for _, svc := range svcs.Items {
+			if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+				continue
+			}
+			n := len(raw)
+			for _, in := range svc.Status.LoadBalancer.Ingress {
+				if in.IP != "" {
+					raw = append(raw, in.IP)
+				}
+			}
+			if len(raw) > n {
+				s.ServiceCount++
+			}
+		}
+	}
+
+	for _, c := range p.CIDRs {
+		if _, ok := normalize(c); !ok {
+			return nil, errors.Errorf(errInvalidCIDR, c)
+		}
+		raw = append(raw, c)
+	}
+
+	out := make([]string, 0, len(raw))
+	for _, r := range raw {
+		pfx, ok := normalize(r)
+		if !ok {
+			continue
+		}
+		if widen && pfx.Addr().Is6() && pfx.Bits() > listPrefixLength {
+			pfx = netip.PrefixFrom(pfx.Addr(), listPrefixLength).Masked()
+		}
+		out = append(out, format(pfx))
+	}
+	addrs := normalized(out)
+	s.Addresses = addrs
+	return addrs, nil
+}
+
+// normalize parses an address or CIDR as a prefix.
+func normalize(s string) (netip.Prefix, bool) {
+	if p, err := netip.ParsePrefix(s); err == nil {
+		return p.Masked(), true
+	}
+	if a, err := netip.ParseAddr(s); err == nil {
+		return netip.PrefixFrom(a, a.BitLen()), true
+	}
+	return netip.Prefix{}, false
+}
+
+// format formats a prefix as an address if it holds a single one.
+func format(p netip.Prefix) string {
+	if p.IsSingleIP() {
+		return p.Addr().String()
+	}
+	return p.String()
+}
+
+// normalized returns the given addresses and CIDRs in canonical form,
+// sorted and without duplicates.
+func normalized(addrs []string) []string {
+	out := make([]string, 0, len(addrs))
+	for _, a := range addrs {
+		if p, ok := normalize(a); ok {
+			out = append(out, format(p))
+		} else {
+			out = append(out, a)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
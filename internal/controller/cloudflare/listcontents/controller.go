@@ -0,0 +1,343 @@
+package listcontents
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+)
+
+const (
+	errNotListContents = "managed resource is not a ListContents custom resource"
+	errNoListID        = "listId is not set"
+	errSource          = "every source must set exactly one of configMapRef or url"
+	errGetConfigMap    = "cannot get ConfigMap %s/%s"
+	errNoKey           = "ConfigMap %s/%s has no key %s"
+	errFetch           = "cannot fetch %s"
+	errFetchStatus     = "cannot fetch %s: %s"
+	errFeedTooLarge    = "feed %s is larger than %d bytes"
+	errGetList         = "cannot get list %s"
+	errGetOperation    = "cannot get bulk operation %s"
+	errReplaceItems    = "cannot replace the items of list %s"
+	errOperationFailed = "bulk operation %s failed: %s"
+	errUpdateStatus    = "cannot update the status of the ListContents"
+)
+
+// The statuses of a bulk operation.
+const (
+	operationPending   = "pending"
+	operationRunning   = "running"
+	operationCompleted = "completed"
+	operationFailed    = "failed"
+)
+
+// maxFeedSize is the largest URL feed that is read.
+const maxFeedSize = 64 << 20
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ListContents_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ListContents_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ListContents{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ListContents)
+	if !ok {
+		return nil, errors.New(errNotListContents)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:   c.kube,
+		api:    api,
+		http:   &http.Client{Timeout: time.Minute},
+		logger: c.logger,
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	http   *http.Client
+	logger logging.Logger
+
+	// desired are the items read from the sources by Observe.
+	desired *items
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ListContents)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotListContents)
+	}
+	listID := ptr.Deref(cr.Spec.ForProvider.ListID, "")
+	if listID == "" {
+		return managed.ExternalObservation{}, errors.New(errNoListID)
+	}
+	rc := cloudflare.AccountIdentifier(cr.Spec.ForProvider.AccountID)
+	s := &cr.Status.AtProvider
+
+	list, err := e.api.GetList(ctx, rc, listID)
+	if isNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetList, listID)
+	}
+	s.Kind, s.ListItemCount = list.Kind, list.NumItems
+
+	pending := false
+	if s.OperationID != "" && (s.OperationStatus == operationPending || s.OperationStatus == operationRunning) {
+		op, err := e.api.GetListBulkOperation(ctx, rc, s.OperationID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrapf(err, errGetOperation, s.OperationID)
+		}
+		s.OperationStatus, s.OperationError = op.Status, op.Error
+		switch op.Status {
+		case operationCompleted:
+			// The list is read again for the number of items and the
+			// modification time set by the operation.
+			if list, err = e.api.GetList(ctx, rc, listID); err != nil {
+				return managed.ExternalObservation{}, errors.Wrapf(err, errGetList, listID)
+			}
+			s.ListItemCount = list.NumItems
+			s.ListModifiedOn = toTime(list.ModifiedOn)
+			s.LastSyncTime = toTime(op.Completed)
+		case operationFailed:
+		default:
+			pending = true
+		}
+	}
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: pending || list.NumItems > 0}, nil
+	}
+	if pending {
+		cr.SetConditions(xpv1.Creating().WithMessage(fmt.Sprintf("bulk operation %s is %s", s.OperationID, s.OperationStatus)))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if s.OperationStatus == operationFailed {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errOperationFailed, s.OperationID, s.OperationError)))
+	}
+
+	desired, err := e.read(ctx, cr, list.Kind)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	e.desired = desired
+	s.ItemCount = len(desired.byKey)
+	s.InvalidItemCount, s.InvalidItems = desired.invalidCount, desired.invalid
+
+	upToDate := s.OperationStatus == operationCompleted &&
+		s.Digest == desired.digest() &&
+		list.NumItems == len(desired.byKey) &&
+		s.ListModifiedOn.Equal(toTime(list.ModifiedOn))
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   s.Digest != "",
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ListContents)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotListContents)
+	}
+	if err := e.replace(ctx, cr, e.desired); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	// The digest and operation tell Observe that the items were replaced.
+	// They are persisted right away, as the managed reconciler updates the
+	// critical annotations of a created resource before its status, which
+	// resets the status to the one stored.
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Status().Update(ctx, cr), errUpdateStatus)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ListContents)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotListContents)
+	}
+	return managed.ExternalUpdate{}, e.replace(ctx, cr, e.desired)
+}
+
+// Delete removes all the items of the list. The list itself is left to the
+// List managing it.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ListContents)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotListContents)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	s := cr.Status.AtProvider
+	if s.OperationStatus == operationPending || s.OperationStatus == operationRunning {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, e.replace(ctx, cr, newItems(s.Kind))
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// replace starts a bulk operation replacing the items of the list. Its
+// status is polled by Observe.
+func (e *external) replace(ctx context.Context, cr *v1alpha1.ListContents, desired *items) error {
+	listID := ptr.Deref(cr.Spec.ForProvider.ListID, "")
+	if desired == nil {
+		var err error
+		if desired, err = e.read(ctx, cr, cr.Status.AtProvider.Kind); err != nil {
+			return err
+		}
+	}
+	res, err := e.api.ReplaceListItemsAsync(ctx, cloudflare.AccountIdentifier(cr.Spec.ForProvider.AccountID), cloudflare.ListReplaceItemsParams{
+		ID:    listID,
+		Items: desired.sorted(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, errReplaceItems, listID)
+	}
+	s := &cr.Status.AtProvider
+	s.OperationID, s.OperationStatus, s.OperationError = res.Result.OperationID, operationPending, ""
+	s.Digest = desired.digest()
+	return nil
+}
+
+// read reads the items of the sources. Any source that cannot be read fails
+// the read, so that the list is never replaced with a partial set of items.
+func (e *external) read(ctx context.Context, cr *v1alpha1.ListContents, kind string) (*items, error) {
+	it := newItems(kind)
+	for _, src := range cr.Spec.ForProvider.Sources {
+		switch {
+		case src.ConfigMapRef != nil && src.URL == nil:
+			ref := src.ConfigMapRef
+			cm := &corev1.ConfigMap{}
+			if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+				return nil, errors.Wrapf(err, errGetConfigMap, ref.Namespace, ref.Name)
+			}
+			if ref.Key != "" {
+				data, ok := cm.Data[ref.Key]
+				if !ok {
+					return nil, errors.Errorf(errNoKey, ref.Namespace, ref.Name, ref.Key)
+				}
+				it.add(ref.Namespace+"/"+ref.Name+"/"+ref.Key, data, src.Comment)
+				continue
+			}
+			for _, k := range sortedKeys(cm.Data) {
+				it.add(ref.Namespace+"/"+ref.Name+"/"+k, cm.Data[k], src.Comment)
+			}
+		case src.URL != nil && src.ConfigMapRef == nil:
+			data, err := e.fetch(ctx, *src.URL)
+			if err != nil {
+				return nil, err
+			}
+			it.add(*src.URL, data, src.Comment)
+		default:
+			return nil, errors.New(errSource)
+		}
+	}
+	return it, nil
+}
+
+func (e *external) fetch(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, errFetch, url)
+	}
+	res, err := e.http.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, errFetch, url)
+	}
+	defer res.Body.Close() //nolint:errcheck
+	if res.StatusCode != http.StatusOK {
+		return "", errors.Errorf(errFetchStatus, url, res.Status)
+	}
+	b, err := io.ReadAll(io.LimitReader(res.Body, maxFeedSize+1))
+	if err != nil {
+		return "", errors.Wrapf(err, errFetch, url)
+	}
+	if len(b) > maxFeedSize {
+		return "", errors.Errorf(errFeedTooLarge, url, maxFeedSize)
+	}
+	return string(b), nil
+}
+
+func isNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
+
+func toTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	mt := metav1.NewTime(t.UTC().Truncate(time.Second))
+	return &mt
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
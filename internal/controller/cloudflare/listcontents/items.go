@@ -0,0 +1,153 @@
+package listcontents
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"k8s.io/utils/ptr"
+)
+
+// maxInvalidItems is the number of invalid lines reported in status.
+const maxInvalidItems = 10
+
+var hostname = regexp.MustCompile(`^(\*\.)?([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// items are the items read from the sources, keyed by their value so that
+// duplicates are dropped.
+type items struct {
+	kind    string
+	byKey   map[string]cloudflare.ListItemCreateRequest
+	invalid []string
+	// invalidCount is the number of invalid lines, of which the first are
+	// kept in invalid.
+	invalidCount int
+}
+
+func newItems(kind string) *items {
+	return &items{kind: kind, byKey: map[string]cloudflare.ListItemCreateRequest{}}
+}
+
+// add parses the lines of a source. The first occurrence of an item wins.
+func (it *items) add(source, data, comment string) {
+	s := bufio.NewScanner(strings.NewReader(data))
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; s.Scan(); n++ {
+		line, c := s.Text(), ""
+		if i := strings.IndexAny(line, "#;"); i >= 0 {
+			line, c = line[:i], line[i+1:]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		c = strings.TrimSpace(c)
+		if c == "" {
+			c = comment
+		}
+		k, item, err := parse(it.kind, line)
+		if err != nil {
+			it.invalidCount++
+			if len(it.invalid) < maxInvalidItems {
+				it.invalid = append(it.invalid, fmt.Sprintf("%s:%d: %s", source, n, err))
+			}
+			continue
+		}
+		if _, ok := it.byKey[k]; ok {
+			continue
+		}
+		item.Comment = c
+		it.byKey[k] = item
+	}
+}
+
+// sorted returns the items in the order of their values.
+func (it *items) sorted() []cloudflare.ListItemCreateRequest {
+	keys := make([]string, 0, len(it.byKey))
+	for k := range it.byKey {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	out := make([]cloudflare.ListItemCreateRequest, len(keys))
+	for i, k := range keys {
+		out[i] = it.byKey[k]
+	}
+	return out
+}
+
+// digest returns a digest of the items and their comments.
+func (it *items) digest() string {
+	h := sha256.New()
+	for _, i := range it.sorted() {
+		b, _ := json.Marshal(i)
+		h.Write(append(b, '\n'))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// parse parses an item of a list of the given kind and returns its
+// normalized value.
+func parse(kind, line string) (string, cloudflare.ListItemCreateRequest, error) {
+	var item cloudflare.ListItemCreateRequest
+	switch kind {
+	case cloudflare.ListTypeIP:
+		if p, err := netip.ParsePrefix(line); err == nil {
+			item.IP = ptr.To(p.Masked().String())
+		} else if a, err := netip.ParseAddr(line); err == nil {
+			item.IP = ptr.To(a.String())
+		} else {
+			return "", item, fmt.Errorf("%q is not an IP address or CIDR", line)
+		}
+	case cloudflare.ListTypeHostname:
+		h := strings.ToLower(line)
+		if !hostname.MatchString(h) {
+			return "", item, fmt.Errorf("%q is not a hostname", line)
+		}
+		item.Hostname = &cloudflare.Hostname{UrlHostname: h}
+	case cloudflare.ListTypeASN:
+		n, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(line), "AS"), 10, 32)
+		if err != nil {
+			return "", item, fmt.Errorf("%q is not an ASN", line)
+		}
+		item.ASN = ptr.To(uint32(n))
+	case cloudflare.ListTypeRedirect:
+		f := strings.Fields(line)
+		if len(f) < 2 || len(f) > 3 {
+			return "", item, fmt.Errorf("%q is not a source URL, a target URL and an optional status code", line)
+		}
+		item.Redirect = &cloudflare.Redirect{SourceUrl: f[0], TargetUrl: f[1]}
+		if len(f) == 3 {
+			code, err := strconv.Atoi(f[2])
+			if err != nil || code < 301 || code > 308 {
+				return "", item, fmt.Errorf("%q is not a redirect status code", f[2])
+			}
+			item.Redirect.StatusCode = ptr.To(code)
+		}
+	default:
+		return "", item, fmt.Errorf("lists of kind %q are not supported", kind)
+	}
+	return key(item), item, nil
+}
+
+// key returns the value identifying the given item within its list.
+func key(i cloudflare.ListItemCreateRequest) string {
+	switch {
+	case i.IP != nil:
+		return *i.IP
+	case i.Hostname != nil:
+		return i.Hostname.UrlHostname
+	case i.ASN != nil:
+		return strconv.FormatUint(uint64(*i.ASN), 10)
+	case i.Redirect != nil:
+		return i.Redirect.SourceUrl
+	}
+	return ""
+}
@@ -0,0 +1,388 @@
+package managedrulesetdeployment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/rulesets"
+	"gitlab.com/jarvisai.run/provider-cloudflare/pkg/rules"
+)
+
+const (
+	errNotDeployment    = "managed resource is not a ManagedRulesetDeployment custom resource"
+	errNoZoneID         = "zoneId is not set"
+	errGetRule          = "cannot get RulesetRule %s"
+	errApplyRule        = "cannot apply RulesetRule %s"
+	errDeleteRule       = "cannot delete RulesetRule %s"
+	errNotOwned         = "RulesetRule %s exists and is not owned by this ManagedRulesetDeployment"
+	errExpression       = "invalid expression"
+	errNoManagedRuleset = "managed ruleset %q is not available to the zone"
+	errUnknownTag       = "category override %d: no rule of %s is tagged %q"
+	errRuleSelector     = "rule override %d: exactly one of id and description must be set"
+	errUnknownRule      = "rule override %d: %s has no rule %q"
+	errAmbiguousRule    = "rule override %d: %d rules of %s are described as %q, use the id instead"
+	errDuplicateRule    = "rule override %d: rule %s is already overridden"
+	errEmptyOverride    = "%s override %d overrides nothing"
+	errEncodeParameters = "cannot encode the action parameters"
+)
+
+// Phase is the phase the managed rulesets are deployed to.
+const Phase = "http_request_firewall_managed"
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ManagedRulesetDeployment_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ManagedRulesetDeployment_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ManagedRulesetDeployment{}).
+		Owns(&v1alpha1.RulesetRule{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ManagedRulesetDeployment)
+	if !ok {
+		return nil, errors.New(errNotDeployment)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:   c.kube,
+		api:    api,
+		logger: c.logger,
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDeployment)
+	}
+
+	child := &v1alpha1.RulesetRule{}
+	err := e.kube.Get(ctx, types.NamespacedName{Name: cr.GetName()}, child)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetRule, cr.GetName())
+	}
+	if !metav1.IsControlledBy(child, cr) {
+		return managed.ExternalObservation{}, errors.Errorf(errNotOwned, cr.GetName())
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	desired, err := e.render(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.RuleID = child.Status.AtProvider.RuleID
+	cr.Status.AtProvider.Position = child.Status.AtProvider.Position
+	cr.SetConditions(child.GetCondition(xpv1.TypeReady))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate(child, desired),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDeployment)
+	}
+	return managed.ExternalCreation{}, e.apply(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDeployment)
+	}
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+// Delete deletes the RulesetRule, which removes the execute rule from the
+// entrypoint unless the deletion policy is Orphan.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDeployment)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	child := &v1alpha1.RulesetRule{}
+	child.SetName(cr.GetName())
+	return managed.ExternalDelete{}, errors.Wrapf(client.IgnoreNotFound(e.kube.Delete(ctx, child)), errDeleteRule, cr.GetName())
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// apply creates or updates the RulesetRule holding the execute rule. It
+// inherits the policies and the provider config of the deployment.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.ManagedRulesetDeployment) error {
+	desired, err := e.render(ctx, cr)
+	if err != nil {
+		return err
+	}
+	child := &v1alpha1.RulesetRule{}
+	child.SetName(cr.GetName())
+	_, err = controllerutil.CreateOrUpdate(ctx, e.kube, child, func() error {
+		if child.GetUID() != "" && !metav1.IsControlledBy(child, cr) {
+			return errors.Errorf(errNotOwned, cr.GetName())
+		}
+		child.Spec.ForProvider = desired
+		child.Spec.ProviderConfigReference = cr.GetProviderConfigReference()
+		child.Spec.ManagementPolicies = cr.GetManagementPolicies()
+		child.Spec.DeletionPolicy = cr.GetDeletionPolicy()
+		return controllerutil.SetControllerReference(cr, child, e.kube.Scheme())
+	})
+	return errors.Wrapf(err, errApplyRule, cr.GetName())
+}
+
+// render resolves the managed ruleset and the targets of the overrides and
+// returns the parameters of the execute rule.
+func (e *external) render(ctx context.Context, cr *v1alpha1.ManagedRulesetDeployment) (v1alpha1.RulesetRuleParameters, error) {
+	p := cr.Spec.ForProvider
+	zoneID := ptr.Deref(p.ZoneID, "")
+	if zoneID == "" {
+		return v1alpha1.RulesetRuleParameters{}, errors.New(errNoZoneID)
+	}
+	expression := p.Expression
+	if expression == "" {
+		expression = "true"
+	}
+	if err := rules.Check(expression); err != nil {
+		return v1alpha1.RulesetRuleParameters{}, errors.Wrap(err, errExpression)
+	}
+
+	all, err := rulesets.List(ctx, e.api, zoneID)
+	if err != nil {
+		return v1alpha1.RulesetRuleParameters{}, err
+	}
+	var rs *rulesets.Ruleset
+	for i := range all {
+		r := all[i]
+		if r.Kind == rulesets.KindManaged && r.Phase == Phase && (r.Name == p.Ruleset || r.ID == p.Ruleset) {
+			rs = &r
+			break
+		}
+	}
+	if rs == nil {
+		return v1alpha1.RulesetRuleParameters{}, errors.Errorf(errNoManagedRuleset, p.Ruleset)
+	}
+	if rs, err = rulesets.GetRuleset(ctx, e.api, zoneID, rs.ID); err != nil {
+		return v1alpha1.RulesetRuleParameters{}, err
+	}
+	s := &cr.Status.AtProvider
+	s.RulesetID, s.RulesetName, s.RulesetVersion = rs.ID, rs.Name, rs.Version
+
+	ap := map[string]any{"id": rs.ID}
+	if p.Overrides != nil {
+		o, err := overrides(rs, p.Overrides)
+		if err != nil {
+			return v1alpha1.RulesetRuleParameters{}, err
+		}
+		if len(o) > 0 {
+			ap["overrides"] = o
+		}
+	}
+	raw, err := json.Marshal(ap)
+	if err != nil {
+		return v1alpha1.RulesetRuleParameters{}, errors.Wrap(err, errEncodeParameters)
+	}
+
+	return v1alpha1.RulesetRuleParameters{
+		ZoneID:           ptr.To(zoneID),
+		Phase:            Phase,
+		Priority:         p.Priority,
+		Action:           "execute",
+		Expression:       expression,
+		Description:      p.Description,
+		Enabled:          ptr.To(ptr.Deref(p.Enabled, true)),
+		ActionParameters: &apiextensionsv1.JSON{Raw: raw},
+	}, nil
+}
+
+// overrides validates the given overrides against the rules of the managed
+// ruleset and renders them in the format of the Rulesets API.
+func overrides(rs *rulesets.Ruleset, in *v1alpha1.ManagedRulesetOverrides) (map[string]any, error) {
+	out := map[string]any{}
+	set(out, "action", in.Action)
+	set(out, "enabled", in.Enabled)
+	set(out, "sensitivity_level", in.SensitivityLevel)
+
+	tags := map[string]bool{}
+	for _, r := range rs.Rules {
+		for _, c := range r.Categories {
+			tags[c] = true
+		}
+	}
+	var categories []any
+	for i, c := range in.Categories {
+		if !tags[c.Tag] {
+			return nil, errors.Errorf(errUnknownTag, i, rs.Name, c.Tag)
+		}
+		o := map[string]any{"category": c.Tag}
+		set(o, "action", c.Action)
+		set(o, "enabled", c.Enabled)
+		if len(o) == 1 {
+			return nil, errors.Errorf(errEmptyOverride, "category", i)
+		}
+		categories = append(categories, o)
+	}
+	if len(categories) > 0 {
+		out["categories"] = categories
+	}
+
+	var overridden []any
+	seen := map[string]bool{}
+	for i, r := range in.Rules {
+		id, err := resolveRule(rs, i, r)
+		if err != nil {
+			return nil, err
+		}
+		if seen[id] {
+			return nil, errors.Errorf(errDuplicateRule, i, id)
+		}
+		seen[id] = true
+		o := map[string]any{"id": id}
+		set(o, "action", r.Action)
+		set(o, "enabled", r.Enabled)
+		set(o, "score_threshold", r.ScoreThreshold)
+		set(o, "sensitivity_level", r.SensitivityLevel)
+		if len(o) == 1 {
+			return nil, errors.Errorf(errEmptyOverride, "rule", i)
+		}
+		overridden = append(overridden, o)
+	}
+	if len(overridden) > 0 {
+		out["rules"] = overridden
+	}
+	return out, nil
+}
+
+// resolveRule returns the identifier of the rule the i-th rule override
+// applies to.
+func resolveRule(rs *rulesets.Ruleset, i int, o v1alpha1.ManagedRulesetRuleOverride) (string, error) {
+	if (o.ID == nil) == (o.Description == nil) {
+		return "", errors.Errorf(errRuleSelector, i)
+	}
+	if o.ID != nil {
+		for _, r := range rs.Rules {
+			if r.ID == *o.ID {
+				return r.ID, nil
+			}
+		}
+		return "", errors.Errorf(errUnknownRule, i, rs.Name, *o.ID)
+	}
+	var ids []string
+	for _, r := range rs.Rules {
+		if r.Description == *o.Description {
+			ids = append(ids, r.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", errors.Errorf(errUnknownRule, i, rs.Name, *o.Description)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", errors.Errorf(errAmbiguousRule, i, len(ids), rs.Name, *o.Description)
+	}
+}
+
+func set[T any](m map[string]any, k string, v *T) {
+	if v != nil {
+		m[k] = *v
+	}
+}
+
+// upToDate reports whether the RulesetRule holds the desired parameters.
+// Zone references are not compared as the zone is always resolved.
+func upToDate(child *v1alpha1.RulesetRule, desired v1alpha1.RulesetRuleParameters) bool {
+	a := child.Spec.ForProvider
+	return ptr.Deref(a.ZoneID, "") == ptr.Deref(desired.ZoneID, "") &&
+		a.Phase == desired.Phase &&
+		a.Priority == desired.Priority &&
+		a.Action == desired.Action &&
+		a.Expression == desired.Expression &&
+		a.Description == desired.Description &&
+		ptr.Deref(a.Enabled, true) == ptr.Deref(desired.Enabled, true) &&
+		sameJSON(a.ActionParameters, desired.ActionParameters)
+}
+
+func sameJSON(a, b *apiextensionsv1.JSON) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	var av, bv any
+	if json.Unmarshal(a.Raw, &av) != nil || json.Unmarshal(b.Raw, &bv) != nil {
+		return false
+	}
+	ab, _ := json.Marshal(av)
+	bb, _ := json.Marshal(bv)
+	return bytes.Equal(ab, bb)
+}
@@ -0,0 +1,366 @@
+package rulesetrule
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/rulesets"
+	"gitlab.com/jarvisai.run/provider-cloudflare/pkg/rules"
+)
+
+const (
+	errNotRulesetRule = "managed resource is not a RulesetRule custom resource"
+	errNoZoneID       = "zoneId is not set"
+	errListRules      = "cannot list the RulesetRules"
+	errListRulesets   = "cannot list the Rulesets"
+	errManaged        = "the %s entrypoint ruleset of zone %s is managed by Ruleset %s, which replaces all of its rules; add the rule to that Ruleset or delete it"
+	errExpression     = "invalid expression"
+	errDecodeField    = "cannot decode %s"
+)
+
+// RefPrefix prefixes the ref of the rules owned by a RulesetRule. It is
+// followed by the name of the RulesetRule.
+const RefPrefix = "crossplane-"
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.RulesetRule_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RulesetRule_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.RulesetRule{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RulesetRule)
+	if !ok {
+		return nil, errors.New(errNotRulesetRule)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:   c.kube,
+		api:    api,
+		logger: c.logger,
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RulesetRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRulesetRule)
+	}
+	zoneID := ptr.Deref(cr.Spec.ForProvider.ZoneID, "")
+	if zoneID == "" {
+		return managed.ExternalObservation{}, errors.New(errNoZoneID)
+	}
+
+	ep, err := rulesets.Get(ctx, e.api, zoneID, cr.Spec.ForProvider.Phase)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	ref := RefPrefix + cr.GetName()
+	i := index(ep, ref)
+	if i < 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	s := &cr.Status.AtProvider
+	s.RulesetID, s.RuleID, s.Ref, s.Position = ep.ID, ep.Rules[i].ID(), ref, i+1
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	if err := e.conflict(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	desired, err := desiredRule(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	members, err := e.members(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rulesets.Contains(ep.Rules[i], desired) && ordered(ep, members),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RulesetRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRulesetRule)
+	}
+	return managed.ExternalCreation{}, e.merge(ctx, cr, false)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RulesetRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRulesetRule)
+	}
+	return managed.ExternalUpdate{}, e.merge(ctx, cr, false)
+}
+
+// Delete removes the rule from the entrypoint and leaves the other rules as
+// they are.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.RulesetRule)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotRulesetRule)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return managed.ExternalDelete{}, e.merge(ctx, cr, true)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// merge writes the entrypoint with the rule of the given RulesetRule added,
+// updated or, if remove is true, removed. The rules owned by RulesetRules
+// come first, in the order of their priority and name. Rules owned by other
+// RulesetRules are written as they were read, so that a RulesetRule never
+// publishes the changes of another. Rules that are not owned by a
+// RulesetRule, e.g. created in the dashboard or orphaned, follow in their
+// current order.
+func (e *external) merge(ctx context.Context, cr *v1alpha1.RulesetRule, remove bool) error {
+	p := cr.Spec.ForProvider
+	zoneID := ptr.Deref(p.ZoneID, "")
+	if zoneID == "" {
+		return errors.New(errNoZoneID)
+	}
+	var desired rulesets.Rule
+	if !remove {
+		if err := rules.Check(p.Expression); err != nil {
+			return errors.Wrap(err, errExpression)
+		}
+		if err := e.conflict(ctx, cr); err != nil {
+			return err
+		}
+		var err error
+		if desired, err = desiredRule(cr); err != nil {
+			return err
+		}
+	}
+
+	defer rulesets.Lock(zoneID, p.Phase)()
+	ep, err := rulesets.Get(ctx, e.api, zoneID, p.Phase)
+	if err != nil {
+		return err
+	}
+	if ep == nil {
+		if remove {
+			return nil
+		}
+		ep = &rulesets.Entrypoint{}
+	}
+	members, err := e.members(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	ref := RefPrefix + cr.GetName()
+	current := map[string]rulesets.Rule{}
+	for _, r := range ep.Rules {
+		current[r.Ref()] = r
+	}
+	var out []rulesets.Rule
+	owned := map[string]bool{}
+	for _, m := range members {
+		owned[m] = true
+		switch {
+		case m == ref && !remove:
+			if old, ok := current[ref]; ok {
+				desired["id"] = old["id"]
+			}
+			out = append(out, desired)
+		case m == ref:
+		default:
+			if r, ok := current[m]; ok {
+				out = append(out, r)
+			}
+		}
+	}
+	for _, r := range ep.Rules {
+		if !owned[r.Ref()] {
+			out = append(out, r)
+		}
+	}
+	if remove && len(out) == len(ep.Rules) {
+		return nil
+	}
+
+	ep, err = rulesets.Put(ctx, e.api, zoneID, p.Phase, out)
+	if err != nil {
+		return err
+	}
+	s := &cr.Status.AtProvider
+	s.RulesetID, s.Ref = ep.ID, ref
+	s.RuleID, s.Position = "", 0
+	if i := index(ep, ref); i >= 0 {
+		s.RuleID, s.Position = ep.Rules[i].ID(), i+1
+	}
+	return nil
+}
+
+// conflict returns an error if a Ruleset manages the entrypoint the given
+// RulesetRule merges its rule into. The Ruleset would remove the rule on its
+// next apply, and the two would keep overwriting each other.
+func (e *external) conflict(ctx context.Context, cr *v1alpha1.RulesetRule) error {
+	l := &v1alpha1.RulesetList{}
+	if err := e.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListRulesets)
+	}
+	p := cr.Spec.ForProvider
+	zoneID := ptr.Deref(p.ZoneID, "")
+	for _, r := range l.Items {
+		rp := r.Spec.ForProvider
+		if ptr.Deref(rp.Kind, "") == "zone" && ptr.Deref(rp.Phase, "") == p.Phase && ptr.Deref(rp.ZoneID, "") == zoneID {
+			return errors.Errorf(errManaged, p.Phase, zoneID, r.GetName())
+		}
+	}
+	return nil
+}
+
+// members returns the refs of the RulesetRules targeting the zone and phase
+// of the given one, in the order of their priority and name. A RulesetRule
+// that is being deleted is included until its rule is removed.
+func (e *external) members(ctx context.Context, cr *v1alpha1.RulesetRule) ([]string, error) {
+	l := &v1alpha1.RulesetRuleList{}
+	if err := e.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListRules)
+	}
+	p := cr.Spec.ForProvider
+	var items []v1alpha1.RulesetRule
+	for _, r := range l.Items {
+		if ptr.Deref(r.Spec.ForProvider.ZoneID, "") == ptr.Deref(p.ZoneID, "") && r.Spec.ForProvider.Phase == p.Phase {
+			items = append(items, r)
+		}
+	}
+	if !slices.ContainsFunc(items, func(r v1alpha1.RulesetRule) bool { return r.GetName() == cr.GetName() }) {
+		items = append(items, *cr)
+	}
+	slices.SortFunc(items, func(a, b v1alpha1.RulesetRule) int {
+		return cmp.Or(cmp.Compare(a.Spec.ForProvider.Priority, b.Spec.ForProvider.Priority), strings.Compare(a.GetName(), b.GetName()))
+	})
+	refs := make([]string, len(items))
+	for i, r := range items {
+		refs[i] = RefPrefix + r.GetName()
+	}
+	return refs, nil
+}
+
+// ordered reports whether the rules of the given members that are in the
+// entrypoint are in the order of the members and precede the other rules.
+func ordered(ep *rulesets.Entrypoint, members []string) bool {
+	var present []string
+	for _, m := range members {
+		if index(ep, m) >= 0 {
+			present = append(present, m)
+		}
+	}
+	for i, m := range present {
+		if ep.Rules[i].Ref() != m {
+			return false
+		}
+	}
+	return true
+}
+
+func index(ep *rulesets.Entrypoint, ref string) int {
+	if ep == nil {
+		return -1
+	}
+	return slices.IndexFunc(ep.Rules, func(r rulesets.Rule) bool { return r.Ref() == ref })
+}
+
+// desiredRule renders the rule of the given RulesetRule in the format of the
+// Rulesets API.
+func desiredRule(cr *v1alpha1.RulesetRule) (rulesets.Rule, error) {
+	p := cr.Spec.ForProvider
+	r := rulesets.Rule{
+		"ref":        RefPrefix + cr.GetName(),
+		"action":     p.Action,
+		"expression": p.Expression,
+		"enabled":    ptr.Deref(p.Enabled, true),
+	}
+	if p.Description != "" {
+		r["description"] = p.Description
+	}
+	for k, v := range map[string]*apiextensionsv1.JSON{
+		"action_parameters": p.ActionParameters,
+		"ratelimit":         p.Ratelimit,
+		"logging":           p.Logging,
+	} {
+		if v == nil || len(v.Raw) == 0 {
+			continue
+		}
+		var val any
+		if err := json.Unmarshal(v.Raw, &val); err != nil {
+			return nil, errors.Wrapf(err, errDecodeField, k)
+		}
+		r[k] = val
+	}
+	return r, nil
+}
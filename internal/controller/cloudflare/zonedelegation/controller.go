@@ -0,0 +1,283 @@
+package zonedelegation
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	dnsv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/dns/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/records"
+)
+
+const (
+	errNotZoneDelegation = "managed resource is not a ZoneDelegation custom resource"
+	errNoZones           = "zoneId and parentZoneId must be set"
+	errGetZone           = "cannot get zone %s"
+	errNotSubdomain      = "zone %s is not a subdomain of parent zone %s"
+	errActivationCheck   = "cannot request an activation check of zone %s"
+)
+
+// LabelZoneDelegation labels the records created for a ZoneDelegation with
+// its name.
+const LabelZoneDelegation = "cloudflare.cloudflare.crossplane.io/zone-delegation"
+
+// statusActive is the status of an active zone.
+const statusActive = "active"
+
+// activationCheckInterval is the interval of the activation checks
+// requested for a pending zone.
+const activationCheckInterval = time.Hour
+
+// keyNameServers is the connection detail key of the comma-separated
+// nameservers assigned to the delegated zone.
+const keyNameServers = "name_servers"
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ZoneDelegation_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ZoneDelegation_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ZoneDelegation{}).
+		Owns(&dnsv1alpha1.Record{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ZoneDelegation)
+	if !ok {
+		return nil, errors.New(errNotZoneDelegation)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{kube: c.kube, api: api, logger: c.logger, records: records.New(c.kube, LabelZoneDelegation)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+
+	records *records.Records
+
+	// desired are the records computed by Observe.
+	desired map[string]dnsv1alpha1.RecordParameters
+	// zoneID is the zone to request an activation check of, if any.
+	zoneID string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ZoneDelegation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotZoneDelegation)
+	}
+	existing, err := e.records.Owned(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: len(existing) > 0}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	zoneID, parentID := ptr.Deref(p.ZoneID, ""), ptr.Deref(p.ParentZoneID, "")
+	if zoneID == "" || parentID == "" {
+		return managed.ExternalObservation{}, errors.New(errNoZones)
+	}
+	z, err := e.api.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetZone, zoneID)
+	}
+	parent, err := e.api.ZoneDetails(ctx, parentID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetZone, parentID)
+	}
+	if !strings.HasSuffix(z.Name, "."+parent.Name) {
+		return managed.ExternalObservation{}, errors.Errorf(errNotSubdomain, z.Name, parent.Name)
+	}
+	e.desired = desiredRecords(cr, parentID, z)
+
+	upToDate := records.UpToDate(existing, e.desired)
+
+	s := &cr.Status.AtProvider
+	s.ZoneName, s.ZoneStatus, s.NameServers = z.Name, z.Status, z.NameServers
+	s.ResolvedNameServers = resolve(ctx, ptr.Deref(p.Resolver, ""), z.Name)
+	s.Propagated = len(s.NameServers) > 0 && sameHosts(s.NameServers, s.ResolvedNameServers)
+	s.Records = slices.Sorted(maps.Keys(e.desired))
+
+	// An activation check is requested through Update once the delegation
+	// has propagated, as it is bound to fail before.
+	if upToDate && s.Propagated && z.Status != statusActive && ptr.Deref(p.ActivationCheck, true) &&
+		(s.LastActivationCheckTime == nil || time.Since(s.LastActivationCheckTime.Time) >= activationCheckInterval) {
+		e.zoneID = zoneID
+		upToDate = false
+	}
+
+	if z.Status == statusActive {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("zone %s is %s, delegation propagated: %t", z.Name, z.Status, s.Propagated)))
+	}
+	conn := managed.ConnectionDetails{}
+	if len(z.NameServers) > 0 {
+		conn[keyNameServers] = []byte(strings.Join(z.NameServers, ","))
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate, ConnectionDetails: conn}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ZoneDelegation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotZoneDelegation)
+	}
+	return managed.ExternalCreation{}, e.records.Apply(ctx, cr, e.desired, nil)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ZoneDelegation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotZoneDelegation)
+	}
+	if err := e.records.Apply(ctx, cr, e.desired, nil); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if e.zoneID == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	// The time of the check is recorded before requesting it, so that a
+	// rejected check, e.g. because of rate limits, is not retried at once.
+	cr.Status.AtProvider.LastActivationCheckTime = ptr.To(metav1.Now())
+	_, err := e.api.ZoneActivationCheck(ctx, e.zoneID)
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errActivationCheck, e.zoneID)
+}
+
+// Delete deletes the records created for the delegation.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ZoneDelegation)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotZoneDelegation)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	e.desired = nil
+	return managed.ExternalDelete{}, e.records.Apply(ctx, cr, e.desired, nil)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// desiredRecords returns the NS records of the nameservers of the zone in its
+// parent zone, keyed by the names of their resources.
+func desiredRecords(cr *v1alpha1.ZoneDelegation, parentID string, z cloudflare.Zone) map[string]dnsv1alpha1.RecordParameters {
+	ttl := cr.Spec.ForProvider.RecordTTL
+	if ttl == 0 {
+		ttl = 1
+	}
+	out := map[string]dnsv1alpha1.RecordParameters{}
+	for _, ns := range z.NameServers {
+		ns = strings.TrimSuffix(strings.ToLower(ns), ".")
+		if ns == "" {
+			continue
+		}
+		out[records.Name(cr.GetName(), "NS", ns)] = dnsv1alpha1.RecordParameters{
+			ZoneID:  ptr.To(parentID),
+			Name:    ptr.To(z.Name),
+			Type:    ptr.To("NS"),
+			Content: ptr.To(ns),
+			TTL:     ptr.To(float64(ttl)),
+			Comment: ptr.To("Managed by ZoneDelegation " + cr.GetName()),
+		}
+	}
+	return out
+}
+
+// resolve returns the nameservers of the zone resolved through the given
+// DNS server, or the resolver of the provider if empty. A failed lookup,
+// e.g. of a zone that is not delegated yet, resolves no nameservers.
+func resolve(ctx context.Context, server, zone string) []string {
+	r := net.DefaultResolver
+	if server != "" {
+		r = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, server)
+			},
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	nss, err := r.LookupNS(ctx, zone)
+	if err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(nss))
+	for _, ns := range nss {
+		out = append(out, strings.TrimSuffix(strings.ToLower(ns.Host), "."))
+	}
+	slices.Sort(out)
+	return out
+}
+
+// sameHosts reports whether both lists contain the same hostnames.
+func sameHosts(a, b []string) bool {
+	norm := func(l []string) []string {
+		out := make([]string, 0, len(l))
+		for _, h := range l {
+			out = append(out, strings.TrimSuffix(strings.ToLower(h), "."))
+		}
+		slices.Sort(out)
+		return slices.Compact(out)
+	}
+	return slices.Equal(norm(a), norm(b))
+}
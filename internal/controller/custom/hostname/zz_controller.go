@@ -22,6 +22,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	v1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/custom/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/readiness"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/secretref"
 	features "gitlab.com/jarvisai.run/provider-cloudflare/internal/features"
 )
 
@@ -42,9 +44,9 @@ func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
 	eventHandler := handler.NewEventHandler(handler.WithLogger(o.Logger.WithValues("gvk", v1alpha1.Hostname_GroupVersionKind)))
 	ac := tjcontroller.NewAPICallbacks(mgr, xpresource.ManagedKind(v1alpha1.Hostname_GroupVersionKind), tjcontroller.WithEventHandler(eventHandler))
 	opts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(tjcontroller.NewConnector(mgr.GetClient(), o.WorkspaceStore, o.SetupFn, o.Provider.Resources["cloudflare_custom_hostname"], tjcontroller.WithLogger(o.Logger), tjcontroller.WithConnectorEventHandler(eventHandler),
+		managed.WithExternalConnecter(readiness.NewConnecter(o.Provider.Resources["cloudflare_custom_hostname"], tjcontroller.NewConnector(mgr.GetClient(), o.WorkspaceStore, o.SetupFn, o.Provider.Resources["cloudflare_custom_hostname"], tjcontroller.WithLogger(o.Logger), tjcontroller.WithConnectorEventHandler(eventHandler),
 			tjcontroller.WithCallbackProvider(ac),
-		)),
+		))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithFinalizer(terraform.NewWorkspaceFinalizer(o.WorkspaceStore, xpresource.NewAPIFinalizer(mgr.GetClient(), managed.FinalizerName))),
@@ -87,10 +89,16 @@ func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
 
 	r := managed.NewReconciler(mgr, xpresource.ManagedKind(v1alpha1.Hostname_GroupVersionKind), opts...)
 
+	secrets, err := secretref.NewSource(mgr, v1alpha1.Hostname_GroupVersionKind, o.Logger)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(xpresource.DesiredStateChanged()).
 		Watches(&v1alpha1.Hostname{}, eventHandler).
+		WatchesRawSource(secrets).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -0,0 +1,303 @@
+package hostnameverification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/custom/v1alpha1"
+	dnsv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/dns/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/config/custom"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/records"
+)
+
+const (
+	errNotHostnameVerification = "managed resource is not a HostnameVerification custom resource"
+	errGetHostname             = "cannot get Hostname %s"
+	errHostnameNotReady        = "Hostname %s has no ID or zone ID yet"
+	errGetCustomHostname       = "cannot get custom hostname %s"
+	errGetZone                 = "cannot get zone %s"
+	errMarshalRecords          = "cannot marshal the validation records"
+)
+
+// LabelHostnameVerification labels the records created for a
+// HostnameVerification with its name.
+const LabelHostnameVerification = "custom.cloudflare.crossplane.io/hostname-verification"
+
+// statusActive is the status of an active custom hostname and certificate.
+const statusActive = "active"
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.HostnameVerification_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.HostnameVerification_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.HostnameVerification{}).
+		Owns(&dnsv1alpha1.Record{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.HostnameVerification)
+	if !ok {
+		return nil, errors.New(errNotHostnameVerification)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{kube: c.kube, api: api, logger: c.logger, records: records.New(c.kube, LabelHostnameVerification)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+
+	records *records.Records
+
+	// desired are the records computed by Observe.
+	desired map[string]dnsv1alpha1.RecordParameters
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.HostnameVerification)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotHostnameVerification)
+	}
+	existing, err := e.records.Owned(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: len(existing) > 0}, nil
+	}
+
+	ch, err := e.customHostname(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	observe(cr, ch)
+	if e.desired, err = e.desiredRecords(ctx, cr, ch); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	conn, err := connectionDetails(ch)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := records.UpToDate(existing, e.desired)
+	cr.Status.AtProvider.Records = slices.Sorted(maps.Keys(e.desired))
+
+	s := cr.Status.AtProvider
+	if s.Status == statusActive && s.SSLStatus == statusActive {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("custom hostname is %s and its certificate is %s", s.Status, s.SSLStatus)))
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate, ConnectionDetails: conn}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.HostnameVerification)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotHostnameVerification)
+	}
+	return managed.ExternalCreation{}, e.records.Apply(ctx, cr, e.desired, nil)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.HostnameVerification)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotHostnameVerification)
+	}
+	return managed.ExternalUpdate{}, e.records.Apply(ctx, cr, e.desired, nil)
+}
+
+// Delete deletes the records created for the verification.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.HostnameVerification)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotHostnameVerification)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	e.desired = nil
+	return managed.ExternalDelete{}, e.records.Apply(ctx, cr, e.desired, nil)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// customHostname reads the custom hostname of the referenced Hostname.
+func (e *external) customHostname(ctx context.Context, cr *v1alpha1.HostnameVerification) (cloudflare.CustomHostname, error) {
+	name := cr.Spec.ForProvider.HostnameRef.Name
+	h := &v1alpha1.Hostname{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: name}, h); err != nil {
+		return cloudflare.CustomHostname{}, errors.Wrapf(err, errGetHostname, name)
+	}
+	id, zoneID := meta.GetExternalName(h), ptr.Deref(h.Spec.ForProvider.ZoneID, "")
+	if id == "" || id == h.GetName() || zoneID == "" {
+		return cloudflare.CustomHostname{}, errors.Errorf(errHostnameNotReady, name)
+	}
+	ch, err := e.api.CustomHostname(ctx, zoneID, id)
+	return ch, errors.Wrapf(err, errGetCustomHostname, id)
+}
+
+// desiredRecords returns the records to create for the verification records of
+// the given custom hostname, keyed by the names of their resources.
+// Records outside of the record zone are left to the owner of the hostname.
+func (e *external) desiredRecords(ctx context.Context, cr *v1alpha1.HostnameVerification, ch cloudflare.CustomHostname) (map[string]dnsv1alpha1.RecordParameters, error) {
+	out := map[string]dnsv1alpha1.RecordParameters{}
+	zoneID := ptr.Deref(cr.Spec.ForProvider.RecordZoneID, "")
+	if zoneID == "" {
+		return out, nil
+	}
+	z, err := e.api.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetZone, zoneID)
+	}
+	ttl := cr.Spec.ForProvider.RecordTTL
+	if ttl == 0 {
+		ttl = 1
+	}
+	add := func(typ, name, content string) {
+		name = strings.TrimSuffix(strings.ToLower(name), ".")
+		if name == "" || content == "" || (name != z.Name && !strings.HasSuffix(name, "."+z.Name)) {
+			return
+		}
+		// The certificate of an apex and wildcard hostname is validated
+		// through two TXT records of the same name.
+		key := typ + "/" + name
+		if typ == "TXT" {
+			key += "/" + content
+		}
+		out[records.Name(cr.GetName(), typ, key)] = dnsv1alpha1.RecordParameters{
+			ZoneID:  ptr.To(zoneID),
+			Name:    ptr.To(name),
+			Type:    ptr.To(typ),
+			Content: ptr.To(content),
+			TTL:     ptr.To(float64(ttl)),
+			Comment: ptr.To("Managed by HostnameVerification " + cr.GetName()),
+		}
+	}
+	if strings.EqualFold(ch.OwnershipVerification.Type, "txt") {
+		add("TXT", ch.OwnershipVerification.Name, ch.OwnershipVerification.Value)
+	}
+	if ch.SSL != nil {
+		for _, r := range ch.SSL.ValidationRecords {
+			add("TXT", r.TxtName, r.TxtValue)
+			add("CNAME", r.CnameName, r.CnameTarget)
+		}
+	}
+	return out, nil
+}
+
+// observe sets the status of the verification from the custom hostname.
+func observe(cr *v1alpha1.HostnameVerification, ch cloudflare.CustomHostname) {
+	s := &cr.Status.AtProvider
+	s.Hostname = ch.Hostname
+	s.Status = string(ch.Status)
+	s.VerificationErrors = ch.VerificationErrors
+	s.OwnershipVerification = nil
+	if ov := ch.OwnershipVerification; ov.Name != "" {
+		s.OwnershipVerification = &v1alpha1.OwnershipVerificationRecord{Type: ov.Type, Name: ov.Name, Value: ov.Value}
+	}
+	s.SSLStatus, s.ValidationRecords = "", nil
+	if ch.SSL != nil {
+		s.SSLStatus = ch.SSL.Status
+		for _, r := range ch.SSL.ValidationRecords {
+			s.ValidationRecords = append(s.ValidationRecords, v1alpha1.ValidationRecord{
+				TXTName:     r.TxtName,
+				TXTValue:    r.TxtValue,
+				CNAMEName:   r.CnameName,
+				CNAMETarget: r.CnameTarget,
+				HTTPURL:     r.HTTPUrl,
+				HTTPBody:    r.HTTPBody,
+				Emails:      r.Emails,
+			})
+		}
+	}
+}
+
+// connectionDetails returns the verification records of the custom
+// hostname, each field under its own key and the validation records as a
+// JSON list as well.
+func connectionDetails(ch cloudflare.CustomHostname) (managed.ConnectionDetails, error) {
+	conn := managed.ConnectionDetails{}
+	set := func(k, v string) {
+		if v != "" {
+			conn[k] = []byte(v)
+		}
+	}
+	set(custom.KeyOwnershipVerificationType, ch.OwnershipVerification.Type)
+	set(custom.KeyOwnershipVerificationName, ch.OwnershipVerification.Name)
+	set(custom.KeyOwnershipVerificationValue, ch.OwnershipVerification.Value)
+	set(custom.KeyOwnershipVerificationHTTPURL, ch.OwnershipVerificationHTTP.HTTPUrl)
+	set(custom.KeyOwnershipVerificationHTTPBody, ch.OwnershipVerificationHTTP.HTTPBody)
+	if ch.SSL == nil || len(ch.SSL.ValidationRecords) == 0 {
+		return conn, nil
+	}
+	for i, r := range ch.SSL.ValidationRecords {
+		set(fmt.Sprintf(custom.KeyValidationRecord, i, "txt_name"), r.TxtName)
+		set(fmt.Sprintf(custom.KeyValidationRecord, i, "txt_value"), r.TxtValue)
+		set(fmt.Sprintf(custom.KeyValidationRecord, i, "cname"), r.CnameName)
+		set(fmt.Sprintf(custom.KeyValidationRecord, i, "cname_target"), r.CnameTarget)
+		set(fmt.Sprintf(custom.KeyValidationRecord, i, "http_url"), r.HTTPUrl)
+		set(fmt.Sprintf(custom.KeyValidationRecord, i, "http_body"), r.HTTPBody)
+	}
+	b, err := json.Marshal(ch.SSL.ValidationRecords)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalRecords)
+	}
+	conn[custom.KeyValidationRecords] = b
+	return conn, nil
+}
@@ -0,0 +1,102 @@
+package hostnameverification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/custom/v1alpha1"
+)
+
+// record is the type, name and content of a desired record.
+type record struct {
+	Type    string
+	Name    string
+	Content string
+}
+
+func TestDesiredRecords(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/zone" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"zone","name":"example.com"}}`))
+	}))
+	defer srv.Close()
+	api, err := cloudflare.NewWithAPIToken("token", cloudflare.BaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		ch   cloudflare.CustomHostname
+		want []record
+	}{
+		"ApexAndWildcard": {
+			ch: cloudflare.CustomHostname{
+				OwnershipVerification: cloudflare.CustomHostnameOwnershipVerification{
+					Type: "txt", Name: "_cf-custom-hostname.shop.example.com", Value: "ownership",
+				},
+				SSL: &cloudflare.CustomHostnameSSL{ValidationRecords: []cloudflare.SSLValidationRecord{
+					{TxtName: "_acme-challenge.shop.example.com", TxtValue: "apex"},
+					{TxtName: "_acme-challenge.shop.example.com", TxtValue: "wildcard"},
+				}},
+			},
+			want: []record{
+				{Type: "TXT", Name: "_acme-challenge.shop.example.com", Content: "apex"},
+				{Type: "TXT", Name: "_acme-challenge.shop.example.com", Content: "wildcard"},
+				{Type: "TXT", Name: "_cf-custom-hostname.shop.example.com", Content: "ownership"},
+			},
+		},
+		"CNAME": {
+			ch: cloudflare.CustomHostname{
+				SSL: &cloudflare.CustomHostnameSSL{ValidationRecords: []cloudflare.SSLValidationRecord{
+					{CnameName: "_acme-challenge.shop.example.com.", CnameTarget: "shop.example.com.dcv.cloudflare.com"},
+				}},
+			},
+			want: []record{
+				{Type: "CNAME", Name: "_acme-challenge.shop.example.com", Content: "shop.example.com.dcv.cloudflare.com"},
+			},
+		},
+		"OutsideOfZone": {
+			ch: cloudflare.CustomHostname{
+				SSL: &cloudflare.CustomHostnameSSL{ValidationRecords: []cloudflare.SSLValidationRecord{
+					{TxtName: "_acme-challenge.shop.example.org", TxtValue: "apex"},
+					{TxtName: "_acme-challenge.notexample.com", TxtValue: "apex"},
+				}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.HostnameVerification{ObjectMeta: metav1.ObjectMeta{Name: "shop"}}
+			cr.Spec.ForProvider.RecordZoneID = ptr.To("zone")
+			e := &external{api: api}
+
+			desired, err := e.desiredRecords(context.Background(), cr, tc.ch)
+			if err != nil {
+				t.Fatalf("desiredRecords(...): %v", err)
+			}
+			var got []record
+			for _, p := range desired {
+				got = append(got, record{Type: *p.Type, Name: *p.Name, Content: *p.Content})
+			}
+			slices.SortFunc(got, func(a, b record) int {
+				if a.Name+a.Content < b.Name+b.Content {
+					return -1
+				}
+				return 1
+			})
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("desiredRecords(...): got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
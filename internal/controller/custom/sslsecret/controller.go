@@ -0,0 +1,310 @@
+package sslsecret
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/custom/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+)
+
+const (
+	errNotSSLSecret   = "managed resource is not an SSLSecret custom resource"
+	errNoZoneID       = "zoneId is not set"
+	errGetSecret      = "cannot get Secret %s/%s"
+	errSecretKeys     = "Secret %s/%s has no %s"
+	errParseCert      = "cannot parse the certificate of Secret %s/%s"
+	errGetCertificate = "cannot get custom certificate %s"
+	errCreateCert     = "cannot upload custom certificate"
+	errUpdateCert     = "cannot upload custom certificate %s"
+	errDeleteCert     = "cannot delete custom certificate %s"
+	errIndexSecretRef = "cannot index SSLSecrets by Secret"
+	errListSSLSecrets = "cannot list the SSLSecrets of a Secret"
+)
+
+// statusActive is the status of a custom certificate in use.
+const statusActive = "active"
+
+// secretRefIndexName is the name of the index of SSLSecrets by the
+// namespace and name of their Secret.
+const secretRefIndexName = "spec.forProvider.secretRef"
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.SSLSecret_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SSLSecret_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	// SSLSecrets are indexed by their Secret, so that a renewed Secret
+	// is uploaded without waiting for the poll interval.
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.SSLSecret{}, secretRefIndexName, func(o client.Object) []string {
+		ref := o.(*v1alpha1.SSLSecret).Spec.ForProvider.SecretRef
+		return []string{ref.Namespace + "/" + ref.Name}
+	}); err != nil {
+		return errors.Wrap(err, errIndexSecretRef)
+	}
+	dependents := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, s client.Object) []reconcile.Request {
+		l := &v1alpha1.SSLSecretList{}
+		if err := mgr.GetClient().List(ctx, l, client.MatchingFields{secretRefIndexName: s.GetNamespace() + "/" + s.GetName()}); err != nil {
+			o.Logger.Info(errListSSLSecrets, "error", err)
+			return nil
+		}
+		reqs := make([]reconcile.Request, len(l.Items))
+		for i := range l.Items {
+			reqs[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: l.Items[i].GetName()}}
+		}
+		return reqs
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.SSLSecret{}).
+		Watches(&corev1.Secret{}, dependents).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SSLSecret)
+	if !ok {
+		return nil, errors.New(errNotSSLSecret)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{kube: c.kube, api: api, logger: c.logger}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+}
+
+// pair is the certificate chain and private key of a Secret.
+type pair struct {
+	chain string
+	key   string
+	leaf  *x509.Certificate
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SSLSecret)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSSLSecret)
+	}
+	zoneID := ptr.Deref(cr.Spec.ForProvider.ZoneID, "")
+	if zoneID == "" {
+		return managed.ExternalObservation{}, errors.New(errNoZoneID)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cert, err := e.api.SSLDetails(ctx, zoneID, id)
+	if isNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetCertificate, id)
+	}
+	s := &cr.Status.AtProvider
+	s.CertificateID = cert.ID
+	s.Hosts = cert.Hosts
+	s.Issuer = cert.Issuer
+	s.ExpiresOn = toTime(cert.ExpiresOn)
+	s.Status = cert.Status
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	p, err := e.pair(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// The API does not return the uploaded certificate, so it is matched
+	// with the one of the Secret by expiry, which a renewal always moves.
+	upToDate := cert.ExpiresOn.Truncate(time.Second).Equal(p.leaf.NotAfter.Truncate(time.Second)) &&
+		cert.BundleMethod == cr.Spec.ForProvider.BundleMethod &&
+		geoRestrictions(cert.GeoRestrictions) == ptr.Deref(cr.Spec.ForProvider.GeoRestrictions, "")
+	if upToDate {
+		s.SerialNumber = serialNumber(p.leaf)
+	}
+
+	if cert.Status == statusActive {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("custom certificate is %s", cert.Status)))
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SSLSecret)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSSLSecret)
+	}
+	cr.SetConditions(xpv1.Creating())
+	p, err := e.pair(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	opts := options(cr, p)
+	opts.Type = cr.Spec.ForProvider.Type
+	cert, err := e.api.CreateSSL(ctx, ptr.Deref(cr.Spec.ForProvider.ZoneID, ""), opts)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCert)
+	}
+	meta.SetExternalName(cr, cert.ID)
+	uploaded(cr, p)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SSLSecret)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSSLSecret)
+	}
+	p, err := e.pair(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	id := meta.GetExternalName(cr)
+	if _, err := e.api.UpdateSSL(ctx, ptr.Deref(cr.Spec.ForProvider.ZoneID, ""), id, options(cr, p)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateCert, id)
+	}
+	uploaded(cr, p)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.SSLSecret)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSSLSecret)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	id := meta.GetExternalName(cr)
+	if err := e.api.DeleteSSL(ctx, ptr.Deref(cr.Spec.ForProvider.ZoneID, ""), id); err != nil && !isNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrapf(err, errDeleteCert, id)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// pair reads the certificate chain and private key of the Secret.
+func (e *external) pair(ctx context.Context, cr *v1alpha1.SSLSecret) (*pair, error) {
+	ref := cr.Spec.ForProvider.SecretRef
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, errors.Wrapf(err, errGetSecret, ref.Namespace, ref.Name)
+	}
+	for _, k := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if len(s.Data[k]) == 0 {
+			return nil, errors.Errorf(errSecretKeys, ref.Namespace, ref.Name, k)
+		}
+	}
+	b, _ := pem.Decode(s.Data[corev1.TLSCertKey])
+	if b == nil {
+		return nil, errors.Errorf(errParseCert, ref.Namespace, ref.Name)
+	}
+	leaf, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, errParseCert, ref.Namespace, ref.Name)
+	}
+	return &pair{
+		chain: string(s.Data[corev1.TLSCertKey]),
+		key:   string(s.Data[corev1.TLSPrivateKeyKey]),
+		leaf:  leaf,
+	}, nil
+}
+
+func options(cr *v1alpha1.SSLSecret, p *pair) cloudflare.ZoneCustomSSLOptions {
+	opts := cloudflare.ZoneCustomSSLOptions{
+		Certificate:  p.chain,
+		PrivateKey:   p.key,
+		BundleMethod: cr.Spec.ForProvider.BundleMethod,
+	}
+	if g := cr.Spec.ForProvider.GeoRestrictions; g != nil {
+		opts.GeoRestrictions = &cloudflare.ZoneCustomSSLGeoRestrictions{Label: *g}
+	}
+	return opts
+}
+
+// uploaded records the certificate of the given pair as uploaded.
+func uploaded(cr *v1alpha1.SSLSecret, p *pair) {
+	s := &cr.Status.AtProvider
+	s.SerialNumber = serialNumber(p.leaf)
+	s.ExpiresOn = toTime(p.leaf.NotAfter)
+	s.LastUploadTime = toTime(time.Now())
+}
+
+func geoRestrictions(g *cloudflare.ZoneCustomSSLGeoRestrictions) string {
+	if g == nil {
+		return ""
+	}
+	return g.Label
+}
+
+func serialNumber(c *x509.Certificate) string {
+	return fmt.Sprintf("%x", c.SerialNumber)
+}
+
+func isNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
+
+func toTime(t time.Time) *metav1.Time {
+	mt := metav1.NewTime(t.UTC().Truncate(time.Second))
+	return &mt
+}
@@ -5,12 +5,40 @@ import (
 
 	"github.com/crossplane/upjet/v2/pkg/controller"
 
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/cloudflare/clusteriplist"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/cloudflare/listcontents"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/cloudflare/managedrulesetdeployment"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/cloudflare/rulesetrule"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/cloudflare/zonedelegation"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/custom/hostnameverification"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/custom/sslsecret"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/d1/d1migration"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/logpush/ownershiptoken"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/origin/cacertificatesecret"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/r2/bucketcontent"
 	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/r2/credentials"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/workers/kvdataset"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/workers/workerrollout"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/controller/zone/dnssecdelegation"
 )
 
 func SetupCustomControllers(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		credentials.Setup,
+		d1migration.Setup,
+		workerrollout.Setup,
+		kvdataset.Setup,
+		bucketcontent.Setup,
+		rulesetrule.Setup,
+		managedrulesetdeployment.Setup,
+		listcontents.Setup,
+		clusteriplist.Setup,
+		cacertificatesecret.Setup,
+		sslsecret.Setup,
+		hostnameverification.Setup,
+		zonedelegation.Setup,
+		dnssecdelegation.Setup,
+		ownershiptoken.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -22,6 +50,20 @@ func SetupCustomControllers(mgr ctrl.Manager, o controller.Options) error {
 func SetupCustomControllersGated(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		credentials.SetupGated,
+		d1migration.SetupGated,
+		workerrollout.SetupGated,
+		kvdataset.SetupGated,
+		bucketcontent.SetupGated,
+		rulesetrule.SetupGated,
+		managedrulesetdeployment.SetupGated,
+		listcontents.SetupGated,
+		clusteriplist.SetupGated,
+		cacertificatesecret.SetupGated,
+		sslsecret.SetupGated,
+		hostnameverification.SetupGated,
+		zonedelegation.SetupGated,
+		dnssecdelegation.SetupGated,
+		ownershiptoken.SetupGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,308 @@
+package d1migration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/d1/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+)
+
+const (
+	errNotD1Migration = "managed resource is not a D1Migration custom resource"
+	errNoDatabaseID   = "databaseId is not set"
+	errGetConfigMap   = "cannot get the ConfigMap with the migrations"
+	errCreateTable    = "cannot create the migrations table"
+	errListApplied    = "cannot list the applied migrations"
+	errApplyMigration = "cannot apply migration %s"
+)
+
+const defaultMigrationsTable = "d1_migrations"
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.D1Migration_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.D1Migration_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.D1Migration{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.D1Migration)
+	if !ok {
+		return nil, errors.New(errNotD1Migration)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:   c.kube,
+		api:    api,
+		logger: c.logger,
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+}
+
+// migration is an SQL migration read from the ConfigMap.
+type migration struct {
+	name string
+	sql  string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.D1Migration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotD1Migration)
+	}
+	if meta.WasDeleted(cr) {
+		// There is no external resource to wait for.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	migrations, err := e.migrations(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	applied, err := e.applied(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	pending := pendingMigrations(migrations, applied)
+
+	s := &cr.Status.AtProvider
+	s.Applied = len(applied)
+	s.Head = ""
+	if len(applied) > 0 {
+		s.Head = applied[len(applied)-1]
+	}
+	s.Pending = nil
+	for _, m := range pending {
+		s.Pending = append(s.Pending, m.name)
+	}
+
+	switch {
+	case len(pending) == 0:
+		s.FailedMigration, s.Error = "", ""
+		cr.SetConditions(xpv1.Available())
+	case s.FailedMigration == pending[0].name:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("migration %s failed: %s", s.FailedMigration, s.Error)))
+	default:
+		cr.SetConditions(xpv1.Creating().WithMessage(fmt.Sprintf("%d migrations pending", len(pending))))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(pending) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update creates the migrations table if needed and applies the pending
+// migrations in order, each in a query that also records it in the
+// migrations table. D1 runs the statements of a query in order but not in a
+// transaction, so a migration failing part-way is left partly applied and
+// unrecorded. Update stops at the first failed migration.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.D1Migration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotD1Migration)
+	}
+
+	migrations, err := e.migrations(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	applied, err := e.applied(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	pending := pendingMigrations(migrations, applied)
+	if len(pending) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	table := migrationsTable(cr)
+	create := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT UNIQUE, applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL);", table)
+	if _, err := e.query(ctx, cr, create); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTable)
+	}
+
+	s := &cr.Status.AtProvider
+	for _, m := range pending {
+		sql := strings.TrimRight(strings.TrimSpace(m.sql), ";") +
+			fmt.Sprintf(";\nINSERT INTO %s (name) VALUES (%s);", table, quote(m.name))
+		if _, err := e.query(ctx, cr, sql); err != nil {
+			s.FailedMigration, s.Error = m.name, err.Error()
+			cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("migration %s failed: %s", m.name, err)))
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errApplyMigration, m.name)
+		}
+		s.Head = m.name
+		s.Applied++
+		s.Pending = slices.DeleteFunc(s.Pending, func(p string) bool { return p == m.name })
+	}
+	s.FailedMigration, s.Error = "", ""
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete leaves the schema of the database as it is.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// migrations returns the migrations of the ConfigMap in lexical order.
+func (e *external) migrations(ctx context.Context, cr *v1alpha1.D1Migration) ([]migration, error) {
+	ref := cr.Spec.ForProvider.ConfigMapRef
+	cm := &corev1.ConfigMap{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return nil, errors.Wrap(err, errGetConfigMap)
+	}
+	var out []migration
+	for k, v := range cm.Data {
+		if strings.HasSuffix(k, ".sql") {
+			out = append(out, migration{name: k, sql: v})
+		}
+	}
+	for k, v := range cm.BinaryData {
+		if strings.HasSuffix(k, ".sql") {
+			out = append(out, migration{name: k, sql: string(v)})
+		}
+	}
+	slices.SortFunc(out, func(a, b migration) int { return strings.Compare(a.name, b.name) })
+	return out, nil
+}
+
+// applied returns the names of the applied migrations in the order they
+// were applied. There are none before the migrations table is created.
+func (e *external) applied(ctx context.Context, cr *v1alpha1.D1Migration) ([]string, error) {
+	table := migrationsTable(cr)
+	res, err := e.query(ctx, cr, fmt.Sprintf("SELECT name FROM sqlite_master WHERE type = 'table' AND name = %s;", quote(table)))
+	if err != nil {
+		return nil, errors.Wrap(err, errListApplied)
+	}
+	if !hasRows(res) {
+		return nil, nil
+	}
+	res, err = e.query(ctx, cr, fmt.Sprintf("SELECT name FROM %s ORDER BY id;", table))
+	if err != nil {
+		return nil, errors.Wrap(err, errListApplied)
+	}
+	var names []string
+	for _, r := range res {
+		for _, row := range r.Results {
+			if n, ok := row["name"].(string); ok {
+				names = append(names, n)
+			}
+		}
+	}
+	return names, nil
+}
+
+func (e *external) query(ctx context.Context, cr *v1alpha1.D1Migration, sql string) ([]cloudflare.D1Result, error) {
+	id := ptr.Deref(cr.Spec.ForProvider.DatabaseID, "")
+	if id == "" {
+		return nil, errors.New(errNoDatabaseID)
+	}
+	return e.api.QueryD1Database(ctx, cloudflare.AccountIdentifier(cr.Spec.ForProvider.AccountID), cloudflare.QueryD1DatabaseParams{
+		DatabaseID: id,
+		SQL:        sql,
+	})
+}
+
+func hasRows(res []cloudflare.D1Result) bool {
+	for _, r := range res {
+		if len(r.Results) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingMigrations returns the migrations that are not applied yet. As with
+// wrangler, applied migrations may be removed from the ConfigMap.
+func pendingMigrations(migrations []migration, applied []string) []migration {
+	done := make(map[string]bool, len(applied))
+	for _, a := range applied {
+		done[a] = true
+	}
+	var pending []migration
+	for _, m := range migrations {
+		if !done[m.name] {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+func migrationsTable(cr *v1alpha1.D1Migration) string {
+	if t := cr.Spec.ForProvider.MigrationsTable; t != "" {
+		return t
+	}
+	return defaultMigrationsTable
+}
+
+// quote renders the given string as an SQL string literal.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,376 @@
+package ownershiptoken
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/logpush/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/r2"
+)
+
+const (
+	errNotOwnershipToken   = "managed resource is not an OwnershipToken custom resource"
+	errParseDestination    = "cannot parse destinationConf"
+	errUnsupportedScheme   = "destinations of scheme %q are not supported, only r2 and s3"
+	errNoCredentials       = "destinationConf has no access keys, set credentialsSecretRef"
+	errNoScope             = "exactly one of accountId and zoneId must be set"
+	errGetChallenge        = "cannot get OwnershipChallenge %s"
+	errNotOwned            = "OwnershipChallenge %s exists and is not controlled by this token"
+	errApplyChallenge      = "cannot apply OwnershipChallenge %s"
+	errDeleteChallenge     = "cannot delete OwnershipChallenge %s"
+	errReadChallengeFile   = "cannot read challenge file %s from bucket %s"
+	errEmptyChallengeFile  = "challenge file %s of bucket %s is empty"
+	errDeleteChallengeFile = "cannot delete challenge file %s from bucket %s"
+	errValidateToken       = "cannot validate the ownership challenge token"
+)
+
+// LabelOwnershipToken labels the OwnershipChallenge created for an
+// OwnershipToken with its name.
+const LabelOwnershipToken = "logpush.cloudflare.crossplane.io/ownership-token"
+
+// keyOwnershipChallenge is the connection detail key of the token.
+const keyOwnershipChallenge = "ownership_challenge"
+
+// The query parameters of a destination.
+const (
+	queryAccountID       = "account-id"
+	queryJurisdiction    = "jurisdiction"
+	queryAccessKeyID     = "access-key-id"
+	querySecretAccessKey = "secret-access-key"
+	queryRegion          = "region"
+	queryEndpoint        = "endpoint"
+)
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.OwnershipToken_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OwnershipToken_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.OwnershipToken{}).
+		Owns(&v1alpha1.OwnershipChallenge{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OwnershipToken)
+	if !ok {
+		return nil, errors.New(errNotOwnershipToken)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+	bucket, s3creds, err := c.bucketCredentials(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	s3, err := r2.NewClient(s3creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{kube: c.kube, api: api, s3: s3, bucket: bucket, logger: c.logger}, nil
+}
+
+// bucketCredentials returns the bucket of the destination and the
+// credentials to read it with.
+func (c *connector) bucketCredentials(ctx context.Context, cr *v1alpha1.OwnershipToken) (string, r2.Credentials, error) {
+	p := cr.Spec.ForProvider
+	u, err := url.Parse(p.DestinationConf)
+	if err != nil {
+		return "", r2.Credentials{}, errors.Wrap(err, errParseDestination)
+	}
+	q := u.Query()
+	creds := r2.Credentials{
+		AccessKeyID:     q.Get(queryAccessKeyID),
+		SecretAccessKey: q.Get(querySecretAccessKey),
+	}
+	switch u.Scheme {
+	case "r2":
+		creds.Endpoint = r2.Endpoint(q.Get(queryAccountID), q.Get(queryJurisdiction))
+	case "s3":
+		creds.Region = q.Get(queryRegion)
+		creds.Endpoint = q.Get(queryEndpoint)
+		switch {
+		case creds.Endpoint != "":
+		case creds.Region != "":
+			creds.Endpoint = "https://s3." + creds.Region + ".amazonaws.com"
+		default:
+			creds.Endpoint = "https://s3.amazonaws.com"
+		}
+		if !strings.Contains(creds.Endpoint, "://") {
+			creds.Endpoint = "https://" + creds.Endpoint
+		}
+	default:
+		return "", r2.Credentials{}, errors.Errorf(errUnsupportedScheme, u.Scheme)
+	}
+	if ep := ptr.Deref(p.Endpoint, ""); ep != "" {
+		creds.Endpoint = ep
+	}
+
+	switch {
+	case p.CredentialsSecretRef != nil:
+		ref := r2.SecretReference{Name: p.CredentialsSecretRef.Name, Namespace: p.CredentialsSecretRef.Namespace}
+		sc, err := r2.CredentialsFromSecret(ctx, c.kube, ref, creds.Endpoint)
+		if err != nil {
+			return "", r2.Credentials{}, err
+		}
+		sc.Region = creds.Region
+		if ep := ptr.Deref(p.Endpoint, ""); ep != "" {
+			sc.Endpoint = ep
+		}
+		creds = sc
+	case creds.AccessKeyID != "" && creds.SecretAccessKey != "":
+	case u.Scheme == "r2":
+		if creds, err = clients.R2Credentials(ctx, c.kube, cr, creds.Endpoint); err != nil {
+			return "", r2.Credentials{}, err
+		}
+	default:
+		return "", r2.Credentials{}, errors.New(errNoCredentials)
+	}
+	return u.Host, creds, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	s3     *minio.Client
+	bucket string
+	logger logging.Logger
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OwnershipToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOwnershipToken)
+	}
+	ch, err := e.challenge(ctx, cr)
+	if err != nil || ch == nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	s := &cr.Status.AtProvider
+	s.OwnershipChallenge = ch.GetName()
+	s.Filename = ptr.Deref(ch.Status.AtProvider.Filename, "")
+	upToDate := equal(ch.Spec.ForProvider, parameters(cr))
+	if s.Filename == "" {
+		s.Valid = false
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("waiting for OwnershipChallenge %s to request the challenge", ch.GetName())))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+	}
+
+	// A challenge file that cannot be read is an error rather than an
+	// empty token, so that a token published before is kept.
+	token, err := e.read(ctx, s.Filename)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	rc, err := container(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	s.Valid, err = e.api.ValidateLogpushOwnershipChallenge(ctx, rc, cloudflare.ValidateLogpushOwnershipChallengeParams{
+		DestinationConf:    cr.Spec.ForProvider.DestinationConf,
+		OwnershipChallenge: token,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errValidateToken)
+	}
+
+	if s.Valid {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("Cloudflare does not accept the token of challenge file %s", s.Filename)))
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{keyOwnershipChallenge: []byte(token)},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OwnershipToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOwnershipToken)
+	}
+	return managed.ExternalCreation{}, e.apply(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OwnershipToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOwnershipToken)
+	}
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+// Delete deletes the challenge file from the bucket and the
+// OwnershipChallenge.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.OwnershipToken)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotOwnershipToken)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	ch, err := e.challenge(ctx, cr)
+	if err != nil || ch == nil {
+		return managed.ExternalDelete{}, err
+	}
+	if f := ptr.Deref(ch.Status.AtProvider.Filename, ""); f != "" {
+		if err := e.s3.RemoveObject(ctx, e.bucket, key(f), minio.RemoveObjectOptions{}); err != nil {
+			return managed.ExternalDelete{}, errors.Wrapf(err, errDeleteChallengeFile, f, e.bucket)
+		}
+	}
+	err = e.kube.Delete(ctx, ch)
+	return managed.ExternalDelete{}, errors.Wrapf(client.IgnoreNotFound(err), errDeleteChallenge, ch.GetName())
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// challenge returns the OwnershipChallenge of the token, or nil if there is
+// none.
+func (e *external) challenge(ctx context.Context, cr *v1alpha1.OwnershipToken) (*v1alpha1.OwnershipChallenge, error) {
+	ch := &v1alpha1.OwnershipChallenge{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: cr.GetName()}, ch); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, errGetChallenge, cr.GetName())
+	}
+	if !metav1.IsControlledBy(ch, cr) {
+		return nil, errors.Errorf(errNotOwned, cr.GetName())
+	}
+	return ch, nil
+}
+
+// apply creates or updates the OwnershipChallenge of the token, which
+// requests the challenge for the destination.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.OwnershipToken) error {
+	if _, err := container(cr); err != nil {
+		return err
+	}
+	ch := &v1alpha1.OwnershipChallenge{}
+	ch.SetName(cr.GetName())
+	_, err := controllerutil.CreateOrUpdate(ctx, e.kube, ch, func() error {
+		if ch.GetUID() != "" && !metav1.IsControlledBy(ch, cr) {
+			return errors.Errorf(errNotOwned, ch.GetName())
+		}
+		meta.AddLabels(ch, map[string]string{LabelOwnershipToken: cr.GetName()})
+		ch.Spec.ForProvider = parameters(cr)
+		ch.Spec.ProviderConfigReference = cr.GetProviderConfigReference()
+		ch.Spec.ManagementPolicies = cr.GetManagementPolicies()
+		ch.Spec.DeletionPolicy = cr.GetDeletionPolicy()
+		return controllerutil.SetControllerReference(cr, ch, e.kube.Scheme())
+	})
+	return errors.Wrapf(err, errApplyChallenge, ch.GetName())
+}
+
+// read returns the token of the given challenge file.
+func (e *external) read(ctx context.Context, filename string) (string, error) {
+	o, err := e.s3.GetObject(ctx, e.bucket, key(filename), minio.GetObjectOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, errReadChallengeFile, filename, e.bucket)
+	}
+	defer o.Close() //nolint:errcheck // Only read from.
+	b, err := io.ReadAll(o)
+	if err != nil {
+		return "", errors.Wrapf(err, errReadChallengeFile, filename, e.bucket)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", errors.Errorf(errEmptyChallengeFile, filename, e.bucket)
+	}
+	return token, nil
+}
+
+// parameters returns the parameters of the OwnershipChallenge of the
+// token.
+func parameters(cr *v1alpha1.OwnershipToken) v1alpha1.OwnershipChallengeParameters {
+	p := cr.Spec.ForProvider
+	return v1alpha1.OwnershipChallengeParameters{
+		AccountID:       p.AccountID,
+		ZoneID:          p.ZoneID,
+		DestinationConf: ptr.To(p.DestinationConf),
+	}
+}
+
+// container returns the account or zone the token is requested for.
+func container(cr *v1alpha1.OwnershipToken) (*cloudflare.ResourceContainer, error) {
+	account, zone := ptr.Deref(cr.Spec.ForProvider.AccountID, ""), ptr.Deref(cr.Spec.ForProvider.ZoneID, "")
+	switch {
+	case account != "" && zone == "":
+		return cloudflare.AccountIdentifier(account), nil
+	case zone != "" && account == "":
+		return cloudflare.ZoneIdentifier(zone), nil
+	}
+	return nil, errors.New(errNoScope)
+}
+
+// key returns the object key of a challenge file, whose name is relative to
+// the bucket.
+func key(filename string) string {
+	return strings.TrimPrefix(filename, "/")
+}
+
+func equal(a, b v1alpha1.OwnershipChallengeParameters) bool {
+	return ptr.Deref(a.AccountID, "") == ptr.Deref(b.AccountID, "") &&
+		ptr.Deref(a.ZoneID, "") == ptr.Deref(b.ZoneID, "") &&
+		ptr.Deref(a.DestinationConf, "") == ptr.Deref(b.DestinationConf, "")
+}
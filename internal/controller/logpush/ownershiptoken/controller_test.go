@@ -0,0 +1,170 @@
+package ownershiptoken
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/logpush/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/r2"
+)
+
+// fakeS3 is a local stand-in for the bucket of a Logpush destination that
+// serves the objects it holds, keyed by bucket and key.
+type fakeS3 map[string]string
+
+func (s fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	content, ok := s[strings.TrimPrefix(r.URL.Path, "/")]
+	if r.Method != http.MethodGet || !ok {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.Header().Set("Last-Modified", time.Unix(0, 0).UTC().Format(http.TimeFormat))
+	w.Header().Set("ETag", `"etag"`)
+	_, _ = w.Write([]byte(content))
+}
+
+func TestBucketCredentials(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "r2-credentials", Namespace: "crossplane-system"},
+		Data: map[string][]byte{
+			r2.KeyAccessKeyID:     []byte("secret-id"),
+			r2.KeySecretAccessKey: []byte("secret-key"),
+		},
+	}
+
+	cases := map[string]struct {
+		destination string
+		secretRef   *xpv1.SecretReference
+		endpoint    *string
+		bucket      string
+		want        r2.Credentials
+		wantErr     bool
+	}{
+		"R2InlineKeys": {
+			destination: "r2://logs/http/{DATE}?account-id=account&access-key-id=id&secret-access-key=key",
+			bucket:      "logs",
+			want:        r2.Credentials{AccessKeyID: "id", SecretAccessKey: "key", Endpoint: "https://account.r2.cloudflarestorage.com"},
+		},
+		"R2Jurisdiction": {
+			destination: "r2://logs?account-id=account&jurisdiction=eu&access-key-id=id&secret-access-key=key",
+			bucket:      "logs",
+			want:        r2.Credentials{AccessKeyID: "id", SecretAccessKey: "key", Endpoint: "https://account.eu.r2.cloudflarestorage.com"},
+		},
+		"S3Region": {
+			destination: "s3://logs/http?region=us-east-2&access-key-id=id&secret-access-key=key",
+			bucket:      "logs",
+			want:        r2.Credentials{AccessKeyID: "id", SecretAccessKey: "key", Endpoint: "https://s3.us-east-2.amazonaws.com", Region: "us-east-2"},
+		},
+		"S3NoRegion": {
+			destination: "s3://logs?access-key-id=id&secret-access-key=key",
+			bucket:      "logs",
+			want:        r2.Credentials{AccessKeyID: "id", SecretAccessKey: "key", Endpoint: "https://s3.amazonaws.com"},
+		},
+		"S3EndpointWithoutScheme": {
+			destination: "s3://logs?region=auto&endpoint=storage.example.com&access-key-id=id&secret-access-key=key",
+			bucket:      "logs",
+			want:        r2.Credentials{AccessKeyID: "id", SecretAccessKey: "key", Endpoint: "https://storage.example.com", Region: "auto"},
+		},
+		"EndpointOverride": {
+			destination: "s3://logs?region=us-east-2&access-key-id=id&secret-access-key=key",
+			endpoint:    ptr.To("http://127.0.0.1:9000"),
+			bucket:      "logs",
+			want:        r2.Credentials{AccessKeyID: "id", SecretAccessKey: "key", Endpoint: "http://127.0.0.1:9000", Region: "us-east-2"},
+		},
+		"CredentialsSecret": {
+			destination: "s3://logs?region=us-east-2&access-key-id=id&secret-access-key=key",
+			secretRef:   &xpv1.SecretReference{Name: "r2-credentials", Namespace: "crossplane-system"},
+			bucket:      "logs",
+			want:        r2.Credentials{AccessKeyID: "secret-id", SecretAccessKey: "secret-key", Endpoint: "https://s3.us-east-2.amazonaws.com", Region: "us-east-2"},
+		},
+		"S3NoKeys": {
+			destination: "s3://logs?region=us-east-2",
+			wantErr:     true,
+		},
+		"UnsupportedScheme": {
+			destination: "gs://logs?access-key-id=id&secret-access-key=key",
+			wantErr:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.OwnershipToken{}
+			cr.Spec.ForProvider.DestinationConf = tc.destination
+			cr.Spec.ForProvider.CredentialsSecretRef = tc.secretRef
+			cr.Spec.ForProvider.Endpoint = tc.endpoint
+			c := &connector{kube: fake.NewClientBuilder().WithObjects(secret).Build()}
+
+			bucket, got, err := c.bucketCredentials(context.Background(), cr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("bucketCredentials(...): error %v, want error %t", err, tc.wantErr)
+			}
+			if bucket != tc.bucket {
+				t.Errorf("bucketCredentials(...): bucket %q, want %q", bucket, tc.bucket)
+			}
+			if got != tc.want {
+				t.Errorf("bucketCredentials(...): got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	s3 := fakeS3{
+		"logs/ownership-challenge-1.txt": "token\n",
+		"logs/ownership-challenge-2.txt": " \n",
+	}
+	srv := httptest.NewServer(s3)
+	defer srv.Close()
+	mc, err := r2.NewClient(r2.Credentials{AccessKeyID: "id", SecretAccessKey: "secret", Endpoint: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &external{s3: mc, bucket: "logs"}
+
+	cases := map[string]struct {
+		filename string
+		want     string
+		wantErr  bool
+	}{
+		"Token": {
+			filename: "ownership-challenge-1.txt",
+			want:     "token",
+		},
+		"LeadingSlash": {
+			filename: "/ownership-challenge-1.txt",
+			want:     "token",
+		},
+		"EmptyFile": {
+			filename: "ownership-challenge-2.txt",
+			wantErr:  true,
+		},
+		"MissingFile": {
+			filename: "ownership-challenge-3.txt",
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := e.read(context.Background(), tc.filename)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("read(...): error %v, want error %t", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("read(...): got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
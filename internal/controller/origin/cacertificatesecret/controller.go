@@ -0,0 +1,460 @@
+package cacertificatesecret
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/origin/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+)
+
+const (
+	errNotCACertificateSecret = "managed resource is not a CACertificateSecret custom resource"
+	errGetCertificate         = "cannot get certificate %s"
+	errParseCertificate       = "cannot parse certificate %s"
+	errGenerateKey            = "cannot generate private key"
+	errCreateCSR              = "cannot create certificate signing request"
+	errCreateCertificate      = "cannot create certificate"
+	errRevokeCertificate      = "cannot revoke certificate %s"
+	errGetRoot                = "cannot get the Origin CA root certificate"
+	errGetSecret              = "cannot get Secret %s/%s"
+	errSecretType             = "Secret %s/%s is of type %s, not kubernetes.io/tls"
+	errWriteSecret            = "cannot write Secret %s/%s"
+	errDeleteSecret           = "cannot delete Secret %s/%s"
+	errPersistExternalName    = "cannot persist the certificate ID as external name"
+)
+
+// The key types of the generated private keys.
+const (
+	keyTypeECDSA = "ecdsa"
+	keyTypeRSA   = "rsa"
+)
+
+// The keys of a kubernetes.io/tls Secret.
+const (
+	keyTLSKey = corev1.TLSPrivateKeyKey
+	keyTLSCrt = corev1.TLSCertKey
+	keyCACrt  = "ca.crt"
+)
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.CACertificateSecret_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CACertificateSecret_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.CACertificateSecret{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CACertificateSecret)
+	if !ok {
+		return nil, errors.New(errNotCACertificateSecret)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{kube: c.kube, api: api, logger: c.logger}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+
+	// cert is the current certificate read by Observe.
+	cert *cloudflare.OriginCACertificate
+	// key is the private key of the current certificate read from the
+	// Secret by Observe, if the Secret still holds it.
+	key crypto.Signer
+	// reissue is whether Observe found that the current certificate has to
+	// be replaced rather than written to the Secret again.
+	reissue bool
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CACertificateSecret)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCACertificateSecret)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cert, err := e.api.GetOriginCACertificate(ctx, id)
+	if isNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetCertificate, id)
+	}
+	if !cert.RevokedAt.IsZero() {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	x, err := parseCertificate([]byte(cert.Certificate))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errParseCertificate, id)
+	}
+	e.cert = cert
+
+	p := cr.Spec.ForProvider
+	renewsOn := cert.ExpiresOn.Add(-renewBefore(p))
+	s := &cr.Status.AtProvider
+	s.CertificateID = cert.ID
+	s.SerialNumber = fmt.Sprintf("%x", x.SerialNumber)
+	s.Hostnames = cert.Hostnames
+	s.ExpiresOn = toTime(cert.ExpiresOn)
+	s.RenewsOn = toTime(renewsOn)
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	e.key, err = e.secretKey(ctx, p.SecretRef, x)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	e.reissue = e.key == nil ||
+		!time.Now().Before(renewsOn) ||
+		!sameHostnames(cert.Hostnames, p.Hostnames) ||
+		cert.RequestType != requestType(p.KeyType) ||
+		cert.RequestValidity != validityDays(p)
+	if e.reissue {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+	current, err := e.secretCurrent(ctx, p.SecretRef, cert)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: current}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CACertificateSecret)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCACertificateSecret)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.issue(ctx, cr)
+	return managed.ExternalCreation{}, err
+}
+
+// Update replaces the certificate if Observe found it has to be, and writes
+// the current one to the Secret again otherwise. A replaced certificate is
+// revoked only if its private key is lost, so that origins still serving it
+// keep working until they load the new one.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CACertificateSecret)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCACertificateSecret)
+	}
+	if !e.reissue {
+		return managed.ExternalUpdate{}, e.writeSecret(ctx, cr, e.key, e.cert)
+	}
+
+	old, lost := meta.GetExternalName(cr), e.key == nil
+	if _, err := e.issue(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The new certificate ID is persisted here, as the managed reconciler
+	// only updates the status after an update.
+	status := cr.Status.DeepCopy()
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPersistExternalName)
+	}
+	cr.Status = *status
+	if lost {
+		if _, err := e.api.RevokeOriginCACertificate(ctx, old); err != nil && !isNotFound(err) {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errRevokeCertificate, old)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete revokes the certificate and deletes the Secret.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.CACertificateSecret)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotCACertificateSecret)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	id := meta.GetExternalName(cr)
+	if _, err := e.api.RevokeOriginCACertificate(ctx, id); err != nil && !isNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrapf(err, errRevokeCertificate, id)
+	}
+	ref := cr.Spec.ForProvider.SecretRef
+	sec := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: ref.Namespace}}
+	if err := e.kube.Delete(ctx, sec); err != nil && !kerrors.IsNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrapf(err, errDeleteSecret, ref.Namespace, ref.Name)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// issue generates a private key and CSR, has the Origin CA issue a
+// certificate for them and writes both to the Secret. The certificate is
+// revoked again if the Secret cannot be written, as its key would be lost.
+func (e *external) issue(ctx context.Context, cr *v1alpha1.CACertificateSecret) (*cloudflare.OriginCACertificate, error) {
+	p := cr.Spec.ForProvider
+	key, err := generateKey(p.KeyType)
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateKey)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: p.Hostnames[0]},
+		DNSNames: p.Hostnames,
+	}, key)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCSR)
+	}
+	cert, err := e.api.CreateOriginCACertificate(ctx, cloudflare.CreateOriginCertificateParams{
+		CSR:             string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+		Hostnames:       p.Hostnames,
+		RequestType:     requestType(p.KeyType),
+		RequestValidity: validityDays(p),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCertificate)
+	}
+	if err := e.writeSecret(ctx, cr, key, cert); err != nil {
+		if _, rerr := e.api.RevokeOriginCACertificate(ctx, cert.ID); rerr != nil {
+			e.logger.Info("cannot revoke the certificate of an unwritten key", "id", cert.ID, "error", rerr)
+		}
+		return nil, err
+	}
+	meta.SetExternalName(cr, cert.ID)
+	cr.Status.AtProvider.LastIssueTime = toTime(time.Now())
+	return cert, nil
+}
+
+// writeSecret writes the key, the certificate and the Origin CA root
+// certificate to the Secret.
+func (e *external) writeSecret(ctx context.Context, cr *v1alpha1.CACertificateSecret, key crypto.Signer, cert *cloudflare.OriginCACertificate) error {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return errors.Wrap(err, errGenerateKey)
+	}
+	root, err := rootCertificate(cert.RequestType)
+	if err != nil {
+		return err
+	}
+
+	ref := cr.Spec.ForProvider.SecretRef
+	sec := &corev1.Secret{}
+	err = e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sec)
+	switch {
+	case kerrors.IsNotFound(err):
+		sec = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: ref.Namespace},
+			Type:       corev1.SecretTypeTLS,
+		}
+	case err != nil:
+		return errors.Wrapf(err, errGetSecret, ref.Namespace, ref.Name)
+	case sec.Type != corev1.SecretTypeTLS:
+		return errors.Errorf(errSecretType, ref.Namespace, ref.Name, sec.Type)
+	}
+	if sec.Data == nil {
+		sec.Data = map[string][]byte{}
+	}
+	sec.Data[keyTLSKey] = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	sec.Data[keyTLSCrt] = []byte(cert.Certificate)
+	sec.Data[keyCACrt] = root
+
+	if sec.ResourceVersion == "" {
+		err = e.kube.Create(ctx, sec)
+	} else {
+		err = e.kube.Update(ctx, sec)
+	}
+	return errors.Wrapf(err, errWriteSecret, ref.Namespace, ref.Name)
+}
+
+// secretKey returns the private key held by the Secret if it belongs to the
+// given certificate, and nil otherwise.
+func (e *external) secretKey(ctx context.Context, ref xpv1.SecretReference, x *x509.Certificate) (crypto.Signer, error) {
+	sec := &corev1.Secret{}
+	err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sec)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetSecret, ref.Namespace, ref.Name)
+	}
+	key := parseKey(sec.Data[keyTLSKey])
+	if key == nil {
+		return nil, nil
+	}
+	if pub, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !pub.Equal(x.PublicKey) {
+		return nil, nil
+	}
+	return key, nil
+}
+
+// secretCurrent returns whether the Secret holds the given certificate and
+// the Origin CA root certificate.
+func (e *external) secretCurrent(ctx context.Context, ref xpv1.SecretReference, cert *cloudflare.OriginCACertificate) (bool, error) {
+	sec := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sec); err != nil {
+		return false, errors.Wrapf(err, errGetSecret, ref.Namespace, ref.Name)
+	}
+	return bytes.Equal(bytes.TrimSpace(sec.Data[keyTLSCrt]), bytes.TrimSpace([]byte(cert.Certificate))) &&
+		len(sec.Data[keyCACrt]) > 0, nil
+}
+
+var (
+	rootsMu sync.Mutex
+	roots   = map[string][]byte{}
+)
+
+// rootCertificate returns the Origin CA root certificate signing
+// certificates of the given request type, fetching it once.
+func rootCertificate(requestType string) ([]byte, error) {
+	algorithm := "ecc"
+	if requestType == "origin-rsa" {
+		algorithm = "rsa"
+	}
+	rootsMu.Lock()
+	defer rootsMu.Unlock()
+	if r, ok := roots[algorithm]; ok {
+		return r, nil
+	}
+	r, err := cloudflare.GetOriginCARootCertificate(algorithm)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetRoot)
+	}
+	roots[algorithm] = r
+	return r, nil
+}
+
+func generateKey(keyType string) (crypto.Signer, error) {
+	if keyType == keyTypeRSA {
+		return rsa.GenerateKey(rand.Reader, 2048)
+	}
+	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+}
+
+// parseKey parses a PEM encoded PKCS #8, SEC 1 or PKCS #1 private key.
+func parseKey(data []byte) crypto.Signer {
+	b, _ := pem.Decode(data)
+	if b == nil {
+		return nil
+	}
+	if k, err := x509.ParsePKCS8PrivateKey(b.Bytes); err == nil {
+		s, _ := k.(crypto.Signer)
+		return s
+	}
+	if k, err := x509.ParseECPrivateKey(b.Bytes); err == nil {
+		return k
+	}
+	if k, err := x509.ParsePKCS1PrivateKey(b.Bytes); err == nil {
+		return k
+	}
+	return nil
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	b, _ := pem.Decode(data)
+	if b == nil {
+		return nil, errors.New("no PEM data")
+	}
+	return x509.ParseCertificate(b.Bytes)
+}
+
+func requestType(keyType string) string {
+	if keyType == keyTypeRSA {
+		return "origin-rsa"
+	}
+	return "origin-ecc"
+}
+
+func validityDays(p v1alpha1.CACertificateSecretParameters) int {
+	if p.ValidityDays == 0 {
+		return 5475
+	}
+	return p.ValidityDays
+}
+
+func renewBefore(p v1alpha1.CACertificateSecretParameters) time.Duration {
+	if p.RenewBefore == nil {
+		return 30 * 24 * time.Hour
+	}
+	return p.RenewBefore.Duration
+}
+
+func sameHostnames(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+func isNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
+
+func toTime(t time.Time) *metav1.Time {
+	mt := metav1.NewTime(t.UTC().Truncate(time.Second))
+	return &mt
+}
@@ -0,0 +1,326 @@
+package bucketcontent
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // ETags of single part uploads are MD5 hashes
+	"encoding/hex"
+	"maps"
+	"mime"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/r2/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/r2"
+)
+
+const (
+	errNotBucketContent = "managed resource is not a BucketContent custom resource"
+	errNoBucket         = "bucket is not set"
+	errStatObject       = "cannot get the attributes of object %s"
+	errListObjects      = "cannot list the objects under the prune prefix"
+	errPutObject        = "cannot upload object %s"
+	errDeleteObject     = "cannot delete object %s"
+)
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.BucketContent_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BucketContent_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.BucketContent{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BucketContent)
+	if !ok {
+		return nil, errors.New(errNotBucketContent)
+	}
+
+	p := cr.Spec.ForProvider
+	endpoint := r2.Endpoint(p.AccountID, p.Jurisdiction)
+	var creds r2.Credentials
+	var err error
+	if ref := p.CredentialsSecretRef; ref != nil {
+		creds, err = r2.CredentialsFromSecret(ctx, c.kube, r2.SecretReference{Name: ref.Name, Namespace: ref.Namespace}, endpoint)
+	} else {
+		creds, err = clients.R2Credentials(ctx, c.kube, cr, endpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s3, err := r2.NewClient(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:   c.kube,
+		s3:     s3,
+		logger: c.logger,
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	s3     *minio.Client
+	logger logging.Logger
+}
+
+// object is an object to upload to the bucket.
+type object struct {
+	data         []byte
+	etag         string
+	contentType  string
+	cacheControl string
+	metadata     map[string]string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BucketContent)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucketContent)
+	}
+	s := &cr.Status.AtProvider
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: len(s.Objects) > 0}, nil
+	}
+
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	upload, remove, err := e.plan(ctx, cr, desired)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	s.ObjectCount = len(s.Objects)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(upload) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update uploads the objects that are missing or drifted and deletes the
+// objects that were removed from the sources or are not part of them under
+// the prune prefix.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BucketContent)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucketContent)
+	}
+
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	upload, remove, err := e.plan(ctx, cr, desired)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	s := &cr.Status.AtProvider
+	if s.Objects == nil {
+		s.Objects = map[string]string{}
+	}
+	bucket := ptr.Deref(cr.Spec.ForProvider.Bucket, "")
+	for _, k := range upload {
+		o := desired[k]
+		info, err := e.s3.PutObject(ctx, bucket, k, bytes.NewReader(o.data), int64(len(o.data)), minio.PutObjectOptions{
+			ContentType:  o.contentType,
+			CacheControl: o.cacheControl,
+			UserMetadata: o.metadata,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errPutObject, k)
+		}
+		s.Objects[k] = info.ETag
+	}
+	if err := e.delete(ctx, cr, remove); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	s.ObjectCount = len(s.Objects)
+	s.LastSyncTime = &metav1.Time{Time: time.Now()}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the objects uploaded by the resource.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BucketContent)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBucketContent)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	keys := slices.Sorted(maps.Keys(cr.Status.AtProvider.Objects))
+	return managed.ExternalDelete{}, e.delete(ctx, cr, keys)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+func (e *external) delete(ctx context.Context, cr *v1alpha1.BucketContent, keys []string) error {
+	s := &cr.Status.AtProvider
+	objects := make(chan minio.ObjectInfo, len(keys))
+	for _, k := range keys {
+		objects <- minio.ObjectInfo{Key: k}
+	}
+	close(objects)
+	failed := map[string]bool{}
+	var err error
+	for r := range e.s3.RemoveObjects(ctx, ptr.Deref(cr.Spec.ForProvider.Bucket, ""), objects, minio.RemoveObjectsOptions{}) {
+		failed[r.ObjectName] = true
+		if err == nil {
+			err = errors.Wrapf(r.Err, errDeleteObject, r.ObjectName)
+		}
+	}
+	for _, k := range keys {
+		if !failed[k] {
+			delete(s.Objects, k)
+		}
+	}
+	s.ObjectCount = len(s.Objects)
+	return err
+}
+
+// plan returns the keys of the objects to upload, because they are missing
+// from the bucket or their ETag, content type, cache control or metadata
+// drifted, and the keys of the objects to delete.
+func (e *external) plan(ctx context.Context, cr *v1alpha1.BucketContent, desired map[string]object) ([]string, []string, error) {
+	bucket := ptr.Deref(cr.Spec.ForProvider.Bucket, "")
+	if bucket == "" {
+		return nil, nil, errors.New(errNoBucket)
+	}
+	var upload, remove []string
+	for k, o := range desired {
+		info, err := e.s3.StatObject(ctx, bucket, k, minio.StatObjectOptions{})
+		if minio.ToErrorResponse(err).StatusCode == 404 {
+			upload = append(upload, k)
+			continue
+		}
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, errStatObject, k)
+		}
+		if drifted(o, info) {
+			upload = append(upload, k)
+		}
+	}
+	for k := range cr.Status.AtProvider.Objects {
+		if _, ok := desired[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	if prefix := cr.Spec.ForProvider.PrunePrefix; prefix != nil {
+		for info := range e.s3.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: *prefix, Recursive: true}) {
+			if info.Err != nil {
+				return nil, nil, errors.Wrap(info.Err, errListObjects)
+			}
+			if _, ok := desired[info.Key]; !ok && !slices.Contains(remove, info.Key) {
+				remove = append(remove, info.Key)
+			}
+		}
+	}
+	slices.Sort(upload)
+	slices.Sort(remove)
+	return upload, remove, nil
+}
+
+// drifted reports whether the given object in the bucket differs from the
+// desired one.
+func drifted(o object, info minio.ObjectInfo) bool {
+	if strings.Trim(info.ETag, `"`) != o.etag || info.ContentType != o.contentType || info.Metadata.Get("Cache-Control") != o.cacheControl {
+		return true
+	}
+	if len(info.UserMetadata) != len(o.metadata) {
+		return true
+	}
+	actual := make(map[string]string, len(info.UserMetadata))
+	for k, v := range info.UserMetadata {
+		actual[strings.ToLower(k)] = v
+	}
+	for k, v := range o.metadata {
+		if actual[strings.ToLower(k)] != v {
+			return true
+		}
+	}
+	return false
+}
+
+// desired returns the objects of all sources. A key of a later source
+// overrides the same key of an earlier one.
+func (e *external) desired(ctx context.Context, cr *v1alpha1.BucketContent) (map[string]object, error) {
+	out := map[string]object{}
+	for _, src := range cr.Spec.ForProvider.Sources {
+		data, err := clients.SourceData(ctx, e.kube, (*clients.SourceReference)(src.ConfigMapRef), (*clients.SourceReference)(src.SecretRef))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range data {
+			h := md5.Sum(v) //nolint:gosec // ETags of single part uploads are MD5 hashes
+			o := object{
+				data:         v,
+				etag:         hex.EncodeToString(h[:]),
+				contentType:  src.ContentType,
+				cacheControl: src.CacheControl,
+				metadata:     src.Metadata,
+			}
+			if o.contentType == "" {
+				o.contentType = mime.TypeByExtension(path.Ext(k))
+			}
+			if o.contentType == "" {
+				o.contentType = "application/octet-stream"
+			}
+			out[src.Prefix+k] = o
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,110 @@
+// Package readiness keeps managed resources that exist in Cloudflare but do
+// not serve yet, such as zones pending nameserver delegation, Ready=False.
+package readiness
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujconfig "github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/crossplane/upjet/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/config"
+)
+
+const (
+	errGetObservation = "cannot get the observation of the managed resource"
+)
+
+// ReasonNotServing is the reason of the Ready=False condition of a managed
+// resource that has been created but does not serve yet.
+const ReasonNotServing xpv1.ConditionReason = "NotServing"
+
+// NewConnecter returns an ExternalConnecter whose external clients evaluate
+// the readiness check of the given resource after observing it, and mark it
+// Ready=False with reason NotServing instead of Ready=True while the check
+// does not pass. Resources without a readiness check are connected to by the
+// given ExternalConnecter as they are.
+func NewConnecter(cfg *ujconfig.Resource, c managed.ExternalConnecter) managed.ExternalConnecter {
+	check, ok := config.ReadinessChecks()[cfg.Name]
+	if !ok {
+		return c
+	}
+	return &connecter{ExternalConnecter: c, check: check}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+
+	check config.ReadinessCheck
+}
+
+func (c *connecter) Connect(ctx context.Context, mg xpresource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec, check: c.check}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+
+	check config.ReadinessCheck
+}
+
+// Observe observes the resource and replaces its Ready=True condition with
+// Ready=False of reason NotServing if its readiness check does not pass.
+//
+// Upjet marks a resource available as soon as it has been observed, and only
+// plans changes to resources it has marked available. A resource that is not
+// serving is therefore handed to Upjet as available, so that its changes keep
+// being applied, and its previous condition is restored if it could not be
+// observed.
+func (e *external) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	previous := mg.GetCondition(xpv1.TypeReady)
+	if previous.Reason == ReasonNotServing {
+		mg.SetConditions(xpv1.Available())
+	}
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || !obs.ResourceExists {
+		if previous.Reason == ReasonNotServing {
+			mg.SetConditions(previous)
+		}
+		return obs, err
+	}
+	if !mg.GetCondition(xpv1.TypeReady).Equal(xpv1.Available()) {
+		return obs, nil
+	}
+	tr, ok := mg.(resource.Terraformed)
+	if !ok {
+		return obs, nil
+	}
+	observation, err := tr.GetObservation()
+	if err != nil {
+		if previous.Reason == ReasonNotServing {
+			mg.SetConditions(previous)
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObservation)
+	}
+	ready, message := e.check(observation)
+	if ready {
+		return obs, nil
+	}
+	notServing := xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: mg.GetCondition(xpv1.TypeReady).LastTransitionTime,
+		Reason:             ReasonNotServing,
+		Message:            message,
+	}
+	if notServing.Equal(previous) {
+		notServing = previous
+	}
+	mg.SetConditions(notServing)
+	return obs, nil
+}
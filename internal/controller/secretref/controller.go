@@ -0,0 +1,140 @@
+// Package secretref makes the controllers of the generated managed resources
+// that read a Secret through a SecretKeySelector reconcile them when that
+// Secret changes, rather than at their next poll. The generator adds the
+// source of this package to those controllers.
+package secretref
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	errIndex       = "cannot index %s by referenced Secret"
+	errListKind    = "cannot list the %s referencing Secret %s"
+	errNewListKind = "cannot create a list of %s"
+)
+
+// indexName is the name of the index of managed resources by the
+// namespace and name of the Secrets they reference.
+const indexName = "spec.secretKeySelectors"
+
+var secretKeySelectorType = reflect.TypeOf(xpv1.SecretKeySelector{})
+
+// NewSource indexes the managed resources of the given kind by the Secrets
+// they reference, and returns a source of reconcile requests for those
+// managed resources when the data of one of those Secrets changes.
+//
+// The generated controllers of the kinds referencing Secrets through a
+// SecretKeySelector watch this source. It is not subject to the filter of
+// their other watches, which only pass changes of the desired state that a
+// Secret never has.
+func NewSource(mgr ctrl.Manager, gvk schema.GroupVersionKind, logger logging.Logger) (source.Source, error) {
+	obj, err := mgr.GetScheme().New(gvk)
+	if err != nil {
+		return nil, errors.Wrapf(err, errIndex, gvk.Kind)
+	}
+	err = mgr.GetFieldIndexer().IndexField(context.Background(), obj.(client.Object), indexName, index)
+	if err != nil {
+		return nil, errors.Wrapf(err, errIndex, gvk.Kind)
+	}
+
+	started := time.Now()
+	dependents := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, s *corev1.Secret) []reconcile.Request {
+		reqs, err := referencing(ctx, mgr.GetScheme(), mgr.GetClient(), gvk, s)
+		if err != nil {
+			logger.Info(err.Error(), "kind", gvk.Kind, "secret", s.GetNamespace()+"/"+s.GetName())
+		}
+		return reqs
+	})
+	changed := predicate.TypedFuncs[*corev1.Secret]{
+		// Secrets listed at start up are not new to the managed
+		// resources, while those created later may be awaited.
+		CreateFunc: func(e event.TypedCreateEvent[*corev1.Secret]) bool {
+			return e.Object.GetCreationTimestamp().After(started)
+		},
+		UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Secret]) bool {
+			return !reflect.DeepEqual(e.ObjectOld.Data, e.ObjectNew.Data)
+		},
+		DeleteFunc:  func(event.TypedDeleteEvent[*corev1.Secret]) bool { return false },
+		GenericFunc: func(event.TypedGenericEvent[*corev1.Secret]) bool { return false },
+	}
+	return source.Kind(mgr.GetCache(), &corev1.Secret{}, dependents, changed), nil
+}
+
+// referencing returns the reconcile requests of the managed resources of the
+// given kind referencing the given Secret.
+func referencing(ctx context.Context, scheme *runtime.Scheme, kube client.Reader, gvk schema.GroupVersionKind, s *corev1.Secret) ([]reconcile.Request, error) {
+	l, err := scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, errors.Wrapf(err, errNewListKind, gvk.Kind)
+	}
+	list, ok := l.(resource.ManagedList)
+	if !ok {
+		return nil, errors.Errorf(errNewListKind, gvk.Kind)
+	}
+	if err := kube.List(ctx, list, client.MatchingFields{indexName: s.GetNamespace() + "/" + s.GetName()}); err != nil {
+		return nil, errors.Wrapf(err, errListKind, gvk.Kind, s.GetNamespace()+"/"+s.GetName())
+	}
+	reqs := make([]reconcile.Request, 0, len(list.GetItems()))
+	for _, mg := range list.GetItems() {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}})
+	}
+	return reqs, nil
+}
+
+// index returns the namespace and name of the Secrets referenced by the
+// given managed resource.
+func index(o client.Object) []string {
+	return selectors(reflect.ValueOf(o).Elem().FieldByName("Spec"), nil)
+}
+
+// selectors returns the namespace and name of the Secrets of every
+// SecretKeySelector of the given value.
+func selectors(v reflect.Value, out []string) []string {
+	switch v.Kind() { //nolint:exhaustive // Other kinds hold no selector.
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			out = selectors(v.Elem(), out)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			out = selectors(v.Index(i), out)
+		}
+	case reflect.Map:
+		for it := v.MapRange(); it.Next(); {
+			out = selectors(it.Value(), out)
+		}
+	case reflect.Struct:
+		if v.Type() == secretKeySelectorType {
+			s := v.Interface().(xpv1.SecretKeySelector)
+			if s.Name != "" {
+				out = append(out, s.Namespace+"/"+s.Name)
+			}
+			return out
+		}
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				out = append(out, selectors(v.Field(i), nil)...)
+			}
+		}
+	}
+	return out
+}
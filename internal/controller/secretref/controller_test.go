@@ -0,0 +1,121 @@
+package secretref
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	workersv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+// script returns a Worker script with secret text bindings reading the given
+// Secrets in forProvider and initProvider.
+func script(name string, forProvider, initProvider []string) *workersv1alpha1.Script {
+	s := &workersv1alpha1.Script{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for _, ref := range forProvider {
+		ns, n, _ := strings.Cut(ref, "/")
+		s.Spec.ForProvider.Bindings = append(s.Spec.ForProvider.Bindings, workersv1alpha1.BindingsParameters{
+			TextSecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: ns, Name: n}, Key: "text"},
+		})
+	}
+	for _, ref := range initProvider {
+		ns, n, _ := strings.Cut(ref, "/")
+		s.Spec.InitProvider.Bindings = append(s.Spec.InitProvider.Bindings, workersv1alpha1.BindingsInitParameters{
+			TextSecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: ns, Name: n}, Key: "text"},
+		})
+	}
+	return s
+}
+
+func TestIndex(t *testing.T) {
+	cases := map[string]struct {
+		s    *workersv1alpha1.Script
+		want []string
+	}{
+		"NoSelectors": {
+			s: script("none", nil, nil),
+		},
+		"ForProvider": {
+			s:    script("for", []string{"ns/a", "ns/b"}, nil),
+			want: []string{"ns/a", "ns/b"},
+		},
+		"ForAndInitProvider": {
+			s:    script("both", []string{"ns/a"}, []string{"other/c"}),
+			want: []string{"ns/a", "other/c"},
+		},
+		"UnnamedSecret": {
+			s:    script("unnamed", []string{"ns/"}, nil),
+			want: nil,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := index(tc.s)
+			slices.Sort(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("index(...): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReferencing(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := workersv1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			script("a", []string{"ns/token"}, nil),
+			script("b", nil, []string{"ns/token"}),
+			script("c", []string{"ns/other"}, nil),
+		).
+		WithIndex(&workersv1alpha1.Script{}, indexName, index).
+		Build()
+
+	cases := map[string]struct {
+		secret string
+		want   []reconcile.Request
+	}{
+		"Referenced": {
+			secret: "token",
+			want: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "a"}},
+				{NamespacedName: types.NamespacedName{Name: "b"}},
+			},
+		},
+		"NotReferenced": {
+			secret: "unused",
+			want:   []reconcile.Request{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: tc.secret}}
+			got, err := referencing(context.Background(), scheme, kube, workersv1alpha1.Script_GroupVersionKind, s)
+			if err != nil {
+				t.Fatalf("referencing(...): %v", err)
+			}
+			slices.SortFunc(got, func(a, b reconcile.Request) int {
+				if a.Name < b.Name {
+					return -1
+				}
+				return 1
+			})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("referencing(...): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package kvdataset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+)
+
+const (
+	errNotKVDataSet   = "managed resource is not a KVDataSet custom resource"
+	errNoNamespaceID  = "namespaceId is not set"
+	errListKeys       = "cannot list the keys of the KV namespace"
+	errWriteKeys      = "cannot write keys to the KV namespace"
+	errDeleteKeys     = "cannot delete keys from the KV namespace"
+	errEncodeMetadata = "cannot encode the metadata of a source"
+)
+
+// bulkLimit is the maximum number of keys of a bulk write or delete request.
+const bulkLimit = 10000
+
+// metadataKey is the metadata key that marks the keys written by a data set
+// with its name and the hash of their value, TTL and metadata, so that
+// changed and removed keys are found by listing the namespace.
+const metadataKey = "crossplane-kvdataset"
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.KVDataSet_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.KVDataSet_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.KVDataSet{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.KVDataSet)
+	if !ok {
+		return nil, errors.New(errNotKVDataSet)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:   c.kube,
+		api:    api,
+		logger: c.logger,
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+}
+
+// entry is a key to write to the KV namespace.
+type entry struct {
+	pair *cloudflare.WorkersKVPair
+	hash string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KVDataSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKVDataSet)
+	}
+	if meta.WasDeleted(cr) && ptr.Deref(cr.Spec.ForProvider.NamespaceID, "") == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.existing(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	s := &cr.Status.AtProvider
+	s.KeyCount = len(owned(existing))
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: s.KeyCount > 0}, nil
+	}
+
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	write, remove := diff(cr, desired, existing)
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(write) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update writes the keys that are missing or changed and deletes the keys
+// that were removed from the sources.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.KVDataSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKVDataSet)
+	}
+
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	existing, err := e.existing(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	write, remove := diff(cr, desired, existing)
+
+	for chunk := range slices.Chunk(write, bulkLimit) {
+		pairs := make([]*cloudflare.WorkersKVPair, 0, len(chunk))
+		for _, k := range chunk {
+			pairs = append(pairs, desired[k].pair)
+		}
+		if _, err := e.api.WriteWorkersKVEntries(ctx, cloudflare.AccountIdentifier(cr.Spec.ForProvider.AccountID), cloudflare.WriteWorkersKVEntriesParams{
+			NamespaceID: ptr.Deref(cr.Spec.ForProvider.NamespaceID, ""),
+			KVs:         pairs,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errWriteKeys)
+		}
+	}
+	if err := e.delete(ctx, cr, remove); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	keys := sets.New(owned(existing)...).Insert(write...).Delete(remove...)
+	s := &cr.Status.AtProvider
+	s.KeyCount = keys.Len()
+	s.Digest = digest(desired)
+	s.LastSyncTime = &metav1.Time{Time: time.Now()}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the keys written by the data set.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.KVDataSet)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotKVDataSet)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	existing, err := e.existing(ctx, cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, e.delete(ctx, cr, owned(existing))
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+func (e *external) delete(ctx context.Context, cr *v1alpha1.KVDataSet, keys []string) error {
+	for chunk := range slices.Chunk(keys, bulkLimit) {
+		if _, err := e.api.DeleteWorkersKVEntries(ctx, cloudflare.AccountIdentifier(cr.Spec.ForProvider.AccountID), cloudflare.DeleteWorkersKVEntriesParams{
+			NamespaceID: ptr.Deref(cr.Spec.ForProvider.NamespaceID, ""),
+			Keys:        chunk,
+		}); err != nil {
+			return errors.Wrap(err, errDeleteKeys)
+		}
+	}
+	return nil
+}
+
+// diff returns the keys to write, because they are missing from the
+// namespace or changed since they were written, and the keys to delete,
+// because they were written by the data set but removed from its sources.
+func diff(cr *v1alpha1.KVDataSet, desired map[string]entry, existing map[string]string) ([]string, []string) {
+	var write, remove []string
+	for k, d := range desired {
+		if h, ok := existing[k]; !ok || h != d.hash {
+			write = append(write, k)
+		}
+	}
+	if ptr.Deref(cr.Spec.ForProvider.Prune, true) {
+		for _, k := range owned(existing) {
+			if _, ok := desired[k]; !ok {
+				remove = append(remove, k)
+			}
+		}
+	}
+	slices.Sort(write)
+	slices.Sort(remove)
+	return write, remove
+}
+
+// owned returns the keys of the namespace written by the data set.
+func owned(existing map[string]string) []string {
+	var keys []string
+	for k, h := range existing {
+		if h != "" {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// digest returns a hash of all keys of the data set and of their hashes.
+func digest(desired map[string]entry) string {
+	h := sha256.New()
+	for _, k := range slices.Sorted(maps.Keys(desired)) {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(desired[k].hash))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// desired returns the keys of all sources. A key of a later source
+// overrides the same key of an earlier one.
+func (e *external) desired(ctx context.Context, cr *v1alpha1.KVDataSet) (map[string]entry, error) {
+	out := map[string]entry{}
+	for _, src := range cr.Spec.ForProvider.Sources {
+		data, err := clients.SourceData(ctx, e.kube, (*clients.SourceReference)(src.ConfigMapRef), (*clients.SourceReference)(src.SecretRef))
+		if err != nil {
+			return nil, err
+		}
+		var metadata []byte
+		if len(src.Metadata) > 0 {
+			if metadata, err = json.Marshal(src.Metadata); err != nil {
+				return nil, errors.Wrap(err, errEncodeMetadata)
+			}
+		}
+		for k, v := range data {
+			key := src.Prefix + k
+			pair := &cloudflare.WorkersKVPair{
+				Key:    key,
+				Value:  base64.StdEncoding.EncodeToString(v),
+				Base64: true,
+			}
+			if src.ExpirationTTL != nil {
+				pair.ExpirationTTL = int(*src.ExpirationTTL)
+			}
+			h := sha256.New()
+			h.Write(v)
+			h.Write([]byte{0})
+			h.Write([]byte(strconv.Itoa(pair.ExpirationTTL)))
+			h.Write([]byte{0})
+			h.Write(metadata)
+			hash := hex.EncodeToString(h.Sum(nil))[:16]
+			md := make(map[string]string, len(src.Metadata)+1)
+			maps.Copy(md, src.Metadata)
+			md[metadataKey] = cr.GetName() + "/" + hash
+			pair.Metadata = md
+			out[key] = entry{pair: pair, hash: hash}
+		}
+	}
+	return out, nil
+}
+
+// existing returns the keys of the namespace, mapped to their hash if they
+// were written by the data set and to an empty string otherwise.
+func (e *external) existing(ctx context.Context, cr *v1alpha1.KVDataSet) (map[string]string, error) {
+	id := ptr.Deref(cr.Spec.ForProvider.NamespaceID, "")
+	if id == "" {
+		return nil, errors.New(errNoNamespaceID)
+	}
+	out := map[string]string{}
+	cursor := ""
+	for {
+		res, err := e.api.ListWorkersKVKeys(ctx, cloudflare.AccountIdentifier(cr.Spec.ForProvider.AccountID), cloudflare.ListWorkersKVsParams{
+			NamespaceID: id,
+			Limit:       1000,
+			Cursor:      cursor,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, errListKeys)
+		}
+		for _, k := range res.Result {
+			out[k.Name] = ""
+			md, _ := k.Metadata.(map[string]any)
+			v, _ := md[metadataKey].(string)
+			if name, hash, ok := strings.Cut(v, "/"); ok && name == cr.GetName() {
+				out[k.Name] = hash
+			}
+		}
+		cursor = res.Cursor
+		if cursor == "" || len(res.Result) == 0 {
+			return out, nil
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package workerrollout
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+)
+
+const (
+	errNotWorkerRollout  = "managed resource is not a WorkerRollout custom resource"
+	errGetDeployment     = "cannot get the referenced Deployment"
+	errUpdateDeployment  = "cannot update the traffic split of the Deployment"
+	errNoTargetVersion   = "targetVersionId is not set"
+	errNoScriptName      = "the referenced Deployment has no scriptName"
+	errErrorRateAnalysis = "cannot analyse the error rate of the target version"
+)
+
+// progressPollInterval is how often a rollout in progress is reconciled, so
+// that pauses end and health signals are checked in time.
+const progressPollInterval = 30 * time.Second
+
+// defaultAnalysisInterval is how often the health signals are checked
+// without an analysis interval.
+const defaultAnalysisInterval = time.Minute
+
+// defaultSteps is the plan of a rollout without steps.
+var defaultSteps = []v1alpha1.RolloutStep{
+	{Percentage: 10, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+	{Percentage: 50, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+	{Percentage: 100},
+}
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.WorkerRollout_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.WorkerRollout_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.WorkerRollout{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+// pollInterval shortens the poll interval of rollouts in progress.
+func pollInterval(mg resource.Managed, interval time.Duration) time.Duration {
+	cr, ok := mg.(*v1alpha1.WorkerRollout)
+	if ok && cr.Status.AtProvider.Phase == v1alpha1.RolloutPhaseProgressing && interval > progressPollInterval {
+		return progressPollInterval
+	}
+	return interval
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WorkerRollout)
+	if !ok {
+		return nil, errors.New(errNotWorkerRollout)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:   c.kube,
+		health: newHealthChecker(creds),
+		logger: c.logger,
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	health *healthChecker
+	logger logging.Logger
+}
+
+// Observe advances the state of the rollout. The Deployment is reported as
+// not up to date whenever its traffic split differs from the one of the
+// current step, so that Update applies it.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WorkerRollout)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWorkerRollout)
+	}
+	if meta.WasDeleted(cr) {
+		// There is no external resource to wait for.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d, err := e.getDeployment(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	target := ptr.Deref(cr.Spec.ForProvider.TargetVersionID, "")
+	if target == "" {
+		return managed.ExternalObservation{}, errors.New(errNoTargetVersion)
+	}
+
+	s := &cr.Status.AtProvider
+	if s.TargetVersionID != target {
+		*s = v1alpha1.WorkerRolloutObservation{
+			Phase:           v1alpha1.RolloutPhaseProgressing,
+			TargetVersionID: target,
+			StableVersionID: stableVersion(d, target),
+		}
+	}
+
+	if s.Phase == v1alpha1.RolloutPhaseProgressing && s.CurrentStep != nil && s.StepStartedAt != nil {
+		if err := e.analyse(ctx, cr, d); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	if s.Phase == v1alpha1.RolloutPhaseProgressing && s.StableVersionID == "" {
+		// Without a stable version there is no traffic to shift, so the
+		// target version is deployed at once.
+		s.CurrentStep = ptr.To(int32(len(steps(cr)) - 1))
+	}
+	if s.Phase == v1alpha1.RolloutPhaseProgressing && s.CurrentStep == nil {
+		s.CurrentStep = ptr.To(int32(0))
+	}
+	if s.Phase == v1alpha1.RolloutPhaseProgressing && s.StepStartedAt != nil && pauseElapsed(cr) {
+		if int(*s.CurrentStep) == len(steps(cr))-1 {
+			s.Phase = v1alpha1.RolloutPhaseSucceeded
+		} else {
+			s.CurrentStep = ptr.To(*s.CurrentStep + 1)
+			s.StepStartedAt = nil
+		}
+	}
+
+	if s.Phase == v1alpha1.RolloutPhaseFailed {
+		// There is no version to shift the traffic to, so the Deployment is
+		// left as it is until a new target version is set.
+		setConditions(cr)
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	desired := desiredVersions(cr)
+	s.Percentage = percentageOf(desired, target)
+	setConditions(cr)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s.StepStartedAt != nil && versionsEqual(d.Spec.ForProvider.Versions, desired),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update applies the traffic split of the current step to the Deployment.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WorkerRollout)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWorkerRollout)
+	}
+
+	d, err := e.getDeployment(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	desired := desiredVersions(cr)
+	if len(desired) > 0 && !versionsEqual(d.Spec.ForProvider.Versions, desired) {
+		d.Spec.ForProvider.Strategy = ptr.To("percentage")
+		d.Spec.ForProvider.Versions = desired
+		if err := e.kube.Update(ctx, d); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDeployment)
+		}
+	}
+	if cr.Status.AtProvider.StepStartedAt == nil {
+		cr.Status.AtProvider.StepStartedAt = &metav1.Time{Time: time.Now()}
+		cr.Status.AtProvider.ProbeFailures = 0
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete leaves the Deployment with its current traffic split.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+func (e *external) getDeployment(ctx context.Context, cr *v1alpha1.WorkerRollout) (*v1alpha1.Deployment, error) {
+	d := &v1alpha1.Deployment{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ForProvider.DeploymentRef.Name}, d); err != nil {
+		return nil, errors.Wrap(err, errGetDeployment)
+	}
+	return d, nil
+}
+
+// analyse checks the health signals of the target version once per
+// analysis interval and marks the rollout as rolled back if one of them
+// degraded.
+func (e *external) analyse(ctx context.Context, cr *v1alpha1.WorkerRollout, d *v1alpha1.Deployment) error {
+	a := cr.Spec.ForProvider.Analysis
+	if a == nil {
+		return nil
+	}
+	s := &cr.Status.AtProvider
+	interval := defaultAnalysisInterval
+	if a.Interval != nil {
+		interval = a.Interval.Duration
+	}
+	if s.LastAnalysisTime != nil && time.Since(s.LastAnalysisTime.Time) < interval {
+		return nil
+	}
+	script := ptr.Deref(d.Spec.ForProvider.ScriptName, "")
+	if script == "" {
+		return errors.New(errNoScriptName)
+	}
+	s.LastAnalysisTime = &metav1.Time{Time: time.Now()}
+
+	if a.HTTPProbe != nil {
+		if err := e.health.probe(ctx, a.HTTPProbe, script, s.TargetVersionID); err != nil {
+			s.ProbeFailures++
+			if s.ProbeFailures >= ptr.Deref(a.HTTPProbe.FailureThreshold, 3) {
+				rollBack(cr, fmt.Sprintf("HTTP probe failed %d times: %s", s.ProbeFailures, err))
+				return nil
+			}
+		} else {
+			s.ProbeFailures = 0
+		}
+	}
+
+	if a.ErrorRate != nil {
+		since := s.StepStartedAt.Time
+		window := 5 * time.Minute
+		if a.ErrorRate.Window != nil {
+			window = a.ErrorRate.Window.Duration
+		}
+		if start := time.Now().Add(-window); start.After(since) {
+			since = start
+		}
+		requests, errs, err := e.health.invocations(ctx, ptr.Deref(d.Spec.ForProvider.AccountID, ""), script, s.TargetVersionID, since)
+		if err != nil {
+			return errors.Wrap(err, errErrorRateAnalysis)
+		}
+		s.Requests, s.Errors, s.ErrorRate = requests, errs, nil
+		if requests > 0 {
+			s.ErrorRate = ptr.To(float64(errs) / float64(requests))
+		}
+		if s.ErrorRate != nil && requests >= ptr.Deref(a.ErrorRate.MinRequests, 100) && *s.ErrorRate > a.ErrorRate.MaxErrorRate {
+			rollBack(cr, fmt.Sprintf("error rate %.4f of %d requests exceeds %.4f", *s.ErrorRate, requests, a.ErrorRate.MaxErrorRate))
+		}
+	}
+	return nil
+}
+
+// rollBack marks the rollout as rolled back, or as failed if there is no
+// stable version to roll back to.
+func rollBack(cr *v1alpha1.WorkerRollout, reason string) {
+	cr.Status.AtProvider.Phase = v1alpha1.RolloutPhaseRolledBack
+	if cr.Status.AtProvider.StableVersionID == "" {
+		cr.Status.AtProvider.Phase = v1alpha1.RolloutPhaseFailed
+	}
+	cr.Status.AtProvider.Message = reason
+	cr.Status.AtProvider.StepStartedAt = nil
+}
+
+func setConditions(cr *v1alpha1.WorkerRollout) {
+	s := cr.Status.AtProvider
+	switch s.Phase {
+	case v1alpha1.RolloutPhaseSucceeded:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.RolloutPhaseRolledBack:
+		cr.SetConditions(xpv1.Unavailable().WithMessage("rolled back to version " + s.StableVersionID + ": " + s.Message))
+	case v1alpha1.RolloutPhaseFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage("cannot roll back version " + s.TargetVersionID + ", the Deployment had no stable version when the rollout started: " + s.Message))
+	default:
+		cr.SetConditions(xpv1.Creating().WithMessage(fmt.Sprintf("step %d of %d: %d%% of the traffic on version %s", ptr.Deref(s.CurrentStep, 0)+1, len(steps(cr)), s.Percentage, s.TargetVersionID)))
+	}
+}
+
+func steps(cr *v1alpha1.WorkerRollout) []v1alpha1.RolloutStep {
+	if len(cr.Spec.ForProvider.Steps) == 0 {
+		return defaultSteps
+	}
+	return cr.Spec.ForProvider.Steps
+}
+
+func pauseElapsed(cr *v1alpha1.WorkerRollout) bool {
+	s := cr.Status.AtProvider
+	step := steps(cr)[*s.CurrentStep]
+	if step.Pause == nil {
+		return true
+	}
+	return time.Since(s.StepStartedAt.Time) >= step.Pause.Duration
+}
+
+// stableVersion returns the version of the Deployment with the largest
+// share of the traffic, other than the target version.
+func stableVersion(d *v1alpha1.Deployment, target string) string {
+	stable, share := "", -1.0
+	for _, v := range d.Spec.ForProvider.Versions {
+		id := ptr.Deref(v.VersionID, "")
+		if id == "" || id == target {
+			continue
+		}
+		if p := ptr.Deref(v.Percentage, 0); p > share {
+			stable, share = id, p
+		}
+	}
+	return stable
+}
+
+// desiredVersions returns the traffic split of the current state of the
+// rollout.
+func desiredVersions(cr *v1alpha1.WorkerRollout) []v1alpha1.VersionsParameters {
+	s := cr.Status.AtProvider
+	split := func(id string, p int32) v1alpha1.VersionsParameters {
+		return v1alpha1.VersionsParameters{VersionID: ptr.To(id), Percentage: ptr.To(float64(p))}
+	}
+	switch s.Phase {
+	case v1alpha1.RolloutPhaseFailed:
+		return nil
+	case v1alpha1.RolloutPhaseRolledBack:
+		return []v1alpha1.VersionsParameters{split(s.StableVersionID, 100)}
+	case v1alpha1.RolloutPhaseSucceeded:
+		return []v1alpha1.VersionsParameters{split(s.TargetVersionID, 100)}
+	}
+	p := steps(cr)[ptr.Deref(s.CurrentStep, 0)].Percentage
+	if p >= 100 || s.StableVersionID == "" {
+		return []v1alpha1.VersionsParameters{split(s.TargetVersionID, 100)}
+	}
+	return []v1alpha1.VersionsParameters{split(s.TargetVersionID, p), split(s.StableVersionID, 100-p)}
+}
+
+func percentageOf(versions []v1alpha1.VersionsParameters, id string) int32 {
+	for _, v := range versions {
+		if ptr.Deref(v.VersionID, "") == id {
+			return int32(math.Round(ptr.Deref(v.Percentage, 0)))
+		}
+	}
+	return 0
+}
+
+// versionsEqual reports whether both traffic splits route the same
+// percentages to the same versions, in any order.
+func versionsEqual(a, b []v1alpha1.VersionsParameters) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	shares := make(map[string]float64, len(a))
+	for _, v := range a {
+		shares[ptr.Deref(v.VersionID, "")] = ptr.Deref(v.Percentage, 0)
+	}
+	for _, v := range b {
+		p, ok := shares[ptr.Deref(v.VersionID, "")]
+		if !ok || math.Abs(p-ptr.Deref(v.Percentage, 0)) > 0.001 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package workerrollout
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+func TestAnalyseInterval(t *testing.T) {
+	var probes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+	}))
+	defer srv.Close()
+
+	d := &v1alpha1.Deployment{}
+	d.Spec.ForProvider.ScriptName = ptr.To("worker")
+
+	cases := map[string]struct {
+		interval     *metav1.Duration
+		lastAnalysis *time.Time
+		wantProbes   int32
+	}{
+		"FirstAnalysis": {
+			wantProbes: 1,
+		},
+		"WithinDefaultInterval": {
+			lastAnalysis: ptr.To(time.Now().Add(-30 * time.Second)),
+		},
+		"DefaultIntervalElapsed": {
+			lastAnalysis: ptr.To(time.Now().Add(-2 * time.Minute)),
+			wantProbes:   1,
+		},
+		"WithinInterval": {
+			interval:     &metav1.Duration{Duration: 10 * time.Minute},
+			lastAnalysis: ptr.To(time.Now().Add(-5 * time.Minute)),
+		},
+		"IntervalElapsed": {
+			interval:     &metav1.Duration{Duration: 10 * time.Second},
+			lastAnalysis: ptr.To(time.Now().Add(-30 * time.Second)),
+			wantProbes:   1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			probes.Store(0)
+			cr := &v1alpha1.WorkerRollout{}
+			cr.Spec.ForProvider.Analysis = &v1alpha1.RolloutAnalysis{
+				HTTPProbe: &v1alpha1.HTTPProbe{URL: srv.URL},
+				Interval:  tc.interval,
+			}
+			cr.Status.AtProvider.TargetVersionID = "v2"
+			if tc.lastAnalysis != nil {
+				cr.Status.AtProvider.LastAnalysisTime = &metav1.Time{Time: *tc.lastAnalysis}
+			}
+			e := &external{health: newHealthChecker(nil)}
+
+			before := time.Now()
+			if err := e.analyse(context.Background(), cr, d); err != nil {
+				t.Fatalf("analyse(...): %v", err)
+			}
+			if got := probes.Load(); got != tc.wantProbes {
+				t.Errorf("analyse(...): %d probes, want %d", got, tc.wantProbes)
+			}
+			analysed := cr.Status.AtProvider.LastAnalysisTime != nil && !cr.Status.AtProvider.LastAnalysisTime.Time.Before(before)
+			if analysed != (tc.wantProbes > 0) {
+				t.Errorf("analyse(...): lastAnalysisTime %v, want it updated %t", cr.Status.AtProvider.LastAnalysisTime, tc.wantProbes > 0)
+			}
+		})
+	}
+}
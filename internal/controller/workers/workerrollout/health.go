@@ -0,0 +1,169 @@
+package workerrollout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+)
+
+const graphQLEndpoint = "https://api.cloudflare.com/client/v4/graphql"
+
+// versionOverridesHeader pins a request to a version of a Worker, see
+// https://developers.cloudflare.com/workers/configuration/versions-and-deployments/gradual-deployments/#version-overrides
+const versionOverridesHeader = "Cloudflare-Workers-Version-Overrides"
+
+const invocationsQuery = `query ($accountTag: string!, $filter: AccountWorkersInvocationsAdaptiveFilter_InputObject) {
+  viewer {
+    accounts(filter: {accountTag: $accountTag}) {
+      workersInvocationsAdaptive(limit: 10000, filter: $filter) {
+        sum {
+          requests
+          errors
+        }
+      }
+    }
+  }
+}`
+
+// healthChecker evaluates the health signals of a WorkerRollout.
+type healthChecker struct {
+	creds  map[string]string
+	client *http.Client
+}
+
+func newHealthChecker(creds map[string]string) *healthChecker {
+	return &healthChecker{creds: creds, client: &http.Client{}}
+}
+
+// probe sends the configured request and returns an error if the response
+// is not healthy.
+func (h *healthChecker) probe(ctx context.Context, p *v1alpha1.HTTPProbe, script, version string) error {
+	timeout := 10 * time.Second
+	if p.Timeout != nil {
+		timeout = p.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	method := p.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.URL, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range p.Headers {
+		req.Header.Set(k, v)
+	}
+	if ptr.Deref(p.PinTargetVersion, true) {
+		req.Header.Set(versionOverridesHeader, fmt.Sprintf("%s=%q", script, version))
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck // the body is only drained
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if len(p.SuccessStatusCodes) == 0 {
+		if resp.StatusCode >= 200 && resp.StatusCode < 400 {
+			return nil
+		}
+	} else if slices.Contains(p.SuccessStatusCodes, resp.StatusCode) {
+		return nil
+	}
+	return errors.Errorf("unexpected status %s", resp.Status)
+}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data struct {
+		Viewer struct {
+			Accounts []struct {
+				WorkersInvocationsAdaptive []struct {
+					Sum struct {
+						Requests int64 `json:"requests"`
+						Errors   int64 `json:"errors"`
+					} `json:"sum"`
+				} `json:"workersInvocationsAdaptive"`
+			} `json:"accounts"`
+		} `json:"viewer"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// invocations returns the number of total and errored invocations of the
+// given Worker version since the given time, as reported by the GraphQL
+// Analytics API.
+func (h *healthChecker) invocations(ctx context.Context, account, script, version string, since time.Time) (int64, int64, error) {
+	body, err := json.Marshal(graphQLRequest{
+		Query: invocationsQuery,
+		Variables: map[string]any{
+			"accountTag": account,
+			"filter": map[string]any{
+				"scriptName":    script,
+				"scriptVersion": version,
+				"datetime_geq":  since.UTC().Format(time.RFC3339),
+				"datetime_leq":  time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, graphQLEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	clients.SetAuthHeaders(req.Header, h.creds)
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only body
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, errors.Errorf("unexpected status %s", resp.Status)
+	}
+	out := graphQLResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return 0, 0, err
+	}
+	if len(out.Errors) > 0 {
+		msgs := make([]string, 0, len(out.Errors))
+		for _, e := range out.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return 0, 0, errors.New(strings.Join(msgs, "; "))
+	}
+
+	var requests, errs int64
+	for _, a := range out.Data.Viewer.Accounts {
+		for _, g := range a.WorkersInvocationsAdaptive {
+			requests += g.Sum.Requests
+			errs += g.Sum.Errors
+		}
+	}
+	return requests, errs, nil
+}
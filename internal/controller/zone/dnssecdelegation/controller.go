@@ -0,0 +1,238 @@
+package dnssecdelegation
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dnsv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/dns/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/zone/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients"
+	"gitlab.com/jarvisai.run/provider-cloudflare/internal/clients/records"
+)
+
+const (
+	errNotDNSSECDelegation = "managed resource is not a DNSSECDelegation custom resource"
+	errNoParentZone        = "parentZoneId must be set"
+	errGetDNSSEC           = "cannot get DNSSEC %s"
+	errDNSSECNoZone        = "DNSSEC %s has no zone ID yet"
+	errGetZone             = "cannot get zone %s"
+	errNotSubdomain        = "zone %s is not a subdomain of parent zone %s"
+	errInvalidDS           = "invalid %s %q of DNSSEC %s"
+)
+
+// The statuses of DNSSEC.
+const (
+	statusActive          = "active"
+	statusDisabled        = "disabled"
+	statusPendingDisabled = "pending-disabled"
+)
+
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.DNSSECDelegation_GroupVersionKind.String())
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DNSSECDelegation_GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			logger: o.Logger,
+		}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(3*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.DNSSECDelegation{}).
+		Owns(&dnsv1alpha1.Record{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func SetupGated(mgr ctrl.Manager, o tjcontroller.Options) error {
+	return Setup(mgr, o)
+}
+
+type connector struct {
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DNSSECDelegation)
+	if !ok {
+		return nil, errors.New(errNotDNSSECDelegation)
+	}
+
+	creds, err := clients.ExtractCredentials(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.NewAPI(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{kube: c.kube, api: api, logger: c.logger, records: records.New(c.kube, v1alpha1.LabelDNSSECDelegation)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    *cloudflare.API
+	logger logging.Logger
+
+	records *records.Records
+
+	// desired are the records computed by Observe.
+	desired map[string]dnsv1alpha1.RecordParameters
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DNSSECDelegation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDNSSECDelegation)
+	}
+	existing, err := e.records.Owned(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: len(existing) > 0}, nil
+	}
+
+	parentID := ptr.Deref(cr.Spec.ForProvider.ParentZoneID, "")
+	if parentID == "" {
+		return managed.ExternalObservation{}, errors.New(errNoParentZone)
+	}
+	name := cr.Spec.ForProvider.DNSSECRef.Name
+	d := &v1alpha1.DNSSEC{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: name}, d); err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetDNSSEC, name)
+	}
+	zoneID := ptr.Deref(d.Status.AtProvider.ZoneID, ptr.Deref(d.Spec.ForProvider.ZoneID, ""))
+	if zoneID == "" {
+		return managed.ExternalObservation{}, errors.Errorf(errDNSSECNoZone, name)
+	}
+	z, err := e.api.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetZone, zoneID)
+	}
+	parent, err := e.api.ZoneDetails(ctx, parentID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetZone, parentID)
+	}
+	if !strings.HasSuffix(z.Name, "."+parent.Name) {
+		return managed.ExternalObservation{}, errors.Errorf(errNotSubdomain, z.Name, parent.Name)
+	}
+	if e.desired, err = desiredRecords(cr, d, parentID, z.Name); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := records.UpToDate(existing, e.desired)
+
+	s := &cr.Status.AtProvider
+	s.ZoneName = z.Name
+	s.DNSSECStatus = ptr.Deref(d.Status.AtProvider.Status, "")
+	s.DS = ptr.Deref(d.Status.AtProvider.Ds, "")
+	s.Records = slices.Sorted(maps.Keys(e.desired))
+
+	if s.DNSSECStatus == statusActive {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("DNSSEC of zone %s is %s", z.Name, s.DNSSECStatus)))
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DNSSECDelegation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDNSSECDelegation)
+	}
+	return managed.ExternalCreation{}, e.records.Apply(ctx, cr, e.desired, map[string]string{v1alpha1.LabelDNSSEC: cr.Spec.ForProvider.DNSSECRef.Name})
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DNSSECDelegation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDNSSECDelegation)
+	}
+	return managed.ExternalUpdate{}, e.records.Apply(ctx, cr, e.desired, map[string]string{v1alpha1.LabelDNSSEC: cr.Spec.ForProvider.DNSSECRef.Name})
+}
+
+// Delete deletes the records created for the delegation. The delegation is
+// gone, and its DNSSEC can be disabled, once they have been deleted from
+// the parent zone.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.DNSSECDelegation)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDNSSECDelegation)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	e.desired = nil
+	return managed.ExternalDelete{}, e.records.Apply(ctx, cr, e.desired, map[string]string{v1alpha1.LabelDNSSEC: cr.Spec.ForProvider.DNSSECRef.Name})
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// desiredRecords returns the DS record of the DNSSEC in the parent zone, keyed by
+// the name of its resource. There is none until Cloudflare has generated
+// the key of the zone, nor once DNSSEC is being disabled.
+func desiredRecords(cr *v1alpha1.DNSSECDelegation, d *v1alpha1.DNSSEC, parentID, zone string) (map[string]dnsv1alpha1.RecordParameters, error) {
+	out := map[string]dnsv1alpha1.RecordParameters{}
+	o := d.Status.AtProvider
+	status, digest, keyTag := ptr.Deref(o.Status, ""), ptr.Deref(o.Digest, ""), ptr.Deref(o.KeyTag, 0)
+	if status == statusDisabled || status == statusPendingDisabled || digest == "" || keyTag == 0 {
+		return out, nil
+	}
+	algorithm, err := strconv.ParseFloat(ptr.Deref(o.Algorithm, ""), 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, errInvalidDS, "algorithm", ptr.Deref(o.Algorithm, ""), d.GetName())
+	}
+	digestType, err := strconv.ParseFloat(ptr.Deref(o.DigestType, ""), 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, errInvalidDS, "digest type", ptr.Deref(o.DigestType, ""), d.GetName())
+	}
+	ttl := cr.Spec.ForProvider.RecordTTL
+	if ttl == 0 {
+		ttl = 1
+	}
+	key := fmt.Sprintf("%v/%v/%v/%s", keyTag, algorithm, digestType, strings.ToLower(digest))
+	out[records.Name(cr.GetName(), "DS", key)] = dnsv1alpha1.RecordParameters{
+		ZoneID: ptr.To(parentID),
+		Name:   ptr.To(zone),
+		Type:   ptr.To("DS"),
+		Data: &dnsv1alpha1.DataParameters{
+			KeyTag:     ptr.To(keyTag),
+			Algorithm:  ptr.To(algorithm),
+			DigestType: ptr.To(digestType),
+			Digest:     ptr.To(digest),
+		},
+		TTL:     ptr.To(float64(ttl)),
+		Comment: ptr.To("Managed by DNSSECDelegation " + cr.GetName()),
+	}
+	return out, nil
+}
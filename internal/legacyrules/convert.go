@@ -0,0 +1,646 @@
+package legacyrules
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/pkg/rules"
+)
+
+// The phases the legacy configuration is converted into, in the order the
+// rulesets are emitted.
+const (
+	PhaseFirewallCustom  = "http_request_firewall_custom"
+	PhaseRatelimit       = "http_ratelimit"
+	PhaseDynamicRedirect = "http_request_dynamic_redirect"
+	PhaseOrigin          = "http_request_origin"
+	PhaseCacheSettings   = "http_request_cache_settings"
+	PhaseConfigSettings  = "http_config_settings"
+)
+
+var phases = []struct{ phase, name string }{
+	{PhaseFirewallCustom, "Custom rules migrated from firewall rules"},
+	{PhaseRatelimit, "Rate limiting rules migrated from rate limits"},
+	{PhaseDynamicRedirect, "Redirect rules migrated from page rules"},
+	{PhaseOrigin, "Origin rules migrated from page rules"},
+	{PhaseCacheSettings, "Cache rules migrated from page rules"},
+	{PhaseConfigSettings, "Configuration rules migrated from page rules"},
+}
+
+// The periods and mitigation timeouts, in seconds, rate limiting rules
+// support.
+var (
+	periods            = []int{10, 60, 120, 300, 600, 3600}
+	mitigationTimeouts = []int{0, 10, 60, 120, 300, 600, 3600, 86400}
+)
+
+// The order in which firewall rules without a priority are evaluated.
+var actionPrecedence = map[string]int{
+	"log": 0, "bypass": 1, "allow": 2, "managed_challenge": 3, "challenge": 4, "js_challenge": 5, "block": 6,
+}
+
+// Options configure the conversion.
+type Options struct {
+	// Name prefixes the object names of the rulesets. It defaults to the
+	// zone ID.
+	Name string
+	// ProviderConfig is the name of the ProviderConfig of the rulesets.
+	ProviderConfig string
+}
+
+// Finding describes a part of the legacy configuration that could not be
+// converted one to one.
+type Finding struct {
+	// Source names the legacy object.
+	Source  string
+	Message string
+}
+
+func (f Finding) String() string {
+	return f.Source + ": " + f.Message
+}
+
+// Result is the outcome of a conversion.
+type Result struct {
+	// Objects are the converted rulesets, one per phase.
+	Objects []runtime.Object
+	// Findings describe what could not be converted one to one.
+	Findings []Finding
+}
+
+// Convert converts the legacy configuration of the given zone into one
+// ruleset per phase. Rules keep the identifier of the legacy object they
+// were converted from in their ref.
+func Convert(z *Zone, o Options) *Result {
+	c := &converter{zone: z, opts: o, rules: map[string][]v1alpha1.RulesParameters{}}
+	for _, f := range z.UnusedFilters {
+		c.report(f, "not used by any firewall rule, there is nothing to migrate")
+	}
+	c.firewallRules()
+	c.rateLimits()
+	c.pageRules()
+
+	name := o.Name
+	if name == "" {
+		name = z.ID
+	}
+	for _, p := range phases {
+		if len(c.rules[p.phase]) == 0 {
+			continue
+		}
+		spec := xpv1.ResourceSpec{}
+		if o.ProviderConfig != "" {
+			spec.ProviderConfigReference = &xpv1.Reference{Name: o.ProviderConfig}
+		}
+		c.res.Objects = append(c.res.Objects, &v1alpha1.Ruleset{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.CRDGroupVersion.String(), Kind: v1alpha1.Ruleset_Kind},
+			ObjectMeta: metav1.ObjectMeta{Name: objectName(name + "-" + strings.ReplaceAll(p.phase, "_", "-"))},
+			Spec: v1alpha1.RulesetSpec{ResourceSpec: spec, ForProvider: v1alpha1.RulesetParameters{
+				ZoneID: ptr.To(z.ID),
+				Kind:   ptr.To("zone"),
+				Name:   ptr.To(p.name),
+				Phase:  ptr.To(p.phase),
+				Rules:  c.rules[p.phase],
+			}},
+		})
+	}
+	return &c.res
+}
+
+type converter struct {
+	zone  *Zone
+	opts  Options
+	rules map[string][]v1alpha1.RulesParameters
+	res   Result
+}
+
+func (c *converter) report(source, format string, args ...any) {
+	c.res.Findings = append(c.res.Findings, Finding{Source: source, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *converter) add(phase string, r v1alpha1.RulesParameters) {
+	c.rules[phase] = append(c.rules[phase], r)
+}
+
+// check reports the expressions that are not valid in the Rules language.
+// They are converted as they are, as the API may support fields the
+// provider does not know about yet.
+func (c *converter) check(source, expression string) {
+	if err := rules.Check(expression); err != nil {
+		c.report(source, "the expression %q may not be valid: %s", expression, err)
+	}
+	for _, f := range rules.UnknownFields(expression) {
+		c.report(source, "the expression %q uses the unknown field %q, which was not checked", expression, f)
+	}
+}
+
+// ref returns the ref of the rule converted from the legacy object with the
+// given identifier.
+func ref(id, source string) *string {
+	if id == "" {
+		id = source
+	}
+	return ptr.To("migrated-" + objectName(id))
+}
+
+// firewallRules converts the firewall rules into custom rules. Rules with a
+// priority come first, followed by the other rules in the order of their
+// actions, as they were evaluated.
+func (c *converter) firewallRules() {
+	frs := slices.Clone(c.zone.FirewallRules)
+	slices.SortStableFunc(frs, func(a, b FirewallRule) int {
+		pa, aok := priority(a.Priority)
+		pb, bok := priority(b.Priority)
+		switch {
+		case aok && bok:
+			return cmp.Compare(pa, pb)
+		case aok:
+			return -1
+		case bok:
+			return 1
+		}
+		return cmp.Compare(actionPrecedence[a.Action], actionPrecedence[b.Action])
+	})
+
+	for _, fr := range frs {
+		expr := fr.Filter.Expression
+		if expr == "" {
+			c.report(fr.Source, "has no filter expression and was not migrated")
+			continue
+		}
+		c.check(fr.Source, expr)
+		r := v1alpha1.RulesParameters{
+			Ref:        ref(fr.ID, fr.Source),
+			Expression: ptr.To(expr),
+			Enabled:    ptr.To(!fr.Paused && !fr.Filter.Paused),
+		}
+		if d := cmp.Or(fr.Description, fr.Filter.Description); d != "" {
+			r.Description = ptr.To(d)
+		}
+		switch fr.Action {
+		case "block", "challenge", "js_challenge", "managed_challenge", "log":
+			r.Action = ptr.To(fr.Action)
+		case "allow":
+			// Allow only exempted requests from the remaining firewall
+			// rules.
+			r.Action = ptr.To("skip")
+			r.ActionParameters = &v1alpha1.ActionParametersParameters{Ruleset: ptr.To("current")}
+		case "bypass":
+			r.Action = ptr.To("skip")
+			r.ActionParameters = &v1alpha1.ActionParametersParameters{}
+			for _, p := range fr.Products {
+				switch p {
+				case "rateLimit":
+					// The rate limits are migrated to rate limiting rules.
+					r.ActionParameters.Phases = append(r.ActionParameters.Phases, ptr.To(PhaseRatelimit))
+				case "waf":
+					r.ActionParameters.Products = append(r.ActionParameters.Products, ptr.To(p))
+					c.report(fr.Source, "bypasses the legacy WAF, add %s to the phases of the skip rule to skip the WAF managed rules", "http_request_firewall_managed")
+				default:
+					r.ActionParameters.Products = append(r.ActionParameters.Products, ptr.To(p))
+				}
+			}
+		default:
+			c.report(fr.Source, "the action %q has no equivalent and the rule was not migrated", fr.Action)
+			continue
+		}
+		c.add(PhaseFirewallCustom, r)
+	}
+}
+
+func priority(p any) (float64, bool) {
+	switch v := p.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// rateLimits converts the rate limits into rate limiting rules counting
+// the requests per IP address and data center, as rate limits did.
+func (c *converter) rateLimits() {
+	for _, rl := range c.zone.RateLimits {
+		req := rl.Match.Request
+		exprs := []string{urlPattern(req.URLPattern)}
+		if len(req.Methods) > 0 && !slices.Contains(req.Methods, "_ALL_") {
+			exprs = append(exprs, "http.request.method in "+set(req.Methods))
+		}
+		switch {
+		case len(req.Schemes) != 1:
+		case req.Schemes[0] == "HTTPS":
+			exprs = append(exprs, "ssl")
+		case req.Schemes[0] == "HTTP":
+			exprs = append(exprs, "not ssl")
+		}
+		for _, b := range rl.Bypass {
+			if b.Name != "url" {
+				c.report(rl.Source, "the bypass on %q has no equivalent and was dropped", b.Name)
+				continue
+			}
+			if e := urlPattern(b.Value); e != "" {
+				exprs = append(exprs, "not ("+e+")")
+			}
+		}
+		expr := cmp.Or(and(exprs...), "true")
+		c.check(rl.Source, expr)
+
+		var counting []string
+		resp := rl.Match.Response
+		if len(resp.Statuses) > 0 {
+			codes := make([]string, len(resp.Statuses))
+			for i, s := range resp.Statuses {
+				codes[i] = strconv.Itoa(s)
+			}
+			counting = append(counting, "http.response.code in {"+strings.Join(codes, " ")+"}")
+		}
+		for _, h := range resp.Headers {
+			counting = append(counting, responseHeader(h.Name, h.Op, h.Value))
+		}
+
+		period, threshold := rl.Period, rl.Threshold
+		if !slices.Contains(periods, period) {
+			period = nearest(periods, rl.Period)
+			threshold = max(1, int(math.Round(float64(rl.Threshold)*float64(period)/float64(rl.Period))))
+			c.report(rl.Source, "a period of %ds is not supported, counting %d requests over %ds instead of %d over %ds", rl.Period, threshold, period, rl.Threshold, rl.Period)
+		}
+		r := v1alpha1.RulesParameters{
+			Ref:        ref(rl.ID, rl.Source),
+			Expression: ptr.To(expr),
+			Enabled:    ptr.To(!rl.Disabled),
+			Ratelimit: &v1alpha1.RatelimitParameters{
+				Characteristics:   []*string{ptr.To("ip.src"), ptr.To("cf.colo.id")},
+				Period:            ptr.To(float64(period)),
+				RequestsPerPeriod: ptr.To(float64(threshold)),
+				// Rate limits only counted the requests to the origin
+				// unless told otherwise.
+				RequestsToOrigin: ptr.To(ptr.Deref(resp.OriginTraffic, true)),
+			},
+		}
+		if rl.Description != "" {
+			r.Description = ptr.To(rl.Description)
+		}
+		if len(counting) > 0 {
+			r.Ratelimit.CountingExpression = ptr.To(and(append([]string{and(exprs...)}, counting...)...))
+			c.check(rl.Source, *r.Ratelimit.CountingExpression)
+			c.report(rl.Source, "counts requests by response status or headers, which requires Advanced Rate Limiting on some plans")
+		}
+		if rl.Correlate != nil && rl.Correlate.By != "" {
+			c.report(rl.Source, "correlation by %q has no equivalent, requests are counted per IP address", rl.Correlate.By)
+		}
+
+		switch rl.Action.Mode {
+		case "ban", "simulate":
+			r.Action = ptr.To("block")
+			if rl.Action.Mode == "simulate" {
+				r.Action = ptr.To("log")
+			}
+			timeout := rl.Action.Timeout
+			if !slices.Contains(mitigationTimeouts, timeout) {
+				timeout = nearest(mitigationTimeouts, rl.Action.Timeout)
+				c.report(rl.Source, "a timeout of %ds is not supported, mitigating for %ds instead", rl.Action.Timeout, timeout)
+			}
+			r.Ratelimit.MitigationTimeout = ptr.To(float64(timeout))
+			if resp := rl.Action.Response; resp != nil && rl.Action.Mode == "ban" {
+				r.ActionParameters = &v1alpha1.ActionParametersParameters{Response: &v1alpha1.ResponseParameters{
+					StatusCode:  ptr.To(float64(429)),
+					ContentType: ptr.To(resp.ContentType),
+					Content:     ptr.To(resp.Body),
+				}}
+			}
+		case "challenge", "js_challenge", "managed_challenge":
+			r.Action = ptr.To(rl.Action.Mode)
+			r.Ratelimit.MitigationTimeout = ptr.To(float64(0))
+		default:
+			c.report(rl.Source, "the action %q has no equivalent and the rate limit was not migrated", rl.Action.Mode)
+			continue
+		}
+		c.add(PhaseRatelimit, r)
+	}
+}
+
+// nearest returns the smallest of the given ascending values that is not
+// less than v, or the largest value.
+func nearest(values []int, v int) int {
+	for _, a := range values {
+		if a >= v {
+			return a
+		}
+	}
+	return values[len(values)-1]
+}
+
+// pageRules converts the page rules into redirect, origin, cache and
+// configuration rules. Page rules are converted in ascending order of
+// priority, so that the settings of the page rule with the highest priority
+// win.
+func (c *converter) pageRules() {
+	prs := slices.Clone(c.zone.PageRules)
+	slices.SortStableFunc(prs, func(a, b PageRule) int { return cmp.Compare(a.Priority, b.Priority) })
+	if len(prs) > 1 {
+		c.report("page rules", "only the first matching page rule applied, while the settings of all the matching rules apply, review the page rules with overlapping targets")
+	}
+	for _, pr := range prs {
+		c.pageRule(pr)
+	}
+}
+
+func (c *converter) pageRule(pr PageRule) {
+	if len(pr.Targets) != 1 || pr.Targets[0].Target != "url" || pr.Targets[0].Constraint.Operator != "matches" {
+		c.report(pr.Source, "only a single URL target matching a pattern is supported, the page rule was not migrated")
+		return
+	}
+	pattern, added := targetPattern(pr.Targets[0].Constraint.Value)
+	expr := "http.request.full_uri wildcard " + quote(pattern)
+	enabled := ptr.To(pr.Status == "active")
+
+	cache := &v1alpha1.ActionParametersParameters{}
+	config := &v1alpha1.ActionParametersParameters{}
+	origin := &v1alpha1.ActionParametersParameters{}
+	cacheKey := func() *v1alpha1.CacheKeyParameters {
+		if cache.CacheKey == nil {
+			cache.CacheKey = &v1alpha1.CacheKeyParameters{}
+		}
+		return cache.CacheKey
+	}
+	edgeTTL := func() *v1alpha1.EdgeTTLParameters {
+		if cache.EdgeTTL == nil {
+			cache.EdgeTTL = &v1alpha1.EdgeTTLParameters{Mode: ptr.To("respect_origin")}
+		}
+		return cache.EdgeTTL
+	}
+
+	for _, a := range pr.Actions {
+		switch a.ID {
+		case "forwarding_url":
+			v, _ := a.Value.(map[string]any)
+			url, _ := v["url"].(string)
+			status, _ := v["status_code"].(float64)
+			from := &v1alpha1.FromValueParameters{StatusCode: ptr.To(status), TargetURL: &v1alpha1.TargetURLParameters{}}
+			if e := forwardingTarget(pattern, added, url); e != "" {
+				from.TargetURL.Expression = ptr.To(e)
+				from.PreserveQueryString = ptr.To(false)
+			} else {
+				from.TargetURL.Value = ptr.To(url)
+				from.PreserveQueryString = ptr.To(true)
+			}
+			c.add(PhaseDynamicRedirect, v1alpha1.RulesParameters{
+				Ref: ref(pr.ID, pr.Source), Expression: ptr.To(expr), Enabled: enabled,
+				Action:           ptr.To("redirect"),
+				ActionParameters: &v1alpha1.ActionParametersParameters{FromValue: from},
+			})
+		case "always_use_https":
+			c.add(PhaseDynamicRedirect, v1alpha1.RulesParameters{
+				Ref: ptr.To(*ref(pr.ID, pr.Source) + "-https"), Expression: ptr.To(and(expr, "not ssl")), Enabled: enabled,
+				Action: ptr.To("redirect"),
+				ActionParameters: &v1alpha1.ActionParametersParameters{FromValue: &v1alpha1.FromValueParameters{
+					StatusCode:          ptr.To(float64(301)),
+					PreserveQueryString: ptr.To(false),
+					TargetURL: &v1alpha1.TargetURLParameters{
+						Expression: ptr.To(`wildcard_replace(http.request.full_uri, "http://*", "https://${1}")`),
+					},
+				}},
+			})
+
+		case "cache_level":
+			switch a.Value {
+			case "bypass":
+				cache.Cache = ptr.To(false)
+			case "cache_everything":
+				cache.Cache = ptr.To(true)
+			case "simplified":
+				cacheKey().CustomKey = &v1alpha1.CustomKeyParameters{QueryString: &v1alpha1.QueryStringParameters{
+					Exclude: &v1alpha1.ExcludeParameters{All: ptr.To(true)},
+				}}
+			case "aggressive":
+			default:
+				c.report(pr.Source, "the cache level %q has no equivalent and was dropped", a.Value)
+			}
+		case "edge_cache_ttl":
+			ttl, _ := a.Value.(float64)
+			edgeTTL().Mode, edgeTTL().Default = ptr.To("override_origin"), ptr.To(ttl)
+		case "browser_cache_ttl":
+			ttl, _ := a.Value.(float64)
+			cache.BrowserTTL = &v1alpha1.BrowserTTLParameters{Mode: ptr.To("respect_origin")}
+			if ttl > 0 {
+				cache.BrowserTTL = &v1alpha1.BrowserTTLParameters{Mode: ptr.To("override_origin"), Default: ptr.To(ttl)}
+			}
+		case "cache_ttl_by_status":
+			v, _ := a.Value.(map[string]any)
+			for _, code := range sortedKeys(v) {
+				s, err := statusCodeTTL(code, v[code])
+				if err != nil {
+					c.report(pr.Source, "the cache TTL of status %s was dropped: %s", code, err)
+					continue
+				}
+				edgeTTL().StatusCodeTTL = append(edgeTTL().StatusCodeTTL, s)
+			}
+		case "cache_deception_armor":
+			cacheKey().CacheDeceptionArmor = onOff(a.Value)
+		case "cache_by_device_type":
+			cacheKey().CacheByDeviceType = onOff(a.Value)
+		case "sort_query_string_for_cache":
+			cacheKey().IgnoreQueryStringsOrder = onOff(a.Value)
+		case "cache_key_fields":
+			v, _ := a.Value.(map[string]any)
+			cacheKey().CustomKey = c.customKey(pr.Source, v)
+		case "origin_error_page_pass_thru":
+			cache.OriginErrorPagePassthru = onOff(a.Value)
+		case "respect_strong_etag":
+			cache.RespectStrongEtags = onOff(a.Value)
+		case "explicit_cache_control":
+			cache.OriginCacheControl = onOff(a.Value)
+
+		case "automatic_https_rewrites":
+			config.AutomaticHTTPSRewrites = onOff(a.Value)
+		case "browser_check":
+			config.Bic = onOff(a.Value)
+		case "email_obfuscation":
+			config.EmailObfuscation = onOff(a.Value)
+		case "mirage":
+			config.Mirage = onOff(a.Value)
+		case "opportunistic_encryption":
+			config.OpportunisticEncryption = onOff(a.Value)
+		case "rocket_loader":
+			config.RocketLoader = onOff(a.Value)
+		case "polish", "security_level", "ssl":
+			s, _ := a.Value.(string)
+			switch a.ID {
+			case "polish":
+				config.Polish = ptr.To(s)
+			case "security_level":
+				config.SecurityLevel = ptr.To(s)
+			default:
+				config.SSL = ptr.To(s)
+			}
+		case "disable_apps":
+			config.DisableApps = ptr.To(true)
+		case "disable_zaraz":
+			config.DisableZaraz = ptr.To(true)
+		case "disable_performance":
+			config.Mirage, config.RocketLoader, config.Polish = ptr.To(false), ptr.To(false), ptr.To("off")
+
+		case "host_header_override":
+			s, _ := a.Value.(string)
+			origin.HostHeader = ptr.To(s)
+		case "resolve_override":
+			s, _ := a.Value.(string)
+			origin.Origin = &v1alpha1.OriginParameters{Host: ptr.To(s)}
+
+		case "ip_geolocation":
+			c.report(pr.Source, "%s has no rule equivalent, enable the add_visitor_location_headers managed transform instead", a.ID)
+		case "true_client_ip_header":
+			c.report(pr.Source, "%s has no rule equivalent, enable the add_true_client_ip_headers managed transform instead", a.ID)
+		case "bypass_cache_on_cookie", "cache_on_cookie":
+			c.report(pr.Source, "%s has no direct equivalent, add a cache rule matching the cookie with http.cookie instead", a.ID)
+		default:
+			c.report(pr.Source, "the setting %s has no equivalent and was dropped", a.ID)
+		}
+	}
+
+	for _, s := range []struct {
+		phase, action string
+		params        *v1alpha1.ActionParametersParameters
+	}{
+		{PhaseOrigin, "route", origin},
+		{PhaseCacheSettings, "set_cache_settings", cache},
+		{PhaseConfigSettings, "set_config", config},
+	} {
+		if reflect.DeepEqual(*s.params, v1alpha1.ActionParametersParameters{}) {
+			continue
+		}
+		c.add(s.phase, v1alpha1.RulesParameters{
+			Ref: ref(pr.ID, pr.Source), Expression: ptr.To(expr), Enabled: enabled,
+			Action: ptr.To(s.action), ActionParameters: s.params,
+		})
+	}
+}
+
+// customKey converts the cache key fields of a page rule.
+func (c *converter) customKey(source string, v map[string]any) *v1alpha1.CustomKeyParameters {
+	k := &v1alpha1.CustomKeyParameters{}
+	if m, ok := v["cookie"].(map[string]any); ok {
+		k.Cookie = &v1alpha1.CookieParameters{CheckPresence: stringList(m["check_presence"]), Include: stringList(m["include"])}
+	}
+	if m, ok := v["header"].(map[string]any); ok {
+		k.Header = &v1alpha1.HeaderParameters{CheckPresence: stringList(m["check_presence"]), Include: stringList(m["include"])}
+		if len(stringList(m["exclude"])) > 0 {
+			c.report(source, "excluding headers from the cache key has no equivalent, the excluded headers were dropped")
+		}
+	}
+	if m, ok := v["host"].(map[string]any); ok {
+		if r, ok := m["resolved"].(bool); ok {
+			k.Host = &v1alpha1.HostParameters{Resolved: ptr.To(r)}
+		}
+	}
+	if m, ok := v["query_string"].(map[string]any); ok {
+		include, exclude := stringList(m["include"]), stringList(m["exclude"])
+		k.QueryString = &v1alpha1.QueryStringParameters{}
+		switch {
+		case len(include) == 1 && *include[0] == "*":
+			k.QueryString.Include = &v1alpha1.IncludeParameters{All: ptr.To(true)}
+		case len(include) > 0:
+			k.QueryString.Include = &v1alpha1.IncludeParameters{List: include}
+		case len(exclude) == 1 && *exclude[0] == "*":
+			k.QueryString.Exclude = &v1alpha1.ExcludeParameters{All: ptr.To(true)}
+		case len(exclude) > 0:
+			k.QueryString.Exclude = &v1alpha1.ExcludeParameters{List: exclude}
+		}
+	}
+	if m, ok := v["user"].(map[string]any); ok {
+		k.User = &v1alpha1.UserParameters{}
+		for f, p := range map[string]**bool{"device_type": &k.User.DeviceType, "geo": &k.User.Geo, "lang": &k.User.Lang} {
+			if b, ok := m[f].(bool); ok {
+				*p = ptr.To(b)
+			}
+		}
+	}
+	return k
+}
+
+// statusCodeTTL converts an entry of the cache TTLs by status of a page
+// rule, e.g. "400-499": "no-cache".
+func statusCodeTTL(code string, value any) (v1alpha1.StatusCodeTTLParameters, error) {
+	s := v1alpha1.StatusCodeTTLParameters{}
+	switch v := value.(type) {
+	case float64:
+		s.Value = ptr.To(v)
+	case string:
+		switch v {
+		case "no-cache":
+			s.Value = ptr.To(float64(0))
+		case "no-store":
+			s.Value = ptr.To(float64(-1))
+		default:
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return s, fmt.Errorf("invalid TTL %q", v)
+			}
+			s.Value = ptr.To(float64(n))
+		}
+	default:
+		return s, fmt.Errorf("invalid TTL %v", value)
+	}
+	from, to, isRange := strings.Cut(code, "-")
+	f, err := strconv.Atoi(from)
+	if err != nil {
+		return s, fmt.Errorf("invalid status %q", code)
+	}
+	if !isRange {
+		s.StatusCode = ptr.To(float64(f))
+		return s, nil
+	}
+	t, err := strconv.Atoi(to)
+	if err != nil {
+		return s, fmt.Errorf("invalid status range %q", code)
+	}
+	s.StatusCodeRange = &v1alpha1.StatusCodeRangeParameters{From: ptr.To(float64(f)), To: ptr.To(float64(t))}
+	return s, nil
+}
+
+// onOff converts the value of an on or off setting of a page rule.
+func onOff(v any) *bool {
+	switch b := v.(type) {
+	case bool:
+		return ptr.To(b)
+	case string:
+		return ptr.To(b == "on")
+	}
+	return nil
+}
+
+func stringList(v any) []*string {
+	l, _ := v.([]any)
+	var out []*string
+	for _, e := range l {
+		if s, ok := e.(string); ok {
+			out = append(out, ptr.To(s))
+		}
+	}
+	return out
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// objectName turns the given string into a valid Kubernetes object name.
+func objectName(s string) string {
+	s = invalidNameChars.ReplaceAllString(strings.ToLower(s), "-")
+	s = strings.Trim(s, "-")
+	if len(s) > 63 {
+		s = strings.TrimRight(s[:63], "-")
+	}
+	return s
+}
@@ -0,0 +1,370 @@
+package legacyrules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"k8s.io/utils/ptr"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+)
+
+// converted returns the rules of the converted rulesets by phase, and the
+// findings.
+func converted(t *testing.T, z *Zone) (map[string][]v1alpha1.RulesParameters, []string) {
+	t.Helper()
+	res := Convert(z, Options{})
+	rules := map[string][]v1alpha1.RulesParameters{}
+	for _, o := range res.Objects {
+		rs := o.(*v1alpha1.Ruleset)
+		if ptr.Deref(rs.Spec.ForProvider.ZoneID, "") != z.ID {
+			t.Errorf("Convert(...): ruleset %s of zone %v, want %s", rs.Name, ptr.Deref(rs.Spec.ForProvider.ZoneID, ""), z.ID)
+		}
+		rules[*rs.Spec.ForProvider.Phase] = rs.Spec.ForProvider.Rules
+	}
+	var findings []string
+	for _, f := range res.Findings {
+		findings = append(findings, f.String())
+	}
+	return rules, findings
+}
+
+func firewallRule(id, action, expression string) FirewallRule {
+	fr := FirewallRule{Source: "firewall rule " + id}
+	fr.ID, fr.Action, fr.Filter.Expression = id, action, expression
+	return fr
+}
+
+func TestConvertFirewallRules(t *testing.T) {
+	cases := map[string]struct {
+		rules    []FirewallRule
+		unused   []string
+		want     map[string][]v1alpha1.RulesParameters
+		findings []string
+	}{
+		"Order": {
+			rules: func() []FirewallRule {
+				block := firewallRule("block", "block", "ip.src eq 192.0.2.1")
+				allow := firewallRule("allow", "allow", `http.host eq "example.com"`)
+				allow.Filter.Description = "Allow the apex"
+				challenge := firewallRule("challenge", "challenge", `http.request.uri.path eq "/login"`)
+				challenge.Priority, challenge.Paused = float64(1), true
+				return []FirewallRule{block, allow, challenge}
+			}(),
+			want: map[string][]v1alpha1.RulesParameters{PhaseFirewallCustom: {
+				{
+					Ref:        ptr.To("migrated-challenge"),
+					Expression: ptr.To(`http.request.uri.path eq "/login"`),
+					Enabled:    ptr.To(false),
+					Action:     ptr.To("challenge"),
+				},
+				{
+					Ref:              ptr.To("migrated-allow"),
+					Expression:       ptr.To(`http.host eq "example.com"`),
+					Enabled:          ptr.To(true),
+					Description:      ptr.To("Allow the apex"),
+					Action:           ptr.To("skip"),
+					ActionParameters: &v1alpha1.ActionParametersParameters{Ruleset: ptr.To("current")},
+				},
+				{
+					Ref:        ptr.To("migrated-block"),
+					Expression: ptr.To("ip.src eq 192.0.2.1"),
+					Enabled:    ptr.To(true),
+					Action:     ptr.To("block"),
+				},
+			}},
+		},
+		"Bypass": {
+			rules: func() []FirewallRule {
+				fr := firewallRule("bypass", "bypass", `http.request.uri.path eq "/health"`)
+				fr.Products = []string{"rateLimit", "waf", "uaBlock"}
+				return []FirewallRule{fr}
+			}(),
+			want: map[string][]v1alpha1.RulesParameters{PhaseFirewallCustom: {{
+				Ref:        ptr.To("migrated-bypass"),
+				Expression: ptr.To(`http.request.uri.path eq "/health"`),
+				Enabled:    ptr.To(true),
+				Action:     ptr.To("skip"),
+				ActionParameters: &v1alpha1.ActionParametersParameters{
+					Phases:   []*string{ptr.To(PhaseRatelimit)},
+					Products: []*string{ptr.To("waf"), ptr.To("uaBlock")},
+				},
+			}}},
+			findings: []string{
+				"firewall rule bypass: bypasses the legacy WAF, add http_request_firewall_managed to the phases of the skip rule to skip the WAF managed rules",
+			},
+		},
+		"Untranslatable": {
+			rules: []FirewallRule{
+				firewallRule("empty", "block", ""),
+				firewallRule("unknown", "redirect", `http.host eq "example.com"`),
+			},
+			unused: []string{"filter unused"},
+			want:   map[string][]v1alpha1.RulesParameters{},
+			findings: []string{
+				"filter unused: not used by any firewall rule, there is nothing to migrate",
+				`firewall rule unknown: the action "redirect" has no equivalent and the rule was not migrated`,
+				"firewall rule empty: has no filter expression and was not migrated",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, findings := converted(t, &Zone{ID: "zone", FirewallRules: tc.rules, UnusedFilters: tc.unused})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Convert(...): got %+v, want %+v", got, tc.want)
+			}
+			if !reflect.DeepEqual(findings, tc.findings) {
+				t.Errorf("Convert(...): findings %q, want %q", findings, tc.findings)
+			}
+		})
+	}
+}
+
+func rateLimit(id, url string, threshold, period int, mode string, timeout int) RateLimit {
+	rl := RateLimit{Source: "rate limit " + id}
+	rl.ID, rl.Match.Request.URLPattern, rl.Threshold, rl.Period = id, url, threshold, period
+	rl.Action.Mode, rl.Action.Timeout = mode, timeout
+	return rl
+}
+
+func ratelimit(period, requests, timeout float64) *v1alpha1.RatelimitParameters {
+	return &v1alpha1.RatelimitParameters{
+		Characteristics:   []*string{ptr.To("ip.src"), ptr.To("cf.colo.id")},
+		Period:            ptr.To(period),
+		RequestsPerPeriod: ptr.To(requests),
+		RequestsToOrigin:  ptr.To(true),
+		MitigationTimeout: ptr.To(timeout),
+	}
+}
+
+func TestConvertRateLimits(t *testing.T) {
+	cases := map[string]struct {
+		limits   []RateLimit
+		want     []v1alpha1.RulesParameters
+		findings []string
+	}{
+		"Ban": {
+			limits: func() []RateLimit {
+				rl := rateLimit("ban", "example.com/api/*", 100, 60, "ban", 600)
+				rl.Description = "Limit the API"
+				rl.Match.Request.Methods, rl.Match.Request.Schemes = []string{"POST", "PUT"}, []string{"HTTPS"}
+				rl.Bypass = []cloudflare.RateLimitKeyValue{{Name: "url", Value: "example.com/api/health"}}
+				rl.Action.Response = &cloudflare.RateLimitActionResponse{ContentType: "application/json", Body: `{"error":"slow down"}`}
+				return []RateLimit{rl}
+			}(),
+			want: []v1alpha1.RulesParameters{{
+				Ref:         ptr.To("migrated-ban"),
+				Expression:  ptr.To(`http.host eq "example.com" and http.request.uri.path wildcard "/api/*" and http.request.method in {"POST" "PUT"} and ssl and not (http.host eq "example.com" and http.request.uri.path eq "/api/health")`),
+				Enabled:     ptr.To(true),
+				Description: ptr.To("Limit the API"),
+				Action:      ptr.To("block"),
+				ActionParameters: &v1alpha1.ActionParametersParameters{Response: &v1alpha1.ResponseParameters{
+					StatusCode:  ptr.To(float64(429)),
+					ContentType: ptr.To("application/json"),
+					Content:     ptr.To(`{"error":"slow down"}`),
+				}},
+				Ratelimit: ratelimit(60, 100, 600),
+			}},
+		},
+		"UnsupportedPeriodAndTimeout": {
+			limits: func() []RateLimit {
+				rl := rateLimit("simulate", "*", 10, 30, "simulate", 30)
+				rl.Disabled = true
+				return []RateLimit{rl}
+			}(),
+			want: []v1alpha1.RulesParameters{{
+				Ref:        ptr.To("migrated-simulate"),
+				Expression: ptr.To("true"),
+				Enabled:    ptr.To(false),
+				Action:     ptr.To("log"),
+				Ratelimit:  ratelimit(60, 20, 60),
+			}},
+			findings: []string{
+				"rate limit simulate: a period of 30s is not supported, counting 20 requests over 60s instead of 10 over 30s",
+				"rate limit simulate: a timeout of 30s is not supported, mitigating for 60s instead",
+			},
+		},
+		"ResponseMatching": {
+			limits: func() []RateLimit {
+				rl := rateLimit("login", "example.com/login", 5, 60, "managed_challenge", 0)
+				rl.Match.Response.Statuses = []int{401, 403}
+				rl.Match.Response.Headers = []cloudflare.RateLimitResponseMatcherHeader{{Name: "X-Cache", Op: "ne", Value: "HIT"}}
+				rl.Correlate = &cloudflare.RateLimitCorrelate{By: "nat"}
+				return []RateLimit{rl}
+			}(),
+			want: []v1alpha1.RulesParameters{{
+				Ref:        ptr.To("migrated-login"),
+				Expression: ptr.To(`http.host eq "example.com" and http.request.uri.path eq "/login"`),
+				Enabled:    ptr.To(true),
+				Action:     ptr.To("managed_challenge"),
+				Ratelimit: func() *v1alpha1.RatelimitParameters {
+					r := ratelimit(60, 5, 0)
+					r.CountingExpression = ptr.To(`http.host eq "example.com" and http.request.uri.path eq "/login" and http.response.code in {401 403} and not any(http.response.headers["x-cache"][*] eq "HIT")`)
+					return r
+				}(),
+			}},
+			findings: []string{
+				"rate limit login: counts requests by response status or headers, which requires Advanced Rate Limiting on some plans",
+				`rate limit login: correlation by "nat" has no equivalent, requests are counted per IP address`,
+			},
+		},
+		"Untranslatable": {
+			limits: func() []RateLimit {
+				rl := rateLimit("unknown", "example.com/*", 10, 60, "redirect", 0)
+				rl.Bypass = []cloudflare.RateLimitKeyValue{{Name: "header", Value: "X-Bypass"}}
+				return []RateLimit{rl}
+			}(),
+			findings: []string{
+				`rate limit unknown: the bypass on "header" has no equivalent and was dropped`,
+				`rate limit unknown: the action "redirect" has no equivalent and the rate limit was not migrated`,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, findings := converted(t, &Zone{ID: "zone", RateLimits: tc.limits})
+			if !reflect.DeepEqual(got[PhaseRatelimit], tc.want) {
+				t.Errorf("Convert(...): got %+v, want %+v", got[PhaseRatelimit], tc.want)
+			}
+			if !reflect.DeepEqual(findings, tc.findings) {
+				t.Errorf("Convert(...): findings %q, want %q", findings, tc.findings)
+			}
+		})
+	}
+}
+
+func pageRule(id, target string, priority int, actions ...cloudflare.PageRuleAction) PageRule {
+	pr := PageRule{Source: "page rule " + id}
+	pr.ID, pr.Priority, pr.Status, pr.Actions = id, priority, "active", actions
+	t := cloudflare.PageRuleTarget{Target: "url"}
+	t.Constraint.Operator, t.Constraint.Value = "matches", target
+	pr.Targets = []cloudflare.PageRuleTarget{t}
+	return pr
+}
+
+func TestConvertPageRules(t *testing.T) {
+	cases := map[string]struct {
+		rules    []PageRule
+		want     map[string][]v1alpha1.RulesParameters
+		findings []string
+	}{
+		"Forwarding": {
+			rules: []PageRule{pageRule("old", "example.com/old/*", 1, cloudflare.PageRuleAction{
+				ID: "forwarding_url", Value: map[string]any{"url": "https://example.com/new/$1", "status_code": float64(301)},
+			})},
+			want: map[string][]v1alpha1.RulesParameters{PhaseDynamicRedirect: {{
+				Ref:        ptr.To("migrated-old"),
+				Expression: ptr.To(`http.request.full_uri wildcard "http*://example.com/old/*"`),
+				Enabled:    ptr.To(true),
+				Action:     ptr.To("redirect"),
+				ActionParameters: &v1alpha1.ActionParametersParameters{FromValue: &v1alpha1.FromValueParameters{
+					StatusCode:          ptr.To(float64(301)),
+					PreserveQueryString: ptr.To(false),
+					TargetURL: &v1alpha1.TargetURLParameters{
+						Expression: ptr.To(`wildcard_replace(http.request.full_uri, "http*://example.com/old/*", "https://example.com/new/${2}")`),
+					},
+				}},
+			}}},
+		},
+		"AlwaysUseHTTPS": {
+			rules: func() []PageRule {
+				pr := pageRule("https", "http://example.com", 1, cloudflare.PageRuleAction{ID: "always_use_https"})
+				pr.Status = "disabled"
+				return []PageRule{pr}
+			}(),
+			want: map[string][]v1alpha1.RulesParameters{PhaseDynamicRedirect: {{
+				Ref:        ptr.To("migrated-https-https"),
+				Expression: ptr.To(`http.request.full_uri wildcard "http://example.com/" and not ssl`),
+				Enabled:    ptr.To(false),
+				Action:     ptr.To("redirect"),
+				ActionParameters: &v1alpha1.ActionParametersParameters{FromValue: &v1alpha1.FromValueParameters{
+					StatusCode:          ptr.To(float64(301)),
+					PreserveQueryString: ptr.To(false),
+					TargetURL: &v1alpha1.TargetURLParameters{
+						Expression: ptr.To(`wildcard_replace(http.request.full_uri, "http://*", "https://${1}")`),
+					},
+				}},
+			}}},
+		},
+		"Settings": {
+			rules: []PageRule{pageRule("static", "example.com/static/*", 1,
+				cloudflare.PageRuleAction{ID: "cache_level", Value: "cache_everything"},
+				cloudflare.PageRuleAction{ID: "edge_cache_ttl", Value: float64(7200)},
+				cloudflare.PageRuleAction{ID: "cache_ttl_by_status", Value: map[string]any{"200": float64(3600), "400-499": "no-cache"}},
+				cloudflare.PageRuleAction{ID: "browser_cache_ttl", Value: float64(0)},
+				cloudflare.PageRuleAction{ID: "ssl", Value: "full"},
+				cloudflare.PageRuleAction{ID: "rocket_loader", Value: "off"},
+				cloudflare.PageRuleAction{ID: "host_header_override", Value: "static.example.net"},
+			)},
+			want: func() map[string][]v1alpha1.RulesParameters {
+				rule := func(action string, p *v1alpha1.ActionParametersParameters) []v1alpha1.RulesParameters {
+					return []v1alpha1.RulesParameters{{
+						Ref:              ptr.To("migrated-static"),
+						Expression:       ptr.To(`http.request.full_uri wildcard "http*://example.com/static/*"`),
+						Enabled:          ptr.To(true),
+						Action:           ptr.To(action),
+						ActionParameters: p,
+					}}
+				}
+				return map[string][]v1alpha1.RulesParameters{
+					PhaseOrigin: rule("route", &v1alpha1.ActionParametersParameters{HostHeader: ptr.To("static.example.net")}),
+					PhaseCacheSettings: rule("set_cache_settings", &v1alpha1.ActionParametersParameters{
+						Cache: ptr.To(true),
+						EdgeTTL: &v1alpha1.EdgeTTLParameters{
+							Mode:    ptr.To("override_origin"),
+							Default: ptr.To(float64(7200)),
+							StatusCodeTTL: []v1alpha1.StatusCodeTTLParameters{
+								{StatusCode: ptr.To(float64(200)), Value: ptr.To(float64(3600))},
+								{StatusCodeRange: &v1alpha1.StatusCodeRangeParameters{From: ptr.To(float64(400)), To: ptr.To(float64(499))}, Value: ptr.To(float64(0))},
+							},
+						},
+						BrowserTTL: &v1alpha1.BrowserTTLParameters{Mode: ptr.To("respect_origin")},
+					}),
+					PhaseConfigSettings: rule("set_config", &v1alpha1.ActionParametersParameters{
+						SSL:          ptr.To("full"),
+						RocketLoader: ptr.To(false),
+					}),
+				}
+			}(),
+		},
+		"Untranslatable": {
+			rules: []PageRule{
+				func() PageRule {
+					pr := pageRule("targets", "example.com/*", 2, cloudflare.PageRuleAction{ID: "ssl", Value: "strict"})
+					pr.Targets = append(pr.Targets, pr.Targets[0])
+					return pr
+				}(),
+				pageRule("settings", "example.com/*", 1,
+					cloudflare.PageRuleAction{ID: "cache_level", Value: "unknown"},
+					cloudflare.PageRuleAction{ID: "cache_ttl_by_status", Value: map[string]any{"2xx": float64(60)}},
+					cloudflare.PageRuleAction{ID: "ip_geolocation", Value: "on"},
+					cloudflare.PageRuleAction{ID: "cache_on_cookie", Value: "session=.*"},
+					cloudflare.PageRuleAction{ID: "always_online", Value: "on"},
+				),
+			},
+			want: map[string][]v1alpha1.RulesParameters{},
+			findings: []string{
+				"page rules: only the first matching page rule applied, while the settings of all the matching rules apply, review the page rules with overlapping targets",
+				`page rule settings: the cache level "unknown" has no equivalent and was dropped`,
+				`page rule settings: the cache TTL of status 2xx was dropped: invalid status "2xx"`,
+				"page rule settings: ip_geolocation has no rule equivalent, enable the add_visitor_location_headers managed transform instead",
+				"page rule settings: cache_on_cookie has no direct equivalent, add a cache rule matching the cookie with http.cookie instead",
+				"page rule settings: the setting always_online has no equivalent and was dropped",
+				"page rule targets: only a single URL target matching a pattern is supported, the page rule was not migrated",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, findings := converted(t, &Zone{ID: "zone", PageRules: tc.rules})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Convert(...): got %+v, want %+v", got, tc.want)
+			}
+			if !reflect.DeepEqual(findings, tc.findings) {
+				t.Errorf("Convert(...): findings %q, want %q", findings, tc.findings)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package legacyrules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// quote returns the given string as a string literal of the Rules language.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// set returns a set of string literals, e.g. {"GET" "POST"}.
+func set(values []string) string {
+	q := make([]string, len(values))
+	for i, v := range values {
+		q[i] = quote(v)
+	}
+	return "{" + strings.Join(q, " ") + "}"
+}
+
+// and joins the given expressions, parenthesizing those holding an or.
+func and(exprs ...string) string {
+	var parts []string
+	for _, e := range exprs {
+		switch {
+		case e == "":
+		case strings.Contains(e, " or "):
+			parts = append(parts, "("+e+")")
+		default:
+			parts = append(parts, e)
+		}
+	}
+	return strings.Join(parts, " and ")
+}
+
+// urlPattern returns the expression matching the URL pattern of a rate
+// limit, e.g. example.org/api/*, or an empty string if it matches all the
+// traffic of the zone.
+func urlPattern(pattern string) string {
+	pattern = strings.TrimPrefix(strings.TrimPrefix(pattern, "https://"), "http://")
+	host, path, _ := strings.Cut(pattern, "/")
+	path = "/" + path
+	var exprs []string
+	switch {
+	case host == "" || host == "*":
+	case strings.Contains(host, "*"):
+		exprs = append(exprs, "http.host wildcard "+quote(host))
+	default:
+		exprs = append(exprs, "http.host eq "+quote(host))
+	}
+	switch {
+	case path == "/" && !strings.Contains(pattern, "/"), path == "/*":
+	case strings.Contains(path, "*"):
+		exprs = append(exprs, "http.request.uri.path wildcard "+quote(path))
+	default:
+		exprs = append(exprs, "http.request.uri.path eq "+quote(path))
+	}
+	return and(exprs...)
+}
+
+// targetPattern returns the wildcard pattern matching the full URI of the
+// requests a page rule target matches, and the number of wildcards added in
+// front of the wildcards of the target.
+func targetPattern(target string) (string, int) {
+	added := 0
+	if !strings.Contains(target, "://") {
+		target = "http*://" + target
+		added = 1
+	}
+	if _, rest, _ := strings.Cut(target, "://"); !strings.Contains(rest, "/") {
+		target += "/"
+	}
+	return target, added
+}
+
+var placeholder = regexp.MustCompile(`\$([0-9]+)`)
+
+// forwardingTarget returns the expression building the target URL of a
+// page rule forwarding URL, replacing the $n placeholders with the n-th
+// wildcard of the target, or an empty string if the URL is static.
+func forwardingTarget(pattern string, added int, url string) string {
+	if !placeholder.MatchString(url) {
+		return ""
+	}
+	replacement := placeholder.ReplaceAllStringFunc(url, func(m string) string {
+		n, _ := strconv.Atoi(m[1:])
+		return fmt.Sprintf("${%d}", n+added)
+	})
+	return fmt.Sprintf("wildcard_replace(http.request.full_uri, %s, %s)", quote(pattern), quote(replacement))
+}
+
+// responseHeader returns the expression matching a response header of a
+// rate limit.
+func responseHeader(name, op, value string) string {
+	e := fmt.Sprintf("any(http.response.headers[%s][*] eq %s)", quote(strings.ToLower(name)), quote(value))
+	if op == "ne" {
+		return "not " + e
+	}
+	return e
+}
@@ -0,0 +1,311 @@
+// Package legacyrules converts the deprecated firewall rules, filters, rate
+// limits and page rules of a zone into rulesets of the equivalent phases.
+// The legacy configuration is read either from the managed resources of a
+// cluster or from the live zone, and is handled in the format of the API in
+// both cases.
+package legacyrules
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cloudflarev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/cloudflare/v1alpha1"
+	firewallv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/firewall/v1alpha1"
+	pagev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/page/v1alpha1"
+	ratev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/rate/v1alpha1"
+)
+
+const (
+	errListFirewallRules = "cannot list the firewall rules"
+	errListFilters       = "cannot list the filters"
+	errListRateLimits    = "cannot list the rate limits"
+	errListPageRules     = "cannot list the page rules"
+	errDecode            = "cannot decode %s"
+)
+
+// FirewallRule is a legacy firewall rule and its filter.
+type FirewallRule struct {
+	// Source names the managed resource or the API object the rule was
+	// read from.
+	Source string
+	cloudflare.FirewallRule
+}
+
+// RateLimit is a legacy rate limit.
+type RateLimit struct {
+	// Source names the managed resource or the API object the rate limit
+	// was read from.
+	Source string
+	cloudflare.RateLimit
+}
+
+// PageRule is a page rule.
+type PageRule struct {
+	// Source names the managed resource or the API object the page rule was
+	// read from.
+	Source string
+	cloudflare.PageRule
+}
+
+// Zone is the legacy configuration of a zone.
+type Zone struct {
+	ID            string
+	FirewallRules []FirewallRule
+	RateLimits    []RateLimit
+	PageRules     []PageRule
+	// UnusedFilters are the filters no firewall rule uses. They have no
+	// effect and are only reported.
+	UnusedFilters []string
+}
+
+// FromAPI reads the legacy configuration of the given zone from the API.
+func FromAPI(ctx context.Context, api *cloudflare.API, zoneID string) (*Zone, error) {
+	z := &Zone{ID: zoneID}
+	rc := cloudflare.ZoneIdentifier(zoneID)
+
+	frs, _, err := api.FirewallRules(ctx, rc, cloudflare.FirewallRuleListParams{})
+	if err != nil {
+		return nil, errors.Wrap(err, errListFirewallRules)
+	}
+	used := map[string]bool{}
+	for _, r := range frs {
+		z.FirewallRules = append(z.FirewallRules, FirewallRule{Source: "firewall rule " + r.ID, FirewallRule: r})
+		used[r.Filter.ID] = true
+	}
+	filters, _, err := api.Filters(ctx, rc, cloudflare.FilterListParams{})
+	if err != nil {
+		return nil, errors.Wrap(err, errListFilters)
+	}
+	for _, f := range filters {
+		if !used[f.ID] {
+			z.UnusedFilters = append(z.UnusedFilters, "filter "+f.ID)
+		}
+	}
+
+	rls, err := api.ListAllRateLimits(ctx, zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, errListRateLimits)
+	}
+	for _, r := range rls {
+		z.RateLimits = append(z.RateLimits, RateLimit{Source: "rate limit " + r.ID, RateLimit: r})
+	}
+
+	prs, err := api.ListPageRules(ctx, zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, errListPageRules)
+	}
+	for _, r := range prs {
+		z.PageRules = append(z.PageRules, PageRule{Source: "page rule " + r.ID, PageRule: r})
+	}
+	return z, nil
+}
+
+// terraformed is a managed resource holding Terraform parameters and
+// observations.
+type terraformed interface {
+	client.Object
+	GetParameters() (map[string]any, error)
+	GetObservation() (map[string]any, error)
+}
+
+// FromCluster reads the legacy configuration of every zone from the
+// firewall.Rule, cloudflare.Filter, rate.Limit and page.Rule managed
+// resources of a cluster. If zone IDs are given, only these zones are read.
+// The observed state of a resource is overlaid by its desired state.
+func FromCluster(ctx context.Context, c client.Reader, zoneIDs ...string) ([]*Zone, error) {
+	zones := map[string]*Zone{}
+	zone := func(id string) *Zone {
+		if len(zoneIDs) > 0 && !slices.Contains(zoneIDs, id) {
+			return nil
+		}
+		if zones[id] == nil {
+			zones[id] = &Zone{ID: id}
+		}
+		return zones[id]
+	}
+
+	filters := &cloudflarev1alpha1.FilterList{}
+	if err := c.List(ctx, filters); err != nil {
+		return nil, errors.Wrap(err, errListFilters)
+	}
+	expressions := map[string]string{}
+	for i := range filters.Items {
+		f := filters.Items[i]
+		if id := ptr.Deref(f.Status.AtProvider.ID, ""); id != "" {
+			expressions[id] = ptr.Deref(f.Spec.ForProvider.Expression, "")
+		}
+	}
+
+	frs := &firewallv1alpha1.RuleList{}
+	if err := c.List(ctx, frs); err != nil {
+		return nil, errors.Wrap(err, errListFirewallRules)
+	}
+	used := map[string]bool{}
+	for i := range frs.Items {
+		m, err := merged(&frs.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		// The action is an object holding the mode in the schema of the
+		// resource, and a string in the API.
+		if a, ok := m["action"].(map[string]any); ok {
+			m["action"] = a["mode"]
+		}
+		r := FirewallRule{Source: source("firewall.Rule", &frs.Items[i])}
+		if err := decode(m, &r.FirewallRule, r.Source); err != nil {
+			return nil, err
+		}
+		used[r.Filter.ID] = true
+		if r.Filter.Expression == "" {
+			r.Filter.Expression = expressions[r.Filter.ID]
+		}
+		if z := zone(ptr.Deref(frs.Items[i].Spec.ForProvider.ZoneID, "")); z != nil {
+			z.FirewallRules = append(z.FirewallRules, r)
+		}
+	}
+	for i := range filters.Items {
+		f := &filters.Items[i]
+		if used[ptr.Deref(f.Status.AtProvider.ID, "")] {
+			continue
+		}
+		if z := zone(ptr.Deref(f.Spec.ForProvider.ZoneID, "")); z != nil {
+			z.UnusedFilters = append(z.UnusedFilters, source("cloudflare.Filter", f))
+		}
+	}
+
+	rls := &ratev1alpha1.LimitList{}
+	if err := c.List(ctx, rls); err != nil {
+		return nil, errors.Wrap(err, errListRateLimits)
+	}
+	for i := range rls.Items {
+		m, err := merged(&rls.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		// The response headers are matched at the top level of the match
+		// in the schema of the resource.
+		if match, ok := m["match"].(map[string]any); ok {
+			if h, ok := match["headers"]; ok {
+				resp, _ := match["response"].(map[string]any)
+				if resp == nil {
+					resp = map[string]any{}
+				}
+				resp["headers"] = h
+				match["response"] = resp
+			}
+		}
+		r := RateLimit{Source: source("rate.Limit", &rls.Items[i])}
+		if err := decode(m, &r.RateLimit, r.Source); err != nil {
+			return nil, err
+		}
+		if z := zone(ptr.Deref(rls.Items[i].Spec.ForProvider.ZoneID, "")); z != nil {
+			z.RateLimits = append(z.RateLimits, r)
+		}
+	}
+
+	prs := &pagev1alpha1.RuleList{}
+	if err := c.List(ctx, prs); err != nil {
+		return nil, errors.Wrap(err, errListPageRules)
+	}
+	for i := range prs.Items {
+		m, err := merged(&prs.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		// The target is a single URL pattern and the actions are an
+		// object in the schema of the resource. Flags are booleans rather
+		// than actions without a value.
+		if t, ok := m["target"].(string); ok {
+			m["targets"] = []any{map[string]any{
+				"target":     "url",
+				"constraint": map[string]any{"operator": "matches", "value": t},
+			}}
+		}
+		if a, ok := m["actions"].(map[string]any); ok {
+			var actions []any
+			for _, k := range sortedKeys(a) {
+				switch v := a[k].(type) {
+				case nil:
+				case bool:
+					if v {
+						actions = append(actions, map[string]any{"id": k})
+					}
+				default:
+					actions = append(actions, map[string]any{"id": k, "value": v})
+				}
+			}
+			m["actions"] = actions
+		}
+		r := PageRule{Source: source("page.Rule", &prs.Items[i])}
+		if err := decode(m, &r.PageRule, r.Source); err != nil {
+			return nil, err
+		}
+		if z := zone(ptr.Deref(prs.Items[i].Spec.ForProvider.ZoneID, "")); z != nil {
+			z.PageRules = append(z.PageRules, r)
+		}
+	}
+
+	out := make([]*Zone, 0, len(zones))
+	for _, id := range sortedKeys(zones) {
+		out = append(out, zones[id])
+	}
+	return out, nil
+}
+
+// merged returns the observation of the given resource overlaid by its
+// parameters.
+func merged(mg terraformed) (map[string]any, error) {
+	obs, err := mg.GetObservation()
+	if err != nil {
+		return nil, errors.Wrapf(err, errDecode, mg.GetName())
+	}
+	params, err := mg.GetParameters()
+	if err != nil {
+		return nil, errors.Wrapf(err, errDecode, mg.GetName())
+	}
+	return overlay(obs, params), nil
+}
+
+func overlay(base, over map[string]any) map[string]any {
+	out := map[string]any{}
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range over {
+		b, bok := out[k].(map[string]any)
+		o, ook := v.(map[string]any)
+		if bok && ook {
+			out[k] = overlay(b, o)
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+func decode(m map[string]any, into any, source string) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrapf(err, errDecode, source)
+	}
+	return errors.Wrapf(json.Unmarshal(b, into), errDecode, source)
+}
+
+func source(kind string, o client.Object) string {
+	return kind + "/" + o.GetName()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,48 @@
+// Package manifests renders managed resources as the YAML manifests written
+// by the conversion commands of this provider.
+package manifests
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	errMarshal = "cannot marshal %s %s"
+)
+
+// MarshalYAML renders the given objects as a multi-document YAML stream,
+// without their empty status, initProvider and creation timestamp.
+func MarshalYAML(objs []runtime.Object) ([]byte, error) {
+	var b strings.Builder
+	for _, o := range objs {
+		kind, name := o.GetObjectKind().GroupVersionKind().Kind, ""
+		if m, ok := o.(metav1.Object); ok {
+			name = m.GetName()
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, errors.Wrapf(err, errMarshal, kind, name)
+		}
+		delete(u, "status")
+		if m, ok := u["metadata"].(map[string]any); ok {
+			delete(m, "creationTimestamp")
+		}
+		if s, ok := u["spec"].(map[string]any); ok {
+			if ip, ok := s["initProvider"].(map[string]any); ok && len(ip) == 0 {
+				delete(s, "initProvider")
+			}
+		}
+		y, err := yaml.Marshal(u)
+		if err != nil {
+			return nil, errors.Wrapf(err, errMarshal, kind, name)
+		}
+		b.WriteString("---\n")
+		b.Write(y)
+	}
+	return []byte(b.String()), nil
+}
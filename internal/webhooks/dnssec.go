@@ -0,0 +1,83 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dnsv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/dns/v1alpha1"
+	"gitlab.com/jarvisai.run/provider-cloudflare/apis/zone/v1alpha1"
+)
+
+// DNSSECDisableValidationPath is the path the DNSSEC disable validation
+// webhook is served at. It must match
+// package/webhookconfigurations/manifests.yaml.
+const DNSSECDisableValidationPath = "/validate-dnssec-disable"
+
+// statusDisabled is the status of disabled DNSSEC.
+const statusDisabled = "disabled"
+
+// SetupDNSSECDisableValidation registers the webhook that rejects deleting
+// or disabling a DNSSEC while a DNSSECDelegation publishes its DS record to
+// the parent zone, so that resolvers are never told to expect signatures of
+// a zone that is no longer signed.
+func SetupDNSSECDisableValidation(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(DNSSECDisableValidationPath, &admission.Webhook{Handler: &dnssecDisableValidator{kube: mgr.GetClient()}})
+	return nil
+}
+
+type dnssecDisableValidator struct {
+	kube client.Reader
+}
+
+// Handle rejects the deletion of a DNSSEC, or an update setting its status
+// to disabled, while DS records created for it exist.
+func (v *dnssecDisableValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Kind.Group != v1alpha1.CRDGroup || req.Kind.Kind != v1alpha1.DNSSEC_Kind {
+		return admission.Allowed("")
+	}
+	switch req.Operation {
+	case admissionv1.Delete:
+	case admissionv1.Update:
+		obj, err := pave(req.Object.Raw)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		old, err := pave(req.OldObject.Raw)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		status, _ := obj.GetString("spec.forProvider.status")
+		prev, _ := old.GetString("spec.forProvider.status")
+		if status != statusDisabled || prev == statusDisabled {
+			return admission.Allowed("")
+		}
+	default:
+		return admission.Allowed("")
+	}
+
+	l := &dnsv1alpha1.RecordList{}
+	if err := v.kube.List(ctx, l, client.MatchingLabels{v1alpha1.LabelDNSSEC: req.Name}); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(l.Items) == 0 {
+		return admission.Allowed("")
+	}
+	records := make([]string, 0, len(l.Items))
+	delegations := map[string]bool{}
+	for _, r := range l.Items {
+		records = append(records, r.GetName())
+		delegations[r.GetLabels()[v1alpha1.LabelDNSSECDelegation]] = true
+	}
+	names := slices.Sorted(maps.Keys(delegations))
+	return admission.Denied(fmt.Sprintf("DNSSEC %s cannot be disabled while its DS record is published to the parent zone by records %s; delete DNSSECDelegation %s first",
+		req.Name, strings.Join(records, ", "), strings.Join(names, ", ")))
+}
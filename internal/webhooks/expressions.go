@@ -0,0 +1,130 @@
+// Package webhooks contains the admission webhooks of the provider.
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"gitlab.com/jarvisai.run/provider-cloudflare/pkg/rules"
+)
+
+// ExpressionValidationPath is the path the expression validation webhook is
+// served at. It must match package/webhookconfigurations/manifests.yaml.
+const ExpressionValidationPath = "/validate-rules-expressions"
+
+// expressionField is a field holding a Rules language expression.
+type expressionField struct {
+	path string
+	// value is true for a value expression of a transform or redirect
+	// rule, false for a filter expression.
+	value bool
+}
+
+// expressionFields are the fields holding Rules language expressions, below
+// spec.forProvider and spec.initProvider, per kind.
+var expressionFields = map[schema.GroupKind][]expressionField{
+	{Group: "cloudflare.cloudflare.crossplane.io", Kind: "Ruleset"}: {
+		{path: "rules[*].expression"},
+		{path: "rules[*].ratelimit.countingExpression"},
+		{path: "rules[*].actionParameters.uri.path.expression", value: true},
+		{path: "rules[*].actionParameters.uri.query.expression", value: true},
+		{path: "rules[*].actionParameters.headers[*].expression", value: true},
+		{path: "rules[*].actionParameters.fromValue.targetUrl.expression", value: true},
+		{path: "rules[*].exposedCredentialCheck.usernameExpression", value: true},
+		{path: "rules[*].exposedCredentialCheck.passwordExpression", value: true},
+	},
+	{Group: "cloudflare.cloudflare.crossplane.io", Kind: "RulesetRule"}:              {{path: "expression"}},
+	{Group: "cloudflare.cloudflare.crossplane.io", Kind: "ManagedRulesetDeployment"}: {{path: "expression"}},
+	{Group: "cloudflare.cloudflare.crossplane.io", Kind: "Filter"}:                   {{path: "expression"}},
+	{Group: "firewall.cloudflare.crossplane.io", Kind: "Rule"}:                       {{path: "filter.expression"}},
+	{Group: "snippet.cloudflare.crossplane.io", Kind: "Rules"}:                       {{path: "rules[*].expression"}},
+	{Group: "waiting.cloudflare.crossplane.io", Kind: "RoomRules"}:                   {{path: "rules[*].expression"}},
+	{Group: "cloud.cloudflare.crossplane.io", Kind: "ConnectorRules"}:                {{path: "rules[*].expression"}},
+	{Group: "page.cloudflare.crossplane.io", Kind: "ShieldPolicy"}:                   {{path: "expression"}},
+}
+
+// SetupExpressionValidation registers the webhook that parses and type
+// checks the Rules language expressions of managed resources, so that
+// invalid expressions are rejected before they reach Terraform.
+func SetupExpressionValidation(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(ExpressionValidationPath, &admission.Webhook{Handler: &expressionValidator{}})
+	return nil
+}
+
+type expressionValidator struct{}
+
+// Handle rejects a managed resource with an invalid expression. On update,
+// only the expressions that changed are checked so that a resource created
+// before the webhook was enabled can still be updated, e.g. to remove its
+// finalizer.
+func (v *expressionValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+	fields, ok := expressionFields[schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}]
+	if !ok {
+		return admission.Allowed("")
+	}
+	obj, err := pave(req.Object.Raw)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	var old *fieldpath.Paved
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 {
+		if old, err = pave(req.OldObject.Raw); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	var msgs, warnings []string
+	for _, prefix := range []string{"spec.forProvider.", "spec.initProvider."} {
+		for _, f := range fields {
+			paths, err := obj.ExpandWildcards(prefix + f.path)
+			if err != nil && !fieldpath.IsNotFound(err) {
+				return admission.Errored(http.StatusBadRequest, err)
+			}
+			for _, p := range paths {
+				expr, err := obj.GetString(p)
+				if err != nil || expr == "" {
+					continue
+				}
+				if old != nil {
+					if prev, err := old.GetString(p); err == nil && prev == expr {
+						continue
+					}
+				}
+				check := rules.Check
+				if f.value {
+					check = rules.CheckValue
+				}
+				if err := check(expr); err != nil {
+					msgs = append(msgs, fmt.Sprintf("%s: %s", p, err))
+				}
+				for _, f := range rules.UnknownFields(expr) {
+					warnings = append(warnings, fmt.Sprintf("%s: unknown field %q was not checked", p, f))
+				}
+			}
+		}
+	}
+	if len(msgs) > 0 {
+		return admission.Denied("invalid Rules language expression: " + strings.Join(msgs, "; ")).WithWarnings(warnings...)
+	}
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+func pave(raw []byte) (*fieldpath.Paved, error) {
+	obj := map[string]any{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
+	}
+	return fieldpath.Pave(obj), nil
+}
@@ -0,0 +1,329 @@
+// Package wrangler converts Wrangler configuration files into Crossplane
+// managed resources of this provider.
+package wrangler
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+)
+
+const (
+	errParseTOML   = "cannot parse wrangler.toml"
+	errParseJSON   = "cannot parse wrangler.json"
+	errUnknownEnv  = "environment %q is not defined"
+	errRouteFormat = "route must be a pattern or a table with a pattern"
+)
+
+// Config is the subset of a Wrangler configuration file that is converted
+// into managed resources. See
+// https://developers.cloudflare.com/workers/wrangler/configuration/
+type Config struct {
+	Name               string    `toml:"name" json:"name"`
+	Main               string    `toml:"main" json:"main"`
+	AccountID          string    `toml:"account_id" json:"account_id"`
+	CompatibilityDate  string    `toml:"compatibility_date" json:"compatibility_date"`
+	CompatibilityFlags []string  `toml:"compatibility_flags" json:"compatibility_flags"`
+	WorkersDev         *bool     `toml:"workers_dev" json:"workers_dev"`
+	Logpush            *bool     `toml:"logpush" json:"logpush"`
+	Route              *Route    `toml:"route" json:"route"`
+	Routes             []Route   `toml:"routes" json:"routes"`
+	Triggers           *Triggers `toml:"triggers" json:"triggers"`
+
+	Vars         map[string]any `toml:"vars" json:"vars"`
+	KVNamespaces []KVNamespace  `toml:"kv_namespaces" json:"kv_namespaces"`
+	R2Buckets    []R2Bucket     `toml:"r2_buckets" json:"r2_buckets"`
+	D1Databases  []D1Database   `toml:"d1_databases" json:"d1_databases"`
+	Queues       *Queues        `toml:"queues" json:"queues"`
+	Services     []Service      `toml:"services" json:"services"`
+	Hyperdrive   []Hyperdrive   `toml:"hyperdrive" json:"hyperdrive"`
+
+	Env map[string]*Config `toml:"env" json:"env"`
+
+	// Undecoded are the keys of the file that are not part of Config, such
+	// as durable_objects or vectorize, and are therefore not converted.
+	// Keys of environments start with env.<name>.
+	Undecoded []string `toml:"-" json:"-"`
+}
+
+// Route is a route or custom domain of a Worker. It is either a bare
+// pattern or a table.
+type Route struct {
+	Pattern      string `toml:"pattern" json:"pattern"`
+	ZoneID       string `toml:"zone_id" json:"zone_id"`
+	ZoneName     string `toml:"zone_name" json:"zone_name"`
+	CustomDomain bool   `toml:"custom_domain" json:"custom_domain"`
+}
+
+// UnmarshalTOML decodes a route from a pattern or a table.
+func (r *Route) UnmarshalTOML(v any) error {
+	switch v := v.(type) {
+	case string:
+		*r = Route{Pattern: v}
+		return nil
+	case map[string]any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		type route Route
+		return json.Unmarshal(b, (*route)(r))
+	}
+	return errors.New(errRouteFormat)
+}
+
+// UnmarshalJSON decodes a route from a pattern or an object.
+func (r *Route) UnmarshalJSON(b []byte) error {
+	var pattern string
+	if err := json.Unmarshal(b, &pattern); err == nil {
+		*r = Route{Pattern: pattern}
+		return nil
+	}
+	type route Route
+	return errors.Wrap(json.Unmarshal(b, (*route)(r)), errRouteFormat)
+}
+
+// Triggers are the scheduled triggers of a Worker.
+type Triggers struct {
+	Crons []string `toml:"crons" json:"crons"`
+}
+
+// KVNamespace is a Workers KV binding.
+type KVNamespace struct {
+	Binding string `toml:"binding" json:"binding"`
+	ID      string `toml:"id" json:"id"`
+}
+
+// R2Bucket is an R2 bucket binding.
+type R2Bucket struct {
+	Binding      string `toml:"binding" json:"binding"`
+	BucketName   string `toml:"bucket_name" json:"bucket_name"`
+	Jurisdiction string `toml:"jurisdiction" json:"jurisdiction"`
+}
+
+// D1Database is a D1 database binding.
+type D1Database struct {
+	Binding      string `toml:"binding" json:"binding"`
+	DatabaseName string `toml:"database_name" json:"database_name"`
+	DatabaseID   string `toml:"database_id" json:"database_id"`
+}
+
+// Queues are the queues a Worker produces to and consumes from.
+type Queues struct {
+	Producers []QueueProducer `toml:"producers" json:"producers"`
+	Consumers []QueueConsumer `toml:"consumers" json:"consumers"`
+}
+
+// QueueProducer is a queue binding.
+type QueueProducer struct {
+	Binding string `toml:"binding" json:"binding"`
+	Queue   string `toml:"queue" json:"queue"`
+}
+
+// QueueConsumer is a queue consumed by the Worker.
+type QueueConsumer struct {
+	Queue           string   `toml:"queue" json:"queue"`
+	MaxBatchSize    *float64 `toml:"max_batch_size" json:"max_batch_size"`
+	MaxBatchTimeout *float64 `toml:"max_batch_timeout" json:"max_batch_timeout"`
+	MaxRetries      *float64 `toml:"max_retries" json:"max_retries"`
+	MaxConcurrency  *float64 `toml:"max_concurrency" json:"max_concurrency"`
+	RetryDelay      *float64 `toml:"retry_delay" json:"retry_delay"`
+	DeadLetterQueue string   `toml:"dead_letter_queue" json:"dead_letter_queue"`
+}
+
+// Service is a service binding to another Worker.
+type Service struct {
+	Binding     string `toml:"binding" json:"binding"`
+	Service     string `toml:"service" json:"service"`
+	Environment string `toml:"environment" json:"environment"`
+	Entrypoint  string `toml:"entrypoint" json:"entrypoint"`
+}
+
+// Hyperdrive is a Hyperdrive binding.
+type Hyperdrive struct {
+	Binding string `toml:"binding" json:"binding"`
+	ID      string `toml:"id" json:"id"`
+}
+
+// Parse parses a wrangler.toml, wrangler.json or wrangler.jsonc file. The
+// format is chosen by the extension of the file name.
+func Parse(name string, data []byte) (*Config, error) {
+	c := &Config{}
+	switch filepath.Ext(name) {
+	case ".json", ".jsonc":
+		data = stripJSONC(data)
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(c); err != nil {
+			return nil, errors.Wrap(err, errParseJSON)
+		}
+		var raw any
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, errors.Wrap(err, errParseJSON)
+		}
+		c.Undecoded = unknownKeys(raw, reflect.TypeFor[Config](), "")
+	default:
+		md, err := toml.Decode(string(data), c)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseTOML)
+		}
+		for _, k := range md.Undecoded() {
+			c.Undecoded = append(c.Undecoded, k.String())
+		}
+	}
+	// Keys below an undecoded key are not reported on their own.
+	slices.Sort(c.Undecoded)
+	keys := c.Undecoded[:0]
+	for _, k := range c.Undecoded {
+		if len(keys) == 0 || !strings.HasPrefix(k, keys[len(keys)-1]+".") {
+			keys = append(keys, k)
+		}
+	}
+	c.Undecoded = keys
+	return c, nil
+}
+
+// unknownKeys returns the keys of the given JSON value that have no field
+// in the given type, as dotted paths below the given prefix.
+func unknownKeys(v any, t reflect.Type, prefix string) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var out []string
+	switch v := v.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := map[string]reflect.Type{}
+			for i := range t.NumField() {
+				f := t.Field(i)
+				if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" && name != "-" {
+					fields[name] = f.Type
+				}
+			}
+			for k, e := range v {
+				ft, ok := fields[k]
+				if !ok {
+					out = append(out, prefix+k)
+					continue
+				}
+				out = append(out, unknownKeys(e, ft, prefix+k+".")...)
+			}
+		case reflect.Map:
+			for k, e := range v {
+				out = append(out, unknownKeys(e, t.Elem(), prefix+k+".")...)
+			}
+		}
+	case []any:
+		if t.Kind() == reflect.Slice {
+			for _, e := range v {
+				out = append(out, unknownKeys(e, t.Elem(), prefix)...)
+			}
+		}
+	}
+	return out
+}
+
+// ForEnv returns the configuration of the given environment. The name, the
+// entry point, the compatibility settings, the routes and the triggers are
+// inherited from the top level, the bindings are not, as in Wrangler. The
+// top level configuration is returned for an empty environment.
+func (c *Config) ForEnv(env string) (*Config, error) {
+	if env == "" {
+		return c, nil
+	}
+	e, ok := c.Env[env]
+	if !ok || e == nil {
+		return nil, errors.Errorf(errUnknownEnv, env)
+	}
+	out := *e
+	out.Env = nil
+	out.Undecoded = nil
+	for _, k := range c.Undecoded {
+		if rest, ok := strings.CutPrefix(k, "env."+env+"."); ok {
+			out.Undecoded = append(out.Undecoded, rest)
+		}
+	}
+	if out.Name == "" {
+		out.Name = c.Name + "-" + env
+	}
+	if out.Main == "" {
+		out.Main = c.Main
+	}
+	if out.AccountID == "" {
+		out.AccountID = c.AccountID
+	}
+	if out.CompatibilityDate == "" {
+		out.CompatibilityDate = c.CompatibilityDate
+	}
+	if out.CompatibilityFlags == nil {
+		out.CompatibilityFlags = c.CompatibilityFlags
+	}
+	if out.WorkersDev == nil {
+		out.WorkersDev = c.WorkersDev
+	}
+	if out.Logpush == nil {
+		out.Logpush = c.Logpush
+	}
+	if out.Route == nil && out.Routes == nil {
+		out.Route, out.Routes = c.Route, c.Routes
+	}
+	if out.Triggers == nil {
+		out.Triggers = c.Triggers
+	}
+	return &out, nil
+}
+
+// stripJSONC removes the comments and trailing commas JSONC allows from the
+// given document.
+func stripJSONC(in []byte) []byte {
+	out := make([]byte, 0, len(in))
+	inString, escaped := false, false
+	for i := 0; i < len(in); i++ {
+		c := in[i]
+		if inString {
+			out = append(out, c)
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+		switch {
+		case c == '"':
+			inString = true
+			out = append(out, c)
+		case c == '/' && i+1 < len(in) && in[i+1] == '/':
+			for i < len(in) && in[i] != '\n' {
+				i++
+			}
+			i--
+		case c == '/' && i+1 < len(in) && in[i+1] == '*':
+			i += 2
+			for i+1 < len(in) && (in[i] != '*' || in[i+1] != '/') {
+				i++
+			}
+			i++
+		case c == ']' || c == '}':
+			j := len(out) - 1
+			for j >= 0 && (out[j] == ' ' || out[j] == '\t' || out[j] == '\n' || out[j] == '\r') {
+				j--
+			}
+			if j >= 0 && out[j] == ',' {
+				out = append(out[:j], out[j+1:]...)
+			}
+			out = append(out, c)
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,185 @@
+package wrangler
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestStripJSONC(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"LineComment": {
+			in:   "{\n  // the name\n  \"name\": \"worker\"\n}",
+			want: "{\n  \n  \"name\": \"worker\"\n}",
+		},
+		"BlockComment": {
+			in:   `{/* the name */"name": /* inline */ "worker"}`,
+			want: `{"name":  "worker"}`,
+		},
+		"CommentMarkersInString": {
+			in:   `{"route": "example.com/*", "url": "https://example.com//path", "glob": "/* not a comment */"}`,
+			want: `{"route": "example.com/*", "url": "https://example.com//path", "glob": "/* not a comment */"}`,
+		},
+		"EscapedQuoteInString": {
+			in:   `{"quote": "say \"hi\" // still a string"} // comment`,
+			want: `{"quote": "say \"hi\" // still a string"} `,
+		},
+		"TrailingCommas": {
+			in:   "{\"crons\": [\"0 * * * *\",\n],\n\"name\": \"worker\", // last\n}",
+			want: "{\"crons\": [\"0 * * * *\"\n],\n\"name\": \"worker\" \n}",
+		},
+		"CommaInString": {
+			in:   `{"list": "a,]", "object": "b,}"}`,
+			want: `{"list": "a,]", "object": "b,}"}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := string(stripJSONC([]byte(tc.in))); got != tc.want {
+				t.Errorf("stripJSONC(%q): got %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		file string
+		data string
+		want *Config
+	}{
+		"TOML": {
+			file: "wrangler.toml",
+			data: `
+name = "worker"
+main = "src/index.ts"
+route = "example.com/*"
+
+[[routes]]
+pattern = "shop.example.com"
+custom_domain = true
+
+[[durable_objects.bindings]]
+name = "COUNTER"
+class_name = "Counter"
+`,
+			want: &Config{
+				Name:      "worker",
+				Main:      "src/index.ts",
+				Route:     &Route{Pattern: "example.com/*"},
+				Routes:    []Route{{Pattern: "shop.example.com", CustomDomain: true}},
+				Undecoded: []string{"durable_objects.bindings"},
+			},
+		},
+		"JSONC": {
+			file: "wrangler.jsonc",
+			data: `{
+  // The Worker.
+  "name": "worker",
+  "routes": [
+    "example.com/*",
+    { "pattern": "api.example.com/*", "zone_name": "example.com" },
+  ],
+  "vectorize": [{ "binding": "INDEX", "index_name": "index" }],
+}`,
+			want: &Config{
+				Name:      "worker",
+				Routes:    []Route{{Pattern: "example.com/*"}, {Pattern: "api.example.com/*", ZoneName: "example.com"}},
+				Undecoded: []string{"vectorize"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tc.file, []byte(tc.data))
+			if err != nil {
+				t.Fatalf("Parse(...): %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Parse(...): got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestForEnv(t *testing.T) {
+	top := &Config{
+		Name:               "worker",
+		Main:               "src/index.ts",
+		AccountID:          "account",
+		CompatibilityDate:  "2025-01-01",
+		CompatibilityFlags: []string{"nodejs_compat"},
+		WorkersDev:         ptr.To(true),
+		Route:              &Route{Pattern: "example.com/*"},
+		Triggers:           &Triggers{Crons: []string{"0 * * * *"}},
+		KVNamespaces:       []KVNamespace{{Binding: "CACHE", ID: "top"}},
+		Env: map[string]*Config{
+			"staging": {},
+			"production": {
+				Name:         "worker-prod",
+				Routes:       []Route{{Pattern: "shop.example.com", CustomDomain: true}},
+				KVNamespaces: []KVNamespace{{Binding: "CACHE", ID: "production"}},
+			},
+		},
+		Undecoded: []string{"env.production.vectorize", "env.staging.ai", "durable_objects"},
+	}
+
+	cases := map[string]struct {
+		env     string
+		want    *Config
+		wantErr bool
+	}{
+		"TopLevel": {
+			env:  "",
+			want: top,
+		},
+		"Inherited": {
+			env: "staging",
+			want: &Config{
+				Name:               "worker-staging",
+				Main:               "src/index.ts",
+				AccountID:          "account",
+				CompatibilityDate:  "2025-01-01",
+				CompatibilityFlags: []string{"nodejs_compat"},
+				WorkersDev:         ptr.To(true),
+				Route:              &Route{Pattern: "example.com/*"},
+				Triggers:           &Triggers{Crons: []string{"0 * * * *"}},
+				Undecoded:          []string{"ai"},
+			},
+		},
+		"Overridden": {
+			env: "production",
+			want: &Config{
+				Name:               "worker-prod",
+				Main:               "src/index.ts",
+				AccountID:          "account",
+				CompatibilityDate:  "2025-01-01",
+				CompatibilityFlags: []string{"nodejs_compat"},
+				WorkersDev:         ptr.To(true),
+				Routes:             []Route{{Pattern: "shop.example.com", CustomDomain: true}},
+				Triggers:           &Triggers{Crons: []string{"0 * * * *"}},
+				KVNamespaces:       []KVNamespace{{Binding: "CACHE", ID: "production"}},
+				Undecoded:          []string{"vectorize"},
+			},
+		},
+		"UnknownEnv": {
+			env:     "dev",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := top.ForEnv(tc.env)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ForEnv(%q): error %v, want error %t", tc.env, err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ForEnv(%q): got %+v, want %+v", tc.env, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+package wrangler
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	queuev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/queue/v1alpha1"
+	workersv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+const (
+	errNoName      = "the Worker has no name"
+	errNoAccountID = "the Worker has no account_id, set one in the file or in the options"
+)
+
+// Options configure the conversion.
+type Options struct {
+	// AccountID overrides the account_id of the file.
+	AccountID string
+	// ContentNamespace is the namespace of the ConfigMap the Worker bundle
+	// is read from. The ConfigMap is named after the Worker with a -bundle
+	// suffix and holds the modules of the bundle, the main module named
+	// after the entry point.
+	ContentNamespace string
+	// ProviderConfig is the name of the ProviderConfig of the resources.
+	ProviderConfig string
+	// References makes the resources reference the buckets, databases,
+	// queues, zones and Workers they use by name, instead of setting their
+	// names and identifiers. The referenced resources are expected to be
+	// named after the Cloudflare resource. KV namespaces and Hyperdrive
+	// configs, which Wrangler only knows by identifier, are expected to be
+	// named after their binding.
+	References bool
+}
+
+// Result is the outcome of a conversion.
+type Result struct {
+	// Objects are the converted managed resources.
+	Objects []runtime.Object
+	// Warnings describe the parts of the configuration that could not be
+	// converted.
+	Warnings []string
+}
+
+// Convert converts the given Wrangler configuration into managed resources.
+func Convert(c *Config, o Options) (*Result, error) {
+	if c.Name == "" {
+		return nil, errors.New(errNoName)
+	}
+	account := o.AccountID
+	if account == "" {
+		account = c.AccountID
+	}
+	if account == "" {
+		return nil, errors.New(errNoAccountID)
+	}
+	conv := &converter{cfg: c, opts: o, account: account, name: objectName(c.Name)}
+	conv.script()
+	conv.routes()
+	conv.cronTrigger()
+	conv.consumers()
+	conv.subdomain()
+	for _, k := range c.Undecoded {
+		if !strings.HasPrefix(k, "env.") {
+			conv.warn("%s is not supported and was not converted", k)
+		}
+	}
+	return &conv.res, nil
+}
+
+type converter struct {
+	cfg     *Config
+	opts    Options
+	account string
+	// name is the object name of the Worker script.
+	name string
+	res  Result
+}
+
+func (c *converter) warn(format string, args ...any) {
+	c.res.Warnings = append(c.res.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (c *converter) spec() xpv1.ResourceSpec {
+	s := xpv1.ResourceSpec{}
+	if c.opts.ProviderConfig != "" {
+		s.ProviderConfigReference = &xpv1.Reference{Name: c.opts.ProviderConfig}
+	}
+	return s
+}
+
+func (c *converter) meta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name}
+}
+
+// ref returns a reference to the resource named after the given Cloudflare
+// resource if references are enabled.
+func (c *converter) ref(name string) *xpv1.Reference {
+	if !c.opts.References || name == "" {
+		return nil
+	}
+	return &xpv1.Reference{Name: objectName(name)}
+}
+
+// literal returns the given value unless references are enabled.
+func (c *converter) literal(v string) *string {
+	if c.opts.References || v == "" {
+		return nil
+	}
+	return ptr.To(v)
+}
+
+func (c *converter) script() {
+	cfg := c.cfg
+	module := bundleName(cfg.Main)
+	p := workersv1alpha1.ScriptParameters{
+		AccountID:  ptr.To(c.account),
+		ScriptName: ptr.To(cfg.Name),
+		MainModule: ptr.To(module),
+		ContentFrom: &workersv1alpha1.ContentFromParameters{
+			ConfigMapKeyRef: &workersv1alpha1.ConfigMapKeyRefParameters{
+				Name:      ptr.To(c.name + "-bundle"),
+				Namespace: ptr.To(c.opts.ContentNamespace),
+			},
+		},
+		Logpush: cfg.Logpush,
+	}
+	if cfg.CompatibilityDate != "" {
+		p.CompatibilityDate = ptr.To(cfg.CompatibilityDate)
+	}
+	for _, f := range cfg.CompatibilityFlags {
+		p.CompatibilityFlags = append(p.CompatibilityFlags, ptr.To(f))
+	}
+	p.Bindings = c.bindings()
+
+	c.res.Objects = append(c.res.Objects, &workersv1alpha1.Script{
+		TypeMeta:   metav1.TypeMeta{APIVersion: workersv1alpha1.CRDGroupVersion.String(), Kind: workersv1alpha1.Script_Kind},
+		ObjectMeta: c.meta(c.name),
+		Spec:       workersv1alpha1.ScriptSpec{ResourceSpec: c.spec(), ForProvider: p},
+	})
+}
+
+func (c *converter) bindings() []workersv1alpha1.BindingsParameters {
+	cfg := c.cfg
+	var out []workersv1alpha1.BindingsParameters
+	binding := func(name, typ string) workersv1alpha1.BindingsParameters {
+		return workersv1alpha1.BindingsParameters{Name: ptr.To(name), Type: ptr.To(typ)}
+	}
+
+	for _, k := range sortedKeys(cfg.Vars) {
+		v, err := json.Marshal(cfg.Vars[k])
+		if err != nil {
+			c.warn("var %s cannot be encoded as JSON: %s", k, err)
+			continue
+		}
+		b := binding(k, "json")
+		b.JSON = ptr.To(string(v))
+		out = append(out, b)
+	}
+	for _, kv := range cfg.KVNamespaces {
+		b := binding(kv.Binding, "kv_namespace")
+		b.NamespaceID = c.literal(kv.ID)
+		b.NamespaceIDRef = c.ref(kv.Binding)
+		out = append(out, b)
+	}
+	for _, r2 := range cfg.R2Buckets {
+		b := binding(r2.Binding, "r2_bucket")
+		b.BucketName = c.literal(r2.BucketName)
+		b.BucketNameRef = c.ref(r2.BucketName)
+		if r2.Jurisdiction != "" {
+			b.Jurisdiction = ptr.To(r2.Jurisdiction)
+		}
+		out = append(out, b)
+	}
+	for _, d1 := range cfg.D1Databases {
+		b := binding(d1.Binding, "d1")
+		if c.opts.References && d1.DatabaseName != "" {
+			b.D1DatabaseRef = c.ref(d1.DatabaseName)
+		} else {
+			b.ID = ptr.To(d1.DatabaseID)
+		}
+		out = append(out, b)
+	}
+	if cfg.Queues != nil {
+		for _, q := range cfg.Queues.Producers {
+			b := binding(q.Binding, "queue")
+			b.QueueName = c.literal(q.Queue)
+			b.QueueNameRef = c.ref(q.Queue)
+			out = append(out, b)
+		}
+	}
+	for _, s := range cfg.Services {
+		b := binding(s.Binding, "service")
+		b.Service = c.literal(s.Service)
+		b.ServiceRef = c.ref(s.Service)
+		if s.Environment != "" {
+			b.Environment = ptr.To(s.Environment)
+		}
+		if s.Entrypoint != "" {
+			c.warn("service binding %s: entrypoint %s is not supported and was dropped", s.Binding, s.Entrypoint)
+		}
+		out = append(out, b)
+	}
+	for _, h := range cfg.Hyperdrive {
+		b := binding(h.Binding, "hyperdrive")
+		b.HyperdriveID = c.literal(h.ID)
+		b.HyperdriveConfigRef = c.ref(h.Binding)
+		out = append(out, b)
+	}
+	return out
+}
+
+func (c *converter) routes() {
+	routes := c.cfg.Routes
+	if c.cfg.Route != nil {
+		routes = append([]Route{*c.cfg.Route}, routes...)
+	}
+	for _, r := range routes {
+		name := objectName(c.name + "-" + r.Pattern)
+		var zoneID *string
+		var zoneRef *xpv1.Reference
+		switch {
+		case r.ZoneID != "":
+			zoneID = ptr.To(r.ZoneID)
+		case r.ZoneName != "" && c.opts.References:
+			zoneRef = &xpv1.Reference{Name: objectName(r.ZoneName)}
+		default:
+			c.warn("route %s has no zone_id, set zoneId on %s", r.Pattern, name)
+		}
+
+		if r.CustomDomain {
+			c.res.Objects = append(c.res.Objects, &workersv1alpha1.CustomDomain{
+				TypeMeta:   metav1.TypeMeta{APIVersion: workersv1alpha1.CRDGroupVersion.String(), Kind: workersv1alpha1.CustomDomain_Kind},
+				ObjectMeta: c.meta(name),
+				Spec: workersv1alpha1.CustomDomainSpec{ResourceSpec: c.spec(), ForProvider: workersv1alpha1.CustomDomainParameters{
+					AccountID:  ptr.To(c.account),
+					Hostname:   ptr.To(r.Pattern),
+					Service:    c.literal(c.cfg.Name),
+					ServiceRef: c.ref(c.cfg.Name),
+					ZoneID:     zoneID,
+					ZoneIDRef:  zoneRef,
+				}},
+			})
+			continue
+		}
+		c.res.Objects = append(c.res.Objects, &workersv1alpha1.Route{
+			TypeMeta:   metav1.TypeMeta{APIVersion: workersv1alpha1.CRDGroupVersion.String(), Kind: workersv1alpha1.Route_Kind},
+			ObjectMeta: c.meta(name),
+			Spec: workersv1alpha1.RouteSpec{ResourceSpec: c.spec(), ForProvider: workersv1alpha1.RouteParameters{
+				Pattern:   ptr.To(r.Pattern),
+				Script:    c.literal(c.cfg.Name),
+				ScriptRef: c.ref(c.cfg.Name),
+				ZoneID:    zoneID,
+				ZoneIDRef: zoneRef,
+			}},
+		})
+	}
+}
+
+func (c *converter) cronTrigger() {
+	if c.cfg.Triggers == nil || len(c.cfg.Triggers.Crons) == 0 {
+		return
+	}
+	p := workersv1alpha1.CronTriggerParameters{
+		AccountID:     ptr.To(c.account),
+		ScriptName:    c.literal(c.cfg.Name),
+		ScriptNameRef: c.ref(c.cfg.Name),
+	}
+	for _, cron := range c.cfg.Triggers.Crons {
+		p.Schedules = append(p.Schedules, workersv1alpha1.SchedulesParameters{Cron: ptr.To(cron)})
+	}
+	c.res.Objects = append(c.res.Objects, &workersv1alpha1.CronTrigger{
+		TypeMeta:   metav1.TypeMeta{APIVersion: workersv1alpha1.CRDGroupVersion.String(), Kind: workersv1alpha1.CronTrigger_Kind},
+		ObjectMeta: c.meta(c.name),
+		Spec:       workersv1alpha1.CronTriggerSpec{ResourceSpec: c.spec(), ForProvider: p},
+	})
+}
+
+func (c *converter) consumers() {
+	if c.cfg.Queues == nil {
+		return
+	}
+	for _, q := range c.cfg.Queues.Consumers {
+		p := queuev1alpha1.ConsumerParameters{
+			AccountID:     ptr.To(c.account),
+			ScriptName:    c.literal(c.cfg.Name),
+			ScriptNameRef: c.ref(c.cfg.Name),
+			Type:          ptr.To("worker"),
+			Settings: &queuev1alpha1.SettingsParameters{
+				BatchSize:      q.MaxBatchSize,
+				MaxRetries:     q.MaxRetries,
+				MaxConcurrency: q.MaxConcurrency,
+				RetryDelay:     q.RetryDelay,
+			},
+		}
+		if q.MaxBatchTimeout != nil {
+			p.Settings.MaxWaitTimeMs = ptr.To(*q.MaxBatchTimeout * 1000)
+		}
+		if q.DeadLetterQueue != "" {
+			p.DeadLetterQueue = ptr.To(q.DeadLetterQueue)
+		}
+		if c.opts.References {
+			p.QueueIDRef = c.ref(q.Queue)
+		} else {
+			c.warn("queue consumer of %s: set queueId to the identifier of the queue", q.Queue)
+		}
+		c.res.Objects = append(c.res.Objects, &queuev1alpha1.Consumer{
+			TypeMeta:   metav1.TypeMeta{APIVersion: queuev1alpha1.CRDGroupVersion.String(), Kind: queuev1alpha1.Consumer_Kind},
+			ObjectMeta: c.meta(objectName(c.name + "-" + q.Queue)),
+			Spec:       queuev1alpha1.ConsumerSpec{ResourceSpec: c.spec(), ForProvider: p},
+		})
+	}
+}
+
+func (c *converter) subdomain() {
+	if c.cfg.WorkersDev == nil {
+		return
+	}
+	c.res.Objects = append(c.res.Objects, &workersv1alpha1.ScriptSubdomain{
+		TypeMeta:   metav1.TypeMeta{APIVersion: workersv1alpha1.CRDGroupVersion.String(), Kind: workersv1alpha1.ScriptSubdomain_Kind},
+		ObjectMeta: c.meta(c.name),
+		Spec: workersv1alpha1.ScriptSubdomainSpec{ResourceSpec: c.spec(), ForProvider: workersv1alpha1.ScriptSubdomainParameters{
+			AccountID:  ptr.To(c.account),
+			ScriptName: ptr.To(c.cfg.Name),
+			Enabled:    c.cfg.WorkersDev,
+		}},
+	})
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// objectName turns the given Cloudflare name or pattern into a valid
+// Kubernetes object name.
+func objectName(s string) string {
+	s = invalidNameChars.ReplaceAllString(strings.ToLower(s), "-")
+	s = strings.Trim(s, "-")
+	if len(s) > 63 {
+		s = strings.TrimRight(s[:63], "-")
+	}
+	return s
+}
+
+// bundleName is the name of the bundled module built from the given entry
+// point, e.g. index.js for src/index.ts.
+func bundleName(main string) string {
+	if main == "" {
+		return "index.js"
+	}
+	base := path.Base(main)
+	return strings.TrimSuffix(base, path.Ext(base)) + ".js"
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,161 @@
+package wrangler
+
+import (
+	"reflect"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"k8s.io/utils/ptr"
+
+	queuev1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/queue/v1alpha1"
+	workersv1alpha1 "gitlab.com/jarvisai.run/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+func TestConvert(t *testing.T) {
+	cfg := &Config{
+		Name:      "api-worker",
+		Main:      "src/index.ts",
+		AccountID: "account",
+		Routes: []Route{
+			{Pattern: "example.com/api/*", ZoneName: "example.com"},
+			{Pattern: "api.example.com", ZoneID: "zone", CustomDomain: true},
+		},
+		KVNamespaces: []KVNamespace{{Binding: "CACHE", ID: "kv-id"}},
+		R2Buckets:    []R2Bucket{{Binding: "ASSETS", BucketName: "assets", Jurisdiction: "eu"}},
+		D1Databases:  []D1Database{{Binding: "DB", DatabaseName: "main-db", DatabaseID: "d1-id"}},
+		Queues: &Queues{
+			Producers: []QueueProducer{{Binding: "JOBS", Queue: "jobs"}},
+			Consumers: []QueueConsumer{{Queue: "jobs", MaxBatchTimeout: ptr.To(5.0)}},
+		},
+		Services:   []Service{{Binding: "AUTH", Service: "auth-worker", Entrypoint: "Auth"}},
+		Hyperdrive: []Hyperdrive{{Binding: "PG", ID: "hd-id"}},
+		Undecoded:  []string{"vectorize", "env.production.ai"},
+	}
+
+	type want struct {
+		bindings     []workersv1alpha1.BindingsParameters
+		route        workersv1alpha1.RouteParameters
+		customDomain workersv1alpha1.CustomDomainParameters
+		consumer     queuev1alpha1.ConsumerParameters
+		warnings     []string
+	}
+	binding := func(name, typ string) workersv1alpha1.BindingsParameters {
+		return workersv1alpha1.BindingsParameters{Name: ptr.To(name), Type: ptr.To(typ)}
+	}
+	consumer := queuev1alpha1.ConsumerParameters{
+		AccountID: ptr.To("account"),
+		Type:      ptr.To("worker"),
+		Settings:  &queuev1alpha1.SettingsParameters{MaxWaitTimeMs: ptr.To(5000.0)},
+	}
+
+	cases := map[string]struct {
+		references bool
+		want       want
+	}{
+		"Literals": {
+			want: want{
+				bindings: func() []workersv1alpha1.BindingsParameters {
+					kv, r2, d1, q, svc, hd := binding("CACHE", "kv_namespace"), binding("ASSETS", "r2_bucket"), binding("DB", "d1"), binding("JOBS", "queue"), binding("AUTH", "service"), binding("PG", "hyperdrive")
+					kv.NamespaceID = ptr.To("kv-id")
+					r2.BucketName, r2.Jurisdiction = ptr.To("assets"), ptr.To("eu")
+					d1.ID = ptr.To("d1-id")
+					q.QueueName = ptr.To("jobs")
+					svc.Service = ptr.To("auth-worker")
+					hd.HyperdriveID = ptr.To("hd-id")
+					return []workersv1alpha1.BindingsParameters{kv, r2, d1, q, svc, hd}
+				}(),
+				route:        workersv1alpha1.RouteParameters{Pattern: ptr.To("example.com/api/*"), Script: ptr.To("api-worker")},
+				customDomain: workersv1alpha1.CustomDomainParameters{AccountID: ptr.To("account"), Hostname: ptr.To("api.example.com"), Service: ptr.To("api-worker"), ZoneID: ptr.To("zone")},
+				consumer: func() queuev1alpha1.ConsumerParameters {
+					c := consumer
+					c.ScriptName = ptr.To("api-worker")
+					return c
+				}(),
+				warnings: []string{
+					"service binding AUTH: entrypoint Auth is not supported and was dropped",
+					"route example.com/api/* has no zone_id, set zoneId on api-worker-example-com-api",
+					"queue consumer of jobs: set queueId to the identifier of the queue",
+					"vectorize is not supported and was not converted",
+				},
+			},
+		},
+		"References": {
+			references: true,
+			want: want{
+				bindings: func() []workersv1alpha1.BindingsParameters {
+					kv, r2, d1, q, svc, hd := binding("CACHE", "kv_namespace"), binding("ASSETS", "r2_bucket"), binding("DB", "d1"), binding("JOBS", "queue"), binding("AUTH", "service"), binding("PG", "hyperdrive")
+					kv.NamespaceIDRef = &xpv1.Reference{Name: "cache"}
+					r2.BucketNameRef, r2.Jurisdiction = &xpv1.Reference{Name: "assets"}, ptr.To("eu")
+					d1.D1DatabaseRef = &xpv1.Reference{Name: "main-db"}
+					q.QueueNameRef = &xpv1.Reference{Name: "jobs"}
+					svc.ServiceRef = &xpv1.Reference{Name: "auth-worker"}
+					hd.HyperdriveConfigRef = &xpv1.Reference{Name: "pg"}
+					return []workersv1alpha1.BindingsParameters{kv, r2, d1, q, svc, hd}
+				}(),
+				route: workersv1alpha1.RouteParameters{
+					Pattern:   ptr.To("example.com/api/*"),
+					ScriptRef: &xpv1.Reference{Name: "api-worker"},
+					ZoneIDRef: &xpv1.Reference{Name: "example-com"},
+				},
+				customDomain: workersv1alpha1.CustomDomainParameters{
+					AccountID:  ptr.To("account"),
+					Hostname:   ptr.To("api.example.com"),
+					ServiceRef: &xpv1.Reference{Name: "api-worker"},
+					ZoneID:     ptr.To("zone"),
+				},
+				consumer: func() queuev1alpha1.ConsumerParameters {
+					c := consumer
+					c.ScriptNameRef = &xpv1.Reference{Name: "api-worker"}
+					c.QueueIDRef = &xpv1.Reference{Name: "jobs"}
+					return c
+				}(),
+				warnings: []string{
+					"service binding AUTH: entrypoint Auth is not supported and was dropped",
+					"vectorize is not supported and was not converted",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			res, err := Convert(cfg, Options{ContentNamespace: "workers", References: tc.references})
+			if err != nil {
+				t.Fatalf("Convert(...): %v", err)
+			}
+			var got want
+			for _, o := range res.Objects {
+				switch o := o.(type) {
+				case *workersv1alpha1.Script:
+					p := o.Spec.ForProvider
+					got.bindings = p.Bindings
+					wantSource := &workersv1alpha1.ContentFromParameters{ConfigMapKeyRef: &workersv1alpha1.ConfigMapKeyRefParameters{Name: ptr.To("api-worker-bundle"), Namespace: ptr.To("workers")}}
+					if ptr.Deref(p.MainModule, "") != "index.js" || !reflect.DeepEqual(p.ContentFrom, wantSource) {
+						t.Errorf("Convert(...): Script main module %v and content %+v, want index.js and %+v", ptr.Deref(p.MainModule, ""), p.ContentFrom, wantSource)
+					}
+				case *workersv1alpha1.Route:
+					got.route = o.Spec.ForProvider
+				case *workersv1alpha1.CustomDomain:
+					got.customDomain = o.Spec.ForProvider
+				case *queuev1alpha1.Consumer:
+					got.consumer = o.Spec.ForProvider
+				}
+			}
+			got.warnings = res.Warnings
+			if !reflect.DeepEqual(got.bindings, tc.want.bindings) {
+				t.Errorf("Convert(...): bindings %+v, want %+v", got.bindings, tc.want.bindings)
+			}
+			if !reflect.DeepEqual(got.route, tc.want.route) {
+				t.Errorf("Convert(...): route %+v, want %+v", got.route, tc.want.route)
+			}
+			if !reflect.DeepEqual(got.customDomain, tc.want.customDomain) {
+				t.Errorf("Convert(...): custom domain %+v, want %+v", got.customDomain, tc.want.customDomain)
+			}
+			if !reflect.DeepEqual(got.consumer, tc.want.consumer) {
+				t.Errorf("Convert(...): consumer %+v, want %+v", got.consumer, tc.want.consumer)
+			}
+			if !reflect.DeepEqual(got.warnings, tc.want.warnings) {
+				t.Errorf("Convert(...): warnings %q, want %q", got.warnings, tc.want.warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Pos is a position in an expression.
+type Pos struct {
+	// Offset is the byte offset, starting at 0.
+	Offset int
+	// Line is the line number, starting at 1.
+	Line int
+	// Column is the column in runes, starting at 1.
+	Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Error is an error at a position of an expression.
+type Error struct {
+	Pos Pos
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return e.Pos.String() + ": " + e.Msg
+}
+
+// Errors are the errors of an expression, ordered by position.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Node is a node of the syntax tree of an expression.
+type Node interface {
+	// Position returns the position of the node in the expression.
+	Position() Pos
+}
+
+// Field is a field, such as http.host.
+type Field struct {
+	Pos  Pos
+	Name string
+}
+
+// Call is a function call, such as lower(http.host).
+type Call struct {
+	Pos  Pos
+	Name string
+	Args []Node
+}
+
+// Index is the element of a map or array, such as
+// http.request.headers["accept"] or http.request.headers.names[0], or all
+// elements of it, such as http.request.headers.names[*].
+type Index struct {
+	Pos Pos
+	X   Node
+	// Key is a String or Int literal, or nil for [*].
+	Key Node
+}
+
+// String is a string literal.
+type String struct {
+	Pos   Pos
+	Value string
+}
+
+// Integer is an integer literal.
+type Integer struct {
+	Pos   Pos
+	Value int64
+}
+
+// Boolean is the true or false literal.
+type Boolean struct {
+	Pos   Pos
+	Value bool
+}
+
+// Address is an IP address or CIDR literal.
+type Address struct {
+	Pos    Pos
+	Prefix netip.Prefix
+}
+
+// Range is an inclusive range of integers or IP addresses in a set, such
+// as 8000..8999.
+type Range struct {
+	Pos  Pos
+	From Node
+	To   Node
+}
+
+// Set is a set of literals, such as {"GET" "HEAD"}.
+type Set struct {
+	Pos   Pos
+	Elems []Node
+}
+
+// List is a reference to a list, such as $office_ips or the managed list
+// $cf.open_proxies.
+type List struct {
+	Pos  Pos
+	Name string
+}
+
+// Comparison compares a field or function result with a literal, such as
+// http.host eq "example.com".
+type Comparison struct {
+	Pos Pos
+	Op  string
+	X   Node
+	Y   Node
+}
+
+// Not negates an expression.
+type Not struct {
+	Pos Pos
+	X   Node
+}
+
+// Logical combines two expressions with and, or or xor.
+type Logical struct {
+	Pos Pos
+	Op  string
+	X   Node
+	Y   Node
+}
+
+func (n *Field) Position() Pos      { return n.Pos }
+func (n *Call) Position() Pos       { return n.Pos }
+func (n *Index) Position() Pos      { return n.Pos }
+func (n *String) Position() Pos     { return n.Pos }
+func (n *Integer) Position() Pos    { return n.Pos }
+func (n *Boolean) Position() Pos    { return n.Pos }
+func (n *Address) Position() Pos    { return n.Pos }
+func (n *Range) Position() Pos      { return n.Pos }
+func (n *Set) Position() Pos        { return n.Pos }
+func (n *List) Position() Pos       { return n.Pos }
+func (n *Comparison) Position() Pos { return n.Pos }
+func (n *Not) Position() Pos        { return n.Pos }
+func (n *Logical) Position() Pos    { return n.Pos }
@@ -0,0 +1,307 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Check parses and type checks the given filter expression, which must
+// evaluate to a Boolean. It returns an Errors describing every error found.
+// Fields that are not in Fields are accepted without being type checked, as
+// the API may support fields this package does not know about yet; they are
+// returned by UnknownFields.
+func Check(src string) error {
+	return check(src, Bool)
+}
+
+// CheckValue parses and type checks the given value expression of a
+// transform or redirect rule, which must evaluate to a String.
+func CheckValue(src string) error {
+	return check(src, Bytes)
+}
+
+func check(src string, want Type) error {
+	n, err := Parse(src)
+	if err != nil {
+		return err
+	}
+	c := &checker{}
+	if v := c.expr(n); v.ok {
+		switch {
+		case v.unpacked:
+			c.errorf(n, "%s is evaluated for every element of an array, use it as an argument of any() or all()", describe(n))
+		case want.Equal(Bool) && v.typ.Equal(Array(Bool)):
+			c.errorf(n, "the expression is of type Array<Boolean>, use it as an argument of any() or all()")
+		case !v.typ.Equal(want):
+			c.errorf(n, "the expression must evaluate to a %s, %s is of type %s", want, describe(n), v.typ)
+		}
+	}
+	if len(c.errs) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(c.errs, func(a, b *Error) int { return a.Pos.Offset - b.Pos.Offset })
+	return c.errs
+}
+
+// UnknownFields returns the fields of the given expression that are not in
+// Fields, in the order they appear, so that they can be reported as
+// warnings. It returns nothing for an expression that cannot be parsed.
+func UnknownFields(src string) []string {
+	n, err := Parse(src)
+	if err != nil {
+		return nil
+	}
+	var unknown []string
+	var walk func(n Node)
+	walk = func(n Node) {
+		switch n := n.(type) {
+		case *Field:
+			if _, ok := Fields[n.Name]; !ok && !slices.Contains(unknown, n.Name) {
+				unknown = append(unknown, n.Name)
+			}
+		case *Call:
+			for _, a := range n.Args {
+				walk(a)
+			}
+		case *Index:
+			walk(n.X)
+		case *Comparison:
+			walk(n.X)
+			walk(n.Y)
+		case *Not:
+			walk(n.X)
+		case *Logical:
+			walk(n.X)
+			walk(n.Y)
+		}
+	}
+	walk(n)
+	return unknown
+}
+
+type checker struct {
+	errs Errors
+}
+
+// value is the result of type checking a node. An unpacked value is the
+// element of an array or map indexed with [*] that is evaluated for every
+// element. A value that is not ok had an error that was already reported.
+type value struct {
+	typ      Type
+	unpacked bool
+	ok       bool
+}
+
+var invalid = value{}
+
+func (c *checker) errorf(n Node, format string, args ...any) {
+	c.errs = append(c.errs, &Error{Pos: n.Position(), Msg: fmt.Sprintf(format, args...)})
+}
+
+// describe names the given node for an error message.
+func describe(n Node) string {
+	switch n := n.(type) {
+	case *Field:
+		return n.Name
+	case *Call:
+		return n.Name + "()"
+	case *Index:
+		return describe(n.X) + "[...]"
+	case *Comparison:
+		return "the comparison"
+	case *Not, *Logical:
+		return "the logical expression"
+	}
+	return "the literal"
+}
+
+func (c *checker) expr(n Node) value {
+	switch n := n.(type) {
+	case *Field:
+		t, ok := Fields[n.Name]
+		if !ok {
+			// The type of an unknown field is not known, so the checks
+			// depending on it are skipped.
+			return invalid
+		}
+		return value{typ: t, ok: true}
+	case *String:
+		return value{typ: Bytes, ok: true}
+	case *Integer:
+		return value{typ: Int, ok: true}
+	case *Boolean:
+		return value{typ: Bool, ok: true}
+	case *Address, *Range, *Set, *List:
+		c.errorf(n, "%s can only be used on the right side of a comparison", describe(n))
+		return invalid
+	case *Index:
+		return c.index(n)
+	case *Call:
+		return c.call(n)
+	case *Comparison:
+		return c.comparison(n)
+	case *Not:
+		c.boolean(n.X, "not")
+		return value{typ: Bool, ok: true}
+	case *Logical:
+		c.boolean(n.X, n.Op)
+		c.boolean(n.Y, n.Op)
+		return value{typ: Bool, ok: true}
+	}
+	c.errorf(n, "unexpected expression")
+	return invalid
+}
+
+// boolean checks that the given operand of a logical operator is a Boolean.
+func (c *checker) boolean(n Node, op string) {
+	v := c.expr(n)
+	switch {
+	case !v.ok:
+	case v.unpacked || v.typ.Equal(Array(Bool)):
+		c.errorf(n, "%s evaluates to an array, use it as an argument of any() or all() before %q", describe(n), op)
+	case !v.typ.Equal(Bool):
+		c.errorf(n, "operand of %q must be a Boolean, %s is of type %s", op, describe(n), v.typ)
+	}
+}
+
+func (c *checker) index(n *Index) value {
+	x := c.expr(n.X)
+	if !x.ok {
+		return invalid
+	}
+	if n.Key == nil && x.unpacked {
+		c.errorf(n, "%s is already unpacked with [*]", describe(n.X))
+		return invalid
+	}
+	switch x.typ.Kind {
+	case KindMap:
+		if _, ok := n.Key.(*Integer); ok {
+			c.errorf(n.Key, "%s is of type %s, index it with a string", describe(n.X), x.typ)
+			return invalid
+		}
+	case KindArray:
+		if _, ok := n.Key.(*String); ok {
+			c.errorf(n.Key, "%s is of type %s, index it with an integer", describe(n.X), x.typ)
+			return invalid
+		}
+	default:
+		c.errorf(n, "%s is of type %s and cannot be indexed", describe(n.X), x.typ)
+		return invalid
+	}
+	return value{typ: *x.typ.Elem, unpacked: x.unpacked || n.Key == nil, ok: true}
+}
+
+func (c *checker) comparison(n *Comparison) value {
+	switch n.X.(type) {
+	case *String, *Integer:
+		c.errorf(n.X, "the left side of %q must be a field or a function call", n.Op)
+		return invalid
+	}
+	x := c.expr(n.X)
+	if !x.ok {
+		return invalid
+	}
+	result := value{typ: Bool, ok: true}
+	if x.unpacked {
+		result = value{typ: Array(Bool), ok: true}
+	}
+	t := x.typ
+	switch t.Kind {
+	case KindArray, KindMap:
+		c.errorf(n.X, "%s is of type %s and cannot be compared, index it or unpack it with [*]", describe(n.X), t)
+		return result
+	case KindBool:
+		c.errorf(n.X, "%s is a Boolean and cannot be compared, use it on its own or with not", describe(n.X))
+		return result
+	}
+
+	allowed := map[Kind][]string{
+		KindBytes: {"eq", "ne", "lt", "le", "gt", "ge", "contains", "matches", "wildcard", "strict wildcard", "in"},
+		KindInt:   {"eq", "ne", "lt", "le", "gt", "ge", "in"},
+		KindIP:    {"eq", "ne", "in"},
+	}[t.Kind]
+	if !slices.Contains(allowed, n.Op) {
+		c.errorf(n, "operator %q is not supported for %s, which is of type %s; supported operators are %s", n.Op, describe(n.X), t, strings.Join(allowed, ", "))
+		return result
+	}
+
+	switch y := n.Y.(type) {
+	case *Set:
+		if len(y.Elems) == 0 {
+			c.errorf(y, "the set is empty")
+		}
+		for _, e := range y.Elems {
+			c.element(t, e)
+		}
+	case *List:
+		if strings.HasPrefix(y.Name, "cf.") && t.Kind != KindIP {
+			c.errorf(y, "managed list $%s holds IP addresses and cannot be matched against %s, which is of type %s", y.Name, describe(n.X), t)
+		}
+	default:
+		c.literal(t, n.Y)
+		if s, ok := n.Y.(*String); ok && n.Op == "matches" {
+			if _, err := regexp.Compile(s.Value); err != nil {
+				c.errorf(s, "invalid regular expression: %s", err)
+			}
+		}
+		if a, ok := n.Y.(*Address); ok && !a.Prefix.IsSingleIP() {
+			c.errorf(a, "%q compares with a single IP address, use in {%s} to match a CIDR", n.Op, a.Prefix)
+		}
+	}
+	return result
+}
+
+// element checks an element of a set matched against a value of type t.
+func (c *checker) element(t Type, e Node) {
+	r, ok := e.(*Range)
+	if !ok {
+		c.literal(t, e)
+		return
+	}
+	if t.Kind == KindBytes {
+		c.errorf(r, "ranges are only supported for Integers and IP addresses")
+		return
+	}
+	if !c.literal(t, r.From) || !c.literal(t, r.To) {
+		return
+	}
+	switch from := r.From.(type) {
+	case *Integer:
+		if from.Value > r.To.(*Integer).Value {
+			c.errorf(r, "the range %d..%d is empty", from.Value, r.To.(*Integer).Value)
+		}
+	case *Address:
+		to := r.To.(*Address)
+		switch {
+		case !from.Prefix.IsSingleIP() || !to.Prefix.IsSingleIP():
+			c.errorf(r, "the bounds of an IP range must be IP addresses, not CIDRs")
+		case from.Prefix.Addr().Is4() != to.Prefix.Addr().Is4():
+			c.errorf(r, "the bounds of an IP range must be of the same IP version")
+		case from.Prefix.Addr().Compare(to.Prefix.Addr()) > 0:
+			c.errorf(r, "the range %s..%s is empty", from.Prefix.Addr(), to.Prefix.Addr())
+		}
+	}
+}
+
+// literal checks that the given literal is of type t.
+func (c *checker) literal(t Type, n Node) bool {
+	var lt Type
+	switch n.(type) {
+	case *String:
+		lt = Bytes
+	case *Integer:
+		lt = Int
+	case *Address:
+		lt = IP
+	default:
+		c.errorf(n, "expected a literal of type %s", t)
+		return false
+	}
+	if !lt.Equal(t) {
+		c.errorf(n, "expected a literal of type %s, found one of type %s", t, lt)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	cases := map[string]struct {
+		src string
+		// err is a substring of the expected error, or empty if the
+		// expression is valid.
+		err string
+	}{
+		"Equal":                {src: `http.host eq "example.com"`},
+		"SymbolOperator":       {src: `http.host == "example.com" && ip.src != 192.0.2.1`},
+		"Not":                  {src: `not ssl`},
+		"Parentheses":          {src: `(http.host eq "a" or http.host eq "b") and not cf.client.bot`},
+		"Xor":                  {src: `ssl xor cf.client.bot`},
+		"IPSet":                {src: `ip.src in {192.0.2.0/24 2001:db8::/32 198.51.100.1..198.51.100.9}`},
+		"IntegerSet":           {src: `tcp.dstport in {80 443 8000..8999}`},
+		"List":                 {src: `ip.src in $office_ips`},
+		"ManagedList":          {src: `ip.src in $cf.open_proxies`},
+		"Matches":              {src: `http.request.uri.path matches "^/api/v[0-9]+/"`},
+		"RawString":            {src: `http.request.uri.path matches r"^/a\d+$"`},
+		"HashRawString":        {src: `http.user_agent contains r#"say "hi""#`},
+		"Wildcard":             {src: `http.request.full_uri strict wildcard "https://*.example.com/*"`},
+		"MapIndex":             {src: `http.request.headers["x-api-key"][0] eq "secret"`},
+		"UnpackedWithAny":      {src: `any(http.request.headers.names[*] eq "x-debug")`},
+		"FunctionCall":         {src: `lower(http.host) eq "example.com" and len(http.request.uri.path) gt 1`},
+		"StartsWith":           {src: `starts_with(http.request.uri.path, "/admin")`},
+		"LookupJSON":           {src: `lookup_json_string(http.request.body.raw, "user", 0) eq "admin"`},
+		"JWTClaims":            {src: `any(http.request.jwt.claims.aud["config"][*] eq "api")`},
+		"LLMPrompt":            {src: `cf.llm.prompt.injection_score lt 20`},
+		"UnknownFieldAccepted": {src: `cf.future_field eq "x" and http.host eq "example.com"`},
+		"UnknownFieldAsBool":   {src: `cf.future_flag`},
+		"True":                 {src: `true`},
+		"BooleanOperand":       {src: `false or ssl`},
+
+		"EmptyExpression": {src: `  `, err: "1:3: empty expression"},
+		"UnknownFunction": {src: `foo(http.host)`, err: `unknown function "foo"`},
+		"NotBoolean":      {src: `http.host`, err: "must evaluate to a Boolean"},
+		"WrongLiteral":    {src: `http.host eq 1`, err: "expected a literal of type String, found one of type Integer"},
+		"WrongOperator":   {src: `ip.src contains "192"`, err: `operator "contains" is not supported`},
+		"InvalidRegex":    {src: `http.host matches "(("`, err: "invalid regular expression"},
+		"CIDRWithEqual":   {src: `ip.src eq 192.0.2.0/24`, err: "use in {192.0.2.0/24} to match a CIDR"},
+		"EmptySet":        {src: `http.host in {}`, err: "the set is empty"},
+		"CommaInSet":      {src: `http.host in {"a", "b"}`, err: "separated by spaces, not commas"},
+		"EmptyRange":      {src: `tcp.dstport in {90..80}`, err: "the range 90..80 is empty"},
+		"StringRange":     {src: `http.host in {"a".."b"}`, err: "ranges are only supported"},
+		"ListOfStrings":   {src: `http.host in $cf.botnetcc`, err: "holds IP addresses"},
+		"UnpackedAlone":   {src: `http.request.headers.names[*] eq "a"`, err: "use it as an argument of any() or all()"},
+		"CompareArray":    {src: `http.request.headers.names eq "a"`, err: "cannot be compared"},
+		"IndexMapWithInt": {src: `http.request.headers[0][0] eq "a"`, err: "index it with a string"},
+		"FieldsCompared":  {src: `http.host eq http.referer`, err: "fields cannot be compared with each other"},
+		"Unterminated":    {src: `http.host eq "example.com`, err: "unterminated string"},
+		"InvalidEscape":   {src: `http.host eq "\d"`, err: `invalid escape "\\d"`},
+		"WrongArgument":   {src: `starts_with(http.host, 1)`, err: "argument 2 of starts_with() must be of type String"},
+		"WrongArity":      {src: `lower(http.host, "x") eq "a"`, err: "lower() takes 1 arguments, found 2"},
+		"TrailingInput":   {src: `ssl ssl`, err: "1:5: unexpected"},
+		"SecondLine":      {src: "ssl and\n  http.host eq 1", err: "2:16:"},
+		"UnknownFieldAnd": {src: `cf.future_field eq "x" and http.host eq 1`, err: "expected a literal of type String"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Check(tc.src)
+			switch {
+			case tc.err == "" && err != nil:
+				t.Errorf("Check(%q): unexpected error: %s", tc.src, err)
+			case tc.err != "" && err == nil:
+				t.Errorf("Check(%q): expected an error containing %q", tc.src, tc.err)
+			case tc.err != "" && !strings.Contains(err.Error(), tc.err):
+				t.Errorf("Check(%q): error %q does not contain %q", tc.src, err, tc.err)
+			}
+			var errs Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Errorf("Check(%q): error is a %T, not an Errors", tc.src, err)
+			}
+		})
+	}
+}
+
+func TestCheckValue(t *testing.T) {
+	cases := map[string]struct {
+		src string
+		err string
+	}{
+		"Literal":      {src: `"https://example.com"`},
+		"Concat":       {src: `concat("https://example.com", http.request.uri.path)`},
+		"RegexReplace": {src: `regex_replace(http.request.uri.path, "^/old/(.*)$", "/new/${1}")`},
+		"ToString":     {src: `to_string(cf.bot_management.score)`},
+		"Boolean":      {src: `http.host eq "example.com"`, err: "must evaluate to a String"},
+		"ConcatMixed":  {src: `concat("a", 1)`, err: "argument 2 of concat() must be of type String"},
+		"RegexNotLit":  {src: `regex_replace(http.host, http.host, "x")`, err: "argument 2 of regex_replace() must be a string literal"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckValue(tc.src)
+			switch {
+			case tc.err == "" && err != nil:
+				t.Errorf("CheckValue(%q): unexpected error: %s", tc.src, err)
+			case tc.err != "" && err == nil:
+				t.Errorf("CheckValue(%q): expected an error containing %q", tc.src, tc.err)
+			case tc.err != "" && !strings.Contains(err.Error(), tc.err):
+				t.Errorf("CheckValue(%q): error %q does not contain %q", tc.src, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	cases := map[string]struct {
+		src  string
+		want []string
+	}{
+		"None":       {src: `http.host eq "example.com"`},
+		"Comparison": {src: `cf.future_field eq "x"`, want: []string{"cf.future_field"}},
+		"Nested": {
+			src:  `not (any(lower(cf.a[*]) eq "x") or cf.b) and cf.a[0] eq "y"`,
+			want: []string{"cf.a", "cf.b"},
+		},
+		"Booleans":    {src: `true and not false`},
+		"SyntaxError": {src: `cf.future_field eq`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := UnknownFields(tc.src); !slices.Equal(got, tc.want) {
+				t.Errorf("UnknownFields(%q): got %q, want %q", tc.src, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package rules
+
+var (
+	stringArray      = Array(Bytes)
+	stringArrayMap   = Map(Array(Bytes))
+	stringArrayArray = Array(Array(Bytes))
+	intArrayMap      = Map(Array(Int))
+)
+
+// Fields are the fields of the Rules language and their types. Fields that
+// are only available on some plans or in some phases are included, the API
+// rejects them where they are not available. Fields missing from this table
+// are accepted by Check without being type checked.
+var Fields = map[string]Type{
+	// Bot management and threat intelligence.
+	"cf.bot_management.corporate_proxy":     Bool,
+	"cf.bot_management.detection_ids":       Array(Int),
+	"cf.bot_management.ja3_hash":            Bytes,
+	"cf.bot_management.ja4":                 Bytes,
+	"cf.bot_management.js_detection.passed": Bool,
+	"cf.bot_management.score":               Int,
+	"cf.bot_management.static_resource":     Bool,
+	"cf.bot_management.verified_bot":        Bool,
+	"cf.client.bot":                         Bool,
+	"cf.threat_score":                       Int,
+	"cf.verified_bot_category":              Bytes,
+
+	// Edge and zone.
+	"cf.colo.id":                     Int,
+	"cf.colo.name":                   Bytes,
+	"cf.edge.server_ip":              IP,
+	"cf.edge.server_port":            Int,
+	"cf.timings.client_tcp_rtt_msec": Int,
+	"cf.timings.edge_msec":           Int,
+	"cf.timings.origin_ttfb_msec":    Int,
+	"cf.hostname.metadata":           Bytes,
+	"cf.metal.id":                    Int,
+	"cf.random_seed":                 Bytes,
+	"cf.ray_id":                      Bytes,
+	"cf.worker.upstream_zone":        Bytes,
+	"cf.zone.name":                   Bytes,
+	"cf.zone.plan":                   Bytes,
+
+	// TLS.
+	"cf.tls_cipher":                              Bytes,
+	"cf.tls_ciphers_sha1":                        Bytes,
+	"cf.tls_client_auth.cert_fingerprint_sha1":   Bytes,
+	"cf.tls_client_auth.cert_fingerprint_sha256": Bytes,
+	"cf.tls_client_auth.cert_issuer_dn":          Bytes,
+	"cf.tls_client_auth.cert_issuer_dn_legacy":   Bytes,
+	"cf.tls_client_auth.cert_issuer_dn_rfc2253":  Bytes,
+	"cf.tls_client_auth.cert_issuer_serial":      Bytes,
+	"cf.tls_client_auth.cert_issuer_ski":         Bytes,
+	"cf.tls_client_auth.cert_not_after":          Bytes,
+	"cf.tls_client_auth.cert_not_before":         Bytes,
+	"cf.tls_client_auth.cert_presented":          Bool,
+	"cf.tls_client_auth.cert_revoked":            Bool,
+	"cf.tls_client_auth.cert_serial":             Bytes,
+	"cf.tls_client_auth.cert_ski":                Bytes,
+	"cf.tls_client_auth.cert_subject_dn":         Bytes,
+	"cf.tls_client_auth.cert_subject_dn_legacy":  Bytes,
+	"cf.tls_client_auth.cert_subject_dn_rfc2253": Bytes,
+	"cf.tls_client_auth.cert_verified":           Bool,
+	"cf.tls_client_extensions_sha1":              Bytes,
+	"cf.tls_client_extensions_sha1_le":           Bytes,
+	"cf.tls_client_hello_length":                 Int,
+	"cf.tls_client_random":                       Bytes,
+	"cf.tls_version":                             Bytes,
+	"ssl":                                        Bool,
+
+	// WAF.
+	"cf.waf.auth_detected":                                 Bool,
+	"cf.waf.content_scan.has_failed":                       Bool,
+	"cf.waf.content_scan.has_malicious_obj":                Bool,
+	"cf.waf.content_scan.has_obj":                          Bool,
+	"cf.waf.content_scan.num_malicious_obj":                Int,
+	"cf.waf.content_scan.num_obj":                          Int,
+	"cf.waf.content_scan.obj_results":                      stringArray,
+	"cf.waf.content_scan.obj_sizes":                        Array(Int),
+	"cf.waf.content_scan.obj_types":                        stringArray,
+	"cf.waf.credential_check.password_leaked":              Bool,
+	"cf.waf.credential_check.username_and_password_leaked": Bool,
+	"cf.waf.credential_check.username_leaked":              Bool,
+	"cf.waf.credential_check.username_password_similar":    Bool,
+	"cf.waf.score":                                         Int,
+	"cf.waf.score.class":                                   Bytes,
+	"cf.waf.score.rce":                                     Int,
+	"cf.waf.score.sqli":                                    Int,
+	"cf.waf.score.xss":                                     Int,
+	"cf.api_gateway.auth_id_present":                       Bool,
+	"cf.api_gateway.fallthrough_detected":                  Bool,
+	"cf.api_gateway.request_violates_schema":               Bool,
+
+	// AI Gateway and Firewall for AI.
+	"cf.llm.prompt.detected":                Bool,
+	"cf.llm.prompt.injection_score":         Int,
+	"cf.llm.prompt.pii_categories":          stringArray,
+	"cf.llm.prompt.pii_detected":            Bool,
+	"cf.llm.prompt.unsafe_topic_categories": stringArray,
+	"cf.llm.prompt.unsafe_topic_detected":   Bool,
+
+	// JSON Web Tokens validated by API Shield, keyed by the identifier of the
+	// token configuration.
+	"http.request.jwt.claims.aud":            stringArrayMap,
+	"http.request.jwt.claims.aud.names":      stringArray,
+	"http.request.jwt.claims.aud.values":     stringArray,
+	"http.request.jwt.claims.iat.sec":        intArrayMap,
+	"http.request.jwt.claims.iat.sec.names":  stringArray,
+	"http.request.jwt.claims.iat.sec.values": Array(Int),
+	"http.request.jwt.claims.iss":            stringArrayMap,
+	"http.request.jwt.claims.iss.names":      stringArray,
+	"http.request.jwt.claims.iss.values":     stringArray,
+	"http.request.jwt.claims.jti":            stringArrayMap,
+	"http.request.jwt.claims.jti.names":      stringArray,
+	"http.request.jwt.claims.jti.values":     stringArray,
+	"http.request.jwt.claims.nbf.sec":        intArrayMap,
+	"http.request.jwt.claims.nbf.sec.names":  stringArray,
+	"http.request.jwt.claims.nbf.sec.values": Array(Int),
+	"http.request.jwt.claims.sub":            stringArrayMap,
+	"http.request.jwt.claims.sub.names":      stringArray,
+	"http.request.jwt.claims.sub.values":     stringArray,
+
+	// Request.
+	"http.cookie":                         Bytes,
+	"http.host":                           Bytes,
+	"http.referer":                        Bytes,
+	"http.request.accepted_languages":     stringArray,
+	"http.request.cookies":                stringArrayMap,
+	"http.request.full_uri":               Bytes,
+	"http.request.headers":                stringArrayMap,
+	"http.request.headers.names":          stringArray,
+	"http.request.headers.truncated":      Bool,
+	"http.request.headers.values":         stringArray,
+	"http.request.method":                 Bytes,
+	"http.request.timestamp.msec":         Int,
+	"http.request.timestamp.sec":          Int,
+	"http.request.uri":                    Bytes,
+	"http.request.uri.args":               stringArrayMap,
+	"http.request.uri.args.names":         stringArray,
+	"http.request.uri.args.values":        stringArray,
+	"http.request.uri.path":               Bytes,
+	"http.request.uri.path.extension":     Bytes,
+	"http.request.uri.query":              Bytes,
+	"http.request.version":                Bytes,
+	"http.user_agent":                     Bytes,
+	"http.x_forwarded_for":                Bytes,
+	"raw.http.request.full_uri":           Bytes,
+	"raw.http.request.uri":                Bytes,
+	"raw.http.request.uri.args":           stringArrayMap,
+	"raw.http.request.uri.args.names":     stringArray,
+	"raw.http.request.uri.args.values":    stringArray,
+	"raw.http.request.uri.path":           Bytes,
+	"raw.http.request.uri.path.extension": Bytes,
+	"raw.http.request.uri.query":          Bytes,
+
+	// Request body.
+	"http.request.body.form":                                 stringArrayMap,
+	"http.request.body.form.names":                           stringArray,
+	"http.request.body.form.values":                          stringArray,
+	"http.request.body.mime":                                 Bytes,
+	"http.request.body.multipart":                            stringArrayMap,
+	"http.request.body.multipart.content_dispositions":       stringArrayArray,
+	"http.request.body.multipart.content_transfer_encodings": stringArrayArray,
+	"http.request.body.multipart.content_types":              stringArrayArray,
+	"http.request.body.multipart.filenames":                  stringArrayArray,
+	"http.request.body.multipart.names":                      stringArrayArray,
+	"http.request.body.multipart.values":                     stringArray,
+	"http.request.body.raw":                                  Bytes,
+	"http.request.body.size":                                 Int,
+	"http.request.body.truncated":                            Bool,
+
+	// Response.
+	"cf.response.1xxx_code":                 Int,
+	"cf.response.error_type":                Bytes,
+	"http.response.code":                    Int,
+	"http.response.content_type.media_type": Bytes,
+	"http.response.headers":                 stringArrayMap,
+	"http.response.headers.names":           stringArray,
+	"http.response.headers.values":          stringArray,
+
+	// Client IP and geolocation.
+	"ip.src":                          IP,
+	"ip.src.asnum":                    Int,
+	"ip.src.city":                     Bytes,
+	"ip.src.continent":                Bytes,
+	"ip.src.country":                  Bytes,
+	"ip.src.is_in_european_union":     Bool,
+	"ip.src.lat":                      Bytes,
+	"ip.src.lon":                      Bytes,
+	"ip.src.metro_code":               Bytes,
+	"ip.src.postal_code":              Bytes,
+	"ip.src.region":                   Bytes,
+	"ip.src.region_code":              Bytes,
+	"ip.src.subdivision_1_iso_code":   Bytes,
+	"ip.src.subdivision_2_iso_code":   Bytes,
+	"ip.src.timezone.name":            Bytes,
+	"ip.geoip.asnum":                  Int,
+	"ip.geoip.continent":              Bytes,
+	"ip.geoip.country":                Bytes,
+	"ip.geoip.is_in_european_union":   Bool,
+	"ip.geoip.subdivision_1_iso_code": Bytes,
+	"ip.geoip.subdivision_2_iso_code": Bytes,
+
+	// Network layer, used by Magic Firewall and Spectrum rules.
+	"icmp.code":       Int,
+	"icmp.type":       Int,
+	"ip.dst":          IP,
+	"ip.dst.country":  Bytes,
+	"ip.flags.df":     Bool,
+	"ip.flags.mf":     Bool,
+	"ip.hdr_len":      Int,
+	"ip.len":          Int,
+	"ip.proto":        Bytes,
+	"ip.ttl":          Int,
+	"tcp.dstport":     Int,
+	"tcp.flags":       Int,
+	"tcp.flags.ack":   Bool,
+	"tcp.flags.cwr":   Bool,
+	"tcp.flags.ecn":   Bool,
+	"tcp.flags.fin":   Bool,
+	"tcp.flags.push":  Bool,
+	"tcp.flags.reset": Bool,
+	"tcp.flags.syn":   Bool,
+	"tcp.flags.urg":   Bool,
+	"tcp.srcport":     Int,
+	"udp.dstport":     Int,
+	"udp.srcport":     Int,
+}
@@ -0,0 +1,252 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// function checks the arguments of a call and returns its result type.
+type function func(c *checker, n *Call, args []value) (Type, bool)
+
+// functions are the functions of the Rules language. Functions that are
+// only available in some phases are included, the API rejects them where
+// they are not available.
+var functions map[string]function
+
+func init() {
+	bytesToBytes := fixed(Bytes, Bytes)
+	functions = map[string]function{
+		"any":    fixed(Bool, Array(Bool)),
+		"all":    fixed(Bool, Array(Bool)),
+		"concat": concat,
+		"len": func(c *checker, n *Call, args []value) (Type, bool) {
+			if !c.arity(n, 1, 1) {
+				return Int, false
+			}
+			if t := args[0].typ; t.Kind != KindBytes && t.Kind != KindArray {
+				c.errorf(n.Args[0], "argument of len() must be a String or an Array, %s is of type %s", describe(n.Args[0]), t)
+				return Int, false
+			}
+			return Int, true
+		},
+		"starts_with":   fixed(Bool, Bytes, Bytes),
+		"ends_with":     fixed(Bool, Bytes, Bytes),
+		"lower":         bytesToBytes,
+		"upper":         bytesToBytes,
+		"sha256":        bytesToBytes,
+		"uuidv4":        bytesToBytes,
+		"decode_base64": bytesToBytes,
+		"encode_base64": optional(Bytes, []Type{Bytes}, []Type{Bytes}, 1),
+		"url_decode":    optional(Bytes, []Type{Bytes}, []Type{Bytes}, 1),
+		"remove_bytes":  fixed(Bytes, Bytes, Bytes),
+		"substring":     optional(Bytes, []Type{Bytes, Int}, []Type{Int}, 0),
+		"split":         fixed(Array(Bytes), Bytes, Bytes, Int),
+		"join":          fixed(Bytes, Array(Bytes), Bytes),
+		"to_string": func(c *checker, n *Call, args []value) (Type, bool) {
+			if !c.arity(n, 1, 1) {
+				return Bytes, false
+			}
+			if t := args[0].typ; t.Kind != KindInt && t.Kind != KindBool && t.Kind != KindIP {
+				c.errorf(n.Args[0], "argument of to_string() must be an Integer, a Boolean or an IP address, %s is of type %s", describe(n.Args[0]), t)
+				return Bytes, false
+			}
+			return Bytes, true
+		},
+		"lookup_json_string":  lookupJSON(Bytes),
+		"lookup_json_integer": lookupJSON(Int),
+		"regex_replace": func(c *checker, n *Call, args []value) (Type, bool) {
+			if !c.params(n, args, Bytes, Bytes, Bytes) || !c.literalArg(n, 1) || !c.literalArg(n, 2) {
+				return Bytes, false
+			}
+			if _, err := regexp.Compile(n.Args[1].(*String).Value); err != nil {
+				c.errorf(n.Args[1], "invalid regular expression: %s", err)
+				return Bytes, false
+			}
+			return Bytes, true
+		},
+		"wildcard_replace": func(c *checker, n *Call, args []value) (Type, bool) {
+			t, ok := optional(Bytes, []Type{Bytes, Bytes, Bytes}, []Type{Bytes}, 1)(c, n, args)
+			return t, ok && c.literalArg(n, 1) && c.literalArg(n, 2)
+		},
+		"remove_query_args": func(c *checker, n *Call, args []value) (Type, bool) {
+			if !c.arity(n, 2, -1) {
+				return Bytes, false
+			}
+			ok := c.arg(n, args, 0, Bytes)
+			for i := 1; i < len(args); i++ {
+				ok = c.arg(n, args, i, Bytes) && c.literalArg(n, i) && ok
+			}
+			return Bytes, ok
+		},
+		"has_key": func(c *checker, n *Call, args []value) (Type, bool) {
+			if !c.arity(n, 2, 2) {
+				return Bool, false
+			}
+			if args[0].typ.Kind != KindMap {
+				c.errorf(n.Args[0], "first argument of has_key() must be a Map, %s is of type %s", describe(n.Args[0]), args[0].typ)
+				return Bool, false
+			}
+			return Bool, c.arg(n, args, 1, Bytes)
+		},
+		"has_value": func(c *checker, n *Call, args []value) (Type, bool) {
+			if !c.arity(n, 2, 2) {
+				return Bool, false
+			}
+			if k := args[0].typ.Kind; k != KindMap && k != KindArray {
+				c.errorf(n.Args[0], "first argument of has_value() must be an Array or a Map, %s is of type %s", describe(n.Args[0]), args[0].typ)
+				return Bool, false
+			}
+			return Bool, c.arg(n, args, 1, *args[0].typ.Elem)
+		},
+		"cidr":                   fixed(IP, IP, Int, Int),
+		"cidr6":                  fixed(IP, IP, Int),
+		"bit_slice":              fixed(Int, Bytes, Int, Int),
+		"is_timed_hmac_valid_v0": optional(Bool, []Type{Bytes, Bytes, Int, Int}, []Type{Int, Bytes}, 0),
+	}
+}
+
+func (c *checker) call(n *Call) value {
+	f, ok := functions[n.Name]
+	if !ok {
+		c.errorf(n, "unknown function %q", n.Name)
+		return invalid
+	}
+	args := make([]value, len(n.Args))
+	unpacked := false
+	for i, a := range n.Args {
+		args[i] = c.expr(a)
+		if !args[i].ok {
+			return invalid
+		}
+		unpacked = unpacked || args[i].unpacked
+	}
+	if unpacked && (n.Name == "any" || n.Name == "all") {
+		c.errorf(n, "argument of %s() must be an Array<Boolean>, such as a comparison of an element unpacked with [*]", n.Name)
+		return invalid
+	}
+	t, ok := f(c, n, args)
+	if !ok {
+		return invalid
+	}
+	if unpacked {
+		// The function is evaluated for every element and returns an array
+		// that can be unpacked again with [*].
+		return value{typ: Array(t), ok: true}
+	}
+	return value{typ: t, ok: true}
+}
+
+// arity checks the number of arguments of a call. A negative max means
+// that any number of arguments is accepted.
+func (c *checker) arity(n *Call, minArgs, maxArgs int) bool {
+	switch {
+	case len(n.Args) < minArgs || (maxArgs >= 0 && len(n.Args) > maxArgs):
+	default:
+		return true
+	}
+	want := fmt.Sprintf("%d", minArgs)
+	switch {
+	case maxArgs < 0:
+		want = fmt.Sprintf("at least %d", minArgs)
+	case maxArgs != minArgs:
+		want = fmt.Sprintf("%d to %d", minArgs, maxArgs)
+	}
+	c.errorf(n, "%s() takes %s arguments, found %d", n.Name, want, len(n.Args))
+	return false
+}
+
+// arg checks that the i-th argument of a call is of type t.
+func (c *checker) arg(n *Call, args []value, i int, t Type) bool {
+	if args[i].typ.Equal(t) {
+		return true
+	}
+	c.errorf(n.Args[i], "argument %d of %s() must be of type %s, %s is of type %s", i+1, n.Name, t, describe(n.Args[i]), args[i].typ)
+	return false
+}
+
+// params checks the arguments of a call with a fixed number of parameters.
+func (c *checker) params(n *Call, args []value, types ...Type) bool {
+	if !c.arity(n, len(types), len(types)) {
+		return false
+	}
+	ok := true
+	for i, t := range types {
+		ok = c.arg(n, args, i, t) && ok
+	}
+	return ok
+}
+
+// literalArg checks that the i-th argument of a call is a string literal.
+func (c *checker) literalArg(n *Call, i int) bool {
+	if i >= len(n.Args) {
+		return true
+	}
+	if _, ok := n.Args[i].(*String); ok {
+		return true
+	}
+	c.errorf(n.Args[i], "argument %d of %s() must be a string literal", i+1, n.Name)
+	return false
+}
+
+// fixed returns a function with the given parameters.
+func fixed(result Type, params ...Type) function {
+	return func(c *checker, n *Call, args []value) (Type, bool) {
+		return result, c.params(n, args, params...)
+	}
+}
+
+// optional returns a function with the given required and optional
+// parameters. The first literals parameters must be string literals.
+func optional(result Type, required, opt []Type, literals int) function {
+	return func(c *checker, n *Call, args []value) (Type, bool) {
+		if !c.arity(n, len(required), len(required)+len(opt)) {
+			return result, false
+		}
+		all := append(append([]Type{}, required...), opt...)
+		ok := true
+		for i := range args {
+			ok = c.arg(n, args, i, all[i]) && ok
+		}
+		for i := len(required); i < len(required)+literals && i < len(args); i++ {
+			ok = c.literalArg(n, i) && ok
+		}
+		return result, ok
+	}
+}
+
+// concat concatenates Strings or Arrays of the same type.
+func concat(c *checker, n *Call, args []value) (Type, bool) {
+	if !c.arity(n, 1, -1) {
+		return Bytes, false
+	}
+	t := args[0].typ
+	if t.Kind != KindBytes && t.Kind != KindArray {
+		c.errorf(n.Args[0], "arguments of concat() must be Strings or Arrays, %s is of type %s", describe(n.Args[0]), t)
+		return t, false
+	}
+	ok := true
+	for i := 1; i < len(args); i++ {
+		ok = c.arg(n, args, i, t) && ok
+	}
+	return t, ok
+}
+
+// lookupJSON returns the function looking up a String or Integer in a JSON
+// document by a path of string keys and integer array indexes.
+func lookupJSON(result Type) function {
+	return func(c *checker, n *Call, args []value) (Type, bool) {
+		if !c.arity(n, 2, -1) {
+			return result, false
+		}
+		ok := c.arg(n, args, 0, Bytes)
+		for i := 1; i < len(args); i++ {
+			switch n.Args[i].(type) {
+			case *String, *Integer:
+			default:
+				c.errorf(n.Args[i], "argument %d of %s() must be a string key or an integer index", i+1, n.Name)
+				ok = false
+			}
+		}
+		return result, ok
+	}
+}
@@ -0,0 +1,447 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// operators maps the symbol and word forms of the comparison operators to
+// their canonical word form.
+var operators = []struct{ token, op string }{
+	{"==", "eq"}, {"!=", "ne"}, {"<=", "le"}, {">=", "ge"}, {"<", "lt"}, {">", "gt"}, {"~", "matches"},
+	{"eq", "eq"}, {"ne", "ne"}, {"le", "le"}, {"ge", "ge"}, {"lt", "lt"}, {"gt", "gt"},
+	{"contains", "contains"}, {"matches", "matches"}, {"in", "in"}, {"wildcard", "wildcard"},
+}
+
+// Parse parses the given expression into its syntax tree without type
+// checking it. Syntax errors are returned as an Errors with a single
+// element.
+func Parse(src string) (n Node, err error) {
+	p := &parser{src: src}
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*Error)
+			if !ok {
+				panic(r)
+			}
+			n, err = nil, Errors{e}
+		}
+	}()
+	p.skipSpace()
+	if p.eof() {
+		p.fail(p.off, "empty expression")
+	}
+	n = p.parseOr()
+	p.skipSpace()
+	if !p.eof() {
+		p.fail(p.off, "unexpected %s", p.describe())
+	}
+	return n, nil
+}
+
+type parser struct {
+	src string
+	off int
+}
+
+func (p *parser) eof() bool {
+	return p.off >= len(p.src)
+}
+
+func (p *parser) pos(off int) Pos {
+	return position(p.src, off)
+}
+
+func position(src string, off int) Pos {
+	line, col := 1, 1
+	for _, r := range src[:off] {
+		if r == '\n' {
+			line, col = line+1, 1
+			continue
+		}
+		col++
+	}
+	return Pos{Offset: off, Line: line, Column: col}
+}
+
+func (p *parser) fail(off int, format string, args ...any) {
+	panic(&Error{Pos: p.pos(off), Msg: fmt.Sprintf(format, args...)})
+}
+
+// describe describes the input at the current offset for an error message.
+func (p *parser) describe() string {
+	if p.eof() {
+		return "end of expression"
+	}
+	if w := p.peekWord(); w != "" {
+		return strconv.Quote(w)
+	}
+	r, _ := utf8.DecodeRuneInString(p.src[p.off:])
+	return strconv.Quote(string(r))
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() {
+		switch p.src[p.off] {
+		case ' ', '\t', '\n', '\r':
+			p.off++
+		default:
+			return
+		}
+	}
+}
+
+func isWordStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isWordChar(c byte) bool {
+	return isWordStart(c) || ('0' <= c && c <= '9') || c == '.'
+}
+
+// peekWord returns the identifier or keyword at the current offset.
+func (p *parser) peekWord() string {
+	if p.eof() || !isWordStart(p.src[p.off]) {
+		return ""
+	}
+	end := p.off
+	for end < len(p.src) && isWordChar(p.src[end]) {
+		end++
+	}
+	return p.src[p.off:end]
+}
+
+func (p *parser) acceptWord(w string) bool {
+	p.skipSpace()
+	if p.peekWord() != w {
+		return false
+	}
+	p.off += len(w)
+	return true
+}
+
+func (p *parser) acceptSym(s string) bool {
+	p.skipSpace()
+	if !strings.HasPrefix(p.src[p.off:], s) {
+		return false
+	}
+	p.off += len(s)
+	return true
+}
+
+func (p *parser) expectSym(s string) {
+	if !p.acceptSym(s) {
+		p.fail(p.off, "expected %q, found %s", s, p.describe())
+	}
+}
+
+func (p *parser) parseOr() Node {
+	x := p.parseXor()
+	for {
+		p.skipSpace()
+		off := p.off
+		if !p.acceptWord("or") && !p.acceptSym("||") {
+			return x
+		}
+		x = &Logical{Pos: p.pos(off), Op: "or", X: x, Y: p.parseXor()}
+	}
+}
+
+func (p *parser) parseXor() Node {
+	x := p.parseAnd()
+	for {
+		p.skipSpace()
+		off := p.off
+		if !p.acceptWord("xor") && !p.acceptSym("^^") {
+			return x
+		}
+		x = &Logical{Pos: p.pos(off), Op: "xor", X: x, Y: p.parseAnd()}
+	}
+}
+
+func (p *parser) parseAnd() Node {
+	x := p.parseUnary()
+	for {
+		p.skipSpace()
+		off := p.off
+		if !p.acceptWord("and") && !p.acceptSym("&&") {
+			return x
+		}
+		x = &Logical{Pos: p.pos(off), Op: "and", X: x, Y: p.parseUnary()}
+	}
+}
+
+func (p *parser) parseUnary() Node {
+	p.skipSpace()
+	off := p.off
+	if p.acceptWord("not") || (!strings.HasPrefix(p.src[p.off:], "!=") && p.acceptSym("!")) {
+		return &Not{Pos: p.pos(off), X: p.parseUnary()}
+	}
+	if p.acceptSym("(") {
+		x := p.parseOr()
+		p.expectSym(")")
+		return x
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() Node {
+	x := p.parseValue()
+	p.skipSpace()
+	opOff := p.off
+	op := p.parseOperator()
+	if op == "" {
+		return x
+	}
+	c := &Comparison{Pos: x.Position(), Op: op, X: x}
+	if op == "in" {
+		p.skipSpace()
+		switch {
+		case p.acceptSym("{"):
+			c.Y = p.parseSet(p.off - 1)
+		case p.acceptSym("$"):
+			c.Y = p.parseList(p.off - 1)
+		default:
+			p.fail(p.off, "expected a set or a list after %q at %s, found %s", "in", p.pos(opOff), p.describe())
+		}
+		return c
+	}
+	c.Y = p.parseLiteral(false)
+	return c
+}
+
+// parseOperator consumes a comparison operator and returns its canonical
+// form, or returns the empty string if there is none.
+func (p *parser) parseOperator() string {
+	rest := p.src[p.off:]
+	if w := p.peekWord(); w == "strict" {
+		p.off += len(w)
+		if !p.acceptWord("wildcard") {
+			p.fail(p.off, "expected %q after %q", "wildcard", "strict")
+		}
+		return "strict wildcard"
+	}
+	for _, o := range operators {
+		if !strings.HasPrefix(rest, o.token) {
+			continue
+		}
+		if isWordStart(o.token[0]) && p.peekWord() != o.token {
+			continue
+		}
+		p.off += len(o.token)
+		return o.op
+	}
+	return ""
+}
+
+// parseValue parses a field, a function call or a literal, followed by any
+// number of indexes.
+func (p *parser) parseValue() Node {
+	p.skipSpace()
+	off := p.off
+	var x Node
+	switch w := p.peekWord(); {
+	case w == "r" && off+1 < len(p.src) && (p.src[off+1] == '"' || p.src[off+1] == '#'):
+		x = p.parseString()
+	case w == "true" || w == "false":
+		p.off += len(w)
+		x = &Boolean{Pos: p.pos(off), Value: w == "true"}
+	case w != "":
+		p.off += len(w)
+		if strings.HasPrefix(p.src[p.off:], "(") {
+			p.off++
+			call := &Call{Pos: p.pos(off), Name: w}
+			if !p.acceptSym(")") {
+				for {
+					call.Args = append(call.Args, p.parseOr())
+					if p.acceptSym(")") {
+						break
+					}
+					p.expectSym(",")
+				}
+			}
+			x = call
+		} else {
+			x = &Field{Pos: p.pos(off), Name: w}
+		}
+	case !p.eof() && p.src[off] == '"':
+		x = p.parseString()
+	case !p.eof() && (p.src[off] == '-' || ('0' <= p.src[off] && p.src[off] <= '9')):
+		x = p.parseLiteral(false)
+	default:
+		p.fail(off, "expected a field, a function call or a literal, found %s", p.describe())
+	}
+	for p.acceptSym("[") {
+		idx := &Index{Pos: x.Position(), X: x}
+		p.skipSpace()
+		switch {
+		case p.acceptSym("*"):
+		case !p.eof() && p.src[p.off] == '"':
+			idx.Key = p.parseString()
+		default:
+			lit := p.parseLiteral(false)
+			if _, ok := lit.(*Integer); !ok {
+				p.fail(lit.Position().Offset, "index must be a string, an integer or *")
+			}
+			idx.Key = lit
+		}
+		p.expectSym("]")
+		x = idx
+	}
+	return x
+}
+
+func (p *parser) parseSet(off int) Node {
+	s := &Set{Pos: p.pos(off)}
+	for {
+		p.skipSpace()
+		if p.acceptSym("}") {
+			return s
+		}
+		if p.eof() {
+			p.fail(off, "unterminated set")
+		}
+		if p.src[p.off] == ',' {
+			p.fail(p.off, "set elements are separated by spaces, not commas")
+		}
+		e := p.parseLiteral(true)
+		if p.acceptSym("..") {
+			e = &Range{Pos: e.Position(), From: e, To: p.parseLiteral(true)}
+		}
+		s.Elems = append(s.Elems, e)
+	}
+}
+
+func (p *parser) parseList(off int) Node {
+	start := p.off
+	for !p.eof() && (isWordChar(p.src[p.off]) || p.src[p.off] == '-') {
+		p.off++
+	}
+	if start == p.off {
+		p.fail(off, "expected a list name after %q", "$")
+	}
+	return &List{Pos: p.pos(off), Name: p.src[start:p.off]}
+}
+
+// parseLiteral parses a string, integer, IP address or CIDR literal. In a
+// set, a literal ends before a range operator.
+func (p *parser) parseLiteral(inSet bool) Node {
+	p.skipSpace()
+	off := p.off
+	if !p.eof() && (p.src[off] == '"' || (p.src[off] == 'r' && off+1 < len(p.src) && (p.src[off+1] == '"' || p.src[off+1] == '#'))) {
+		return p.parseString()
+	}
+	end := off
+	if end < len(p.src) && p.src[end] == '-' {
+		end++
+	}
+	for end < len(p.src) {
+		c := p.src[end]
+		if c == '.' && end+1 < len(p.src) && p.src[end+1] == '.' {
+			break
+		}
+		if !isHexDigit(c) && c != '.' && c != ':' {
+			break
+		}
+		end++
+	}
+	lit := p.src[off:end]
+	if lit == "" || lit == "-" {
+		if !inSet && p.peekWord() != "" {
+			p.fail(off, "expected a literal, found %s; fields cannot be compared with each other", p.describe())
+		}
+		p.fail(off, "expected a literal, found %s", p.describe())
+	}
+	if end < len(p.src) && p.src[end] == '/' {
+		end++
+		for end < len(p.src) && '0' <= p.src[end] && p.src[end] <= '9' {
+			end++
+		}
+		prefix, err := netip.ParsePrefix(p.src[off:end])
+		if err != nil {
+			p.fail(off, "invalid CIDR %q", p.src[off:end])
+		}
+		p.off = end
+		return &Address{Pos: p.pos(off), Prefix: prefix}
+	}
+	p.off = end
+	if v, err := strconv.ParseInt(lit, 10, 64); err == nil {
+		return &Integer{Pos: p.pos(off), Value: v}
+	}
+	if a, err := netip.ParseAddr(lit); err == nil {
+		return &Address{Pos: p.pos(off), Prefix: netip.PrefixFrom(a, a.BitLen())}
+	}
+	if end < len(p.src) && isWordChar(p.src[end]) {
+		p.off = off
+		p.fail(off, "expected a literal, found %s", p.describe())
+	}
+	p.fail(off, "invalid literal %q", lit)
+	return nil
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// parseString parses a quoted string with the escapes \" \\ and \xHH, or a
+// raw string such as r"C:\path" or r#"say "hi""#.
+func (p *parser) parseString() Node {
+	off := p.off
+	if p.src[p.off] == 'r' {
+		p.off++
+		hashes := 0
+		for !p.eof() && p.src[p.off] == '#' {
+			hashes++
+			p.off++
+		}
+		if p.eof() || p.src[p.off] != '"' {
+			p.fail(off, "expected %q to start a raw string", `"`)
+		}
+		p.off++
+		closing := `"` + strings.Repeat("#", hashes)
+		i := strings.Index(p.src[p.off:], closing)
+		if i < 0 {
+			p.fail(off, "unterminated raw string")
+		}
+		v := p.src[p.off : p.off+i]
+		p.off += i + len(closing)
+		return &String{Pos: p.pos(off), Value: v}
+	}
+	p.off++
+	var b strings.Builder
+	for {
+		if p.eof() {
+			p.fail(off, "unterminated string")
+		}
+		c := p.src[p.off]
+		switch c {
+		case '"':
+			p.off++
+			return &String{Pos: p.pos(off), Value: b.String()}
+		case '\\':
+			if p.off+1 >= len(p.src) {
+				p.fail(off, "unterminated string")
+			}
+			switch e := p.src[p.off+1]; e {
+			case '"', '\\':
+				b.WriteByte(e)
+				p.off += 2
+			case 'x':
+				if p.off+3 >= len(p.src) || !isHexDigit(p.src[p.off+2]) || !isHexDigit(p.src[p.off+3]) {
+					p.fail(p.off, "invalid hex escape, expected \\xHH")
+				}
+				v, _ := strconv.ParseUint(p.src[p.off+2:p.off+4], 16, 8)
+				b.WriteByte(byte(v))
+				p.off += 4
+			default:
+				p.fail(p.off, "invalid escape %q, only \\\", \\\\ and \\xHH are supported; use a raw string such as r\"...\" for regular expressions", p.src[p.off:p.off+2])
+			}
+		default:
+			b.WriteByte(c)
+			p.off++
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Package rules parses and type checks expressions of the Cloudflare Rules
+// language, the wirefilter based language of rulesets, filters, snippet
+// rules, waiting room rules and other rules.
+//
+// Check validates a filter expression, such as
+//
+//	http.host eq "example.com" and not ip.src in $office_ips
+//
+// and CheckValue validates a value expression of a transform or redirect
+// rule, such as
+//
+//	concat("https://example.com", http.request.uri.path)
+//
+// Errors carry the line and column of the offending part of the expression.
+package rules
+
+import "fmt"
+
+// Kind is the kind of a Type.
+type Kind int
+
+// The kinds of the Rules language.
+const (
+	KindBytes Kind = iota
+	KindInt
+	KindBool
+	KindIP
+	KindArray
+	KindMap
+)
+
+// Type is the type of a field, function result or literal.
+type Type struct {
+	Kind Kind
+	// Elem is the element type of an Array or Map.
+	Elem *Type
+}
+
+// The scalar types of the Rules language. Bytes is the String type of the
+// Cloudflare documentation.
+var (
+	Bytes = Type{Kind: KindBytes}
+	Int   = Type{Kind: KindInt}
+	Bool  = Type{Kind: KindBool}
+	IP    = Type{Kind: KindIP}
+)
+
+// Array returns the type of an array of the given element type.
+func Array(elem Type) Type {
+	return Type{Kind: KindArray, Elem: &elem}
+}
+
+// Map returns the type of a map from strings to the given element type.
+func Map(elem Type) Type {
+	return Type{Kind: KindMap, Elem: &elem}
+}
+
+// Equal reports whether t and o are the same type.
+func (t Type) Equal(o Type) bool {
+	if t.Kind != o.Kind {
+		return false
+	}
+	if t.Elem == nil || o.Elem == nil {
+		return t.Elem == o.Elem
+	}
+	return t.Elem.Equal(*o.Elem)
+}
+
+func (t Type) String() string {
+	switch t.Kind {
+	case KindBytes:
+		return "String"
+	case KindInt:
+		return "Integer"
+	case KindBool:
+		return "Boolean"
+	case KindIP:
+		return "IP address"
+	case KindArray:
+		return fmt.Sprintf("Array<%s>", t.Elem)
+	case KindMap:
+		return fmt.Sprintf("Map<%s>", t.Elem)
+	}
+	return "unknown"
+}